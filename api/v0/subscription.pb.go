@@ -13,6 +13,36 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+// BackpressurePolicy specifies what the Sensor does with events for a
+// subscription whose queue is full.
+type BackpressurePolicy int32
+
+const (
+	// Wait for the subscriber to make room in the queue. While waiting,
+	// no events are delivered to any subscription.
+	BackpressurePolicy_BACKPRESSURE_POLICY_BLOCK BackpressurePolicy = 0
+	// Drop new events until the subscriber makes room in the queue.
+	BackpressurePolicy_BACKPRESSURE_POLICY_DROP_NEWEST BackpressurePolicy = 1
+	// Drop the oldest queued events to make room for new ones.
+	BackpressurePolicy_BACKPRESSURE_POLICY_DROP_OLDEST BackpressurePolicy = 2
+)
+
+var BackpressurePolicy_name = map[int32]string{
+	0: "BACKPRESSURE_POLICY_BLOCK",
+	1: "BACKPRESSURE_POLICY_DROP_NEWEST",
+	2: "BACKPRESSURE_POLICY_DROP_OLDEST",
+}
+var BackpressurePolicy_value = map[string]int32{
+	"BACKPRESSURE_POLICY_BLOCK":       0,
+	"BACKPRESSURE_POLICY_DROP_NEWEST": 1,
+	"BACKPRESSURE_POLICY_DROP_OLDEST": 2,
+}
+
+func (x BackpressurePolicy) String() string {
+	return proto.EnumName(BackpressurePolicy_name, int32(x))
+}
+func (BackpressurePolicy) EnumDescriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
+
 // The ContainerEventView specifies the level of detail to include for
 // ContainerEvents.
 type ContainerEventView int32
@@ -37,7 +67,7 @@ var ContainerEventView_value = map[string]int32{
 func (x ContainerEventView) String() string {
 	return proto.EnumName(ContainerEventView_name, int32(x))
 }
-func (ContainerEventView) EnumDescriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
+func (ContainerEventView) EnumDescriptor() ([]byte, []int) { return fileDescriptor3, []int{1} }
 
 // Possible interval types
 type ThrottleModifier_IntervalType int32
@@ -70,7 +100,7 @@ func (x ThrottleModifier_IntervalType) String() string {
 	return proto.EnumName(ThrottleModifier_IntervalType_name, int32(x))
 }
 func (ThrottleModifier_IntervalType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor3, []int{12, 0}
+	return fileDescriptor3, []int{22, 0}
 }
 
 //
@@ -89,7 +119,8 @@ type Subscription struct {
 	// the specified relative duration subtracted from the current
 	// time (recorder time). If the resulting time is in the past, then the
 	// subscription will search for historic events before streaming
-	// live ones.
+	// live ones. Historic events are only available when the sensor
+	// is run with CAPSULE8_SENSOR_EVENT_HISTORY_DURATION set.
 	SinceDuration *google_protobuf1.Int64Value `protobuf:"bytes,10,opt,name=since_duration,json=sinceDuration" json:"since_duration,omitempty"`
 	// If not empty, then only return events that occurred before
 	// the specified relative duration added to `since_duration`.
@@ -98,6 +129,18 @@ type Subscription struct {
 	ForDuration *google_protobuf1.Int64Value `protobuf:"bytes,11,opt,name=for_duration,json=forDuration" json:"for_duration,omitempty"`
 	// If not empty, apply the specified modifier to the subscription.
 	Modifier *Modifier `protobuf:"bytes,20,opt,name=modifier" json:"modifier,omitempty"`
+	// If not empty, deliver events in batches of more than one event
+	// per response as specified.
+	BatchOptions *BatchOptions `protobuf:"bytes,30,opt,name=batch_options,json=batchOptions" json:"batch_options,omitempty"`
+	// If not empty, specifies what the Sensor does with events when
+	// the subscriber is not receiving them as fast as they occur.
+	Backpressure *BackpressureOptions `protobuf:"bytes,40,opt,name=backpressure" json:"backpressure,omitempty"`
+	// If not zero, include the lineage of the process associated with
+	// each event in the event's process_lineage, up to this many
+	// processes (including the process itself). The lineage ends at
+	// the init process of the process's container or at the host's
+	// init process, whichever comes first.
+	ProcessLineageDepth uint32 `protobuf:"varint,50,opt,name=process_lineage_depth,json=processLineageDepth" json:"process_lineage_depth,omitempty"`
 }
 
 func (m *Subscription) Reset()                    { *m = Subscription{} }
@@ -140,6 +183,90 @@ func (m *Subscription) GetModifier() *Modifier {
 	return nil
 }
 
+func (m *Subscription) GetBatchOptions() *BatchOptions {
+	if m != nil {
+		return m.BatchOptions
+	}
+	return nil
+}
+
+func (m *Subscription) GetBackpressure() *BackpressureOptions {
+	if m != nil {
+		return m.Backpressure
+	}
+	return nil
+}
+
+func (m *Subscription) GetProcessLineageDepth() uint32 {
+	if m != nil {
+		return m.ProcessLineageDepth
+	}
+	return 0
+}
+
+// The BatchOptions message specifies how events are batched together into
+// responses. A batch is sent when it is full, when its oldest event has
+// waited for the maximum latency, or when the subscription ends.
+type BatchOptions struct {
+	// The maximum number of events to send in a single response. If
+	// zero, only max_latency limits the size of a batch.
+	MaxEvents uint32 `protobuf:"varint,1,opt,name=max_events,json=maxEvents" json:"max_events,omitempty"`
+	// The maximum length of time (in nanoseconds) that an event may
+	// be held in a batch before the batch is sent. If zero, batches
+	// are sent only when they are full.
+	MaxLatency int64 `protobuf:"varint,2,opt,name=max_latency,json=maxLatency" json:"max_latency,omitempty"`
+}
+
+func (m *BatchOptions) Reset()                    { *m = BatchOptions{} }
+func (m *BatchOptions) String() string            { return proto.CompactTextString(m) }
+func (*BatchOptions) ProtoMessage()               {}
+func (*BatchOptions) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1} }
+
+func (m *BatchOptions) GetMaxEvents() uint32 {
+	if m != nil {
+		return m.MaxEvents
+	}
+	return 0
+}
+
+func (m *BatchOptions) GetMaxLatency() int64 {
+	if m != nil {
+		return m.MaxLatency
+	}
+	return 0
+}
+
+// The BackpressureOptions message specifies how events are queued for a
+// subscriber that is not receiving them as fast as they occur. When events
+// are dropped, a DroppedEventsEvent with the number of events dropped is
+// delivered to the subscriber as soon as there is room in the queue.
+type BackpressureOptions struct {
+	// The policy to apply when the queue is full.
+	Policy BackpressurePolicy `protobuf:"varint,1,opt,name=policy,enum=capsule8.api.v0.BackpressurePolicy" json:"policy,omitempty"`
+	// The maximum number of events to queue for the subscriber. If
+	// zero, the Sensor's default channel buffer length is used.
+	QueueLength uint32 `protobuf:"varint,2,opt,name=queue_length,json=queueLength" json:"queue_length,omitempty"`
+}
+
+func (m *BackpressureOptions) Reset()                    { *m = BackpressureOptions{} }
+func (m *BackpressureOptions) String() string            { return proto.CompactTextString(m) }
+func (*BackpressureOptions) ProtoMessage()               {}
+func (*BackpressureOptions) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{2} }
+
+func (m *BackpressureOptions) GetPolicy() BackpressurePolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return BackpressurePolicy_BACKPRESSURE_POLICY_BLOCK
+}
+
+func (m *BackpressureOptions) GetQueueLength() uint32 {
+	if m != nil {
+		return m.QueueLength
+	}
+	return 0
+}
+
 // The ContainerFilter restricts events in the Subscription to the
 // running containers indicated. All of the fields in this message are
 // effectively "ORed" together to create the list of containers to
@@ -162,7 +289,7 @@ type ContainerFilter struct {
 func (m *ContainerFilter) Reset()                    { *m = ContainerFilter{} }
 func (m *ContainerFilter) String() string            { return proto.CompactTextString(m) }
 func (*ContainerFilter) ProtoMessage()               {}
-func (*ContainerFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1} }
+func (*ContainerFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{3} }
 
 func (m *ContainerFilter) GetIds() []string {
 	if m != nil {
@@ -206,8 +333,24 @@ type EventFilter struct {
 	KernelEvents []*KernelFunctionCallFilter `protobuf:"bytes,4,rep,name=kernel_events,json=kernelEvents" json:"kernel_events,omitempty"`
 	// Zero or more network events to include
 	NetworkEvents []*NetworkEventFilter `protobuf:"bytes,5,rep,name=network_events,json=networkEvents" json:"network_events,omitempty"`
+	// Zero or more signal events to include
+	SignalEvents []*SignalEventFilter `protobuf:"bytes,6,rep,name=signal_events,json=signalEvents" json:"signal_events,omitempty"`
+	// Zero or more namespace events to include
+	NamespaceEvents []*NamespaceEventFilter `protobuf:"bytes,7,rep,name=namespace_events,json=namespaceEvents" json:"namespace_events,omitempty"`
+	// Zero or more mount events to include
+	MountEvents []*MountEventFilter `protobuf:"bytes,8,rep,name=mount_events,json=mountEvents" json:"mount_events,omitempty"`
+	// Zero or more kernel module events to include
+	KernelModuleEvents []*KernelModuleEventFilter `protobuf:"bytes,9,rep,name=kernel_module_events,json=kernelModuleEvents" json:"kernel_module_events,omitempty"`
+	// Zero or more process access events to include
+	ProcessAccessEvents []*ProcessAccessEventFilter `protobuf:"bytes,11,rep,name=process_access_events,json=processAccessEvents" json:"process_access_events,omitempty"`
+	// Zero or more user-space function calls to include
+	UserEvents []*UserFunctionCallFilter `protobuf:"bytes,13,rep,name=user_events,json=userEvents" json:"user_events,omitempty"`
+	// Zero or more arbitrary kernel tracepoints to include
+	TracepointEvents []*TracepointEventFilter `protobuf:"bytes,14,rep,name=tracepoint_events,json=tracepointEvents" json:"tracepoint_events,omitempty"`
 	// Zero or more container events to include
 	ContainerEvents []*ContainerEventFilter `protobuf:"bytes,10,rep,name=container_events,json=containerEvents" json:"container_events,omitempty"`
+	// Zero or more periodic container resource metrics to include
+	ContainerMetricsEvents []*ContainerMetricsEventFilter `protobuf:"bytes,12,rep,name=container_metrics_events,json=containerMetricsEvents" json:"container_metrics_events,omitempty"`
 	// Zero or more character generators to configure and return events from
 	// (for debugging)
 	ChargenEvents []*ChargenEventFilter `protobuf:"bytes,100,rep,name=chargen_events,json=chargenEvents" json:"chargen_events,omitempty"`
@@ -219,7 +362,7 @@ type EventFilter struct {
 func (m *EventFilter) Reset()                    { *m = EventFilter{} }
 func (m *EventFilter) String() string            { return proto.CompactTextString(m) }
 func (*EventFilter) ProtoMessage()               {}
-func (*EventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{2} }
+func (*EventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4} }
 
 func (m *EventFilter) GetSyscallEvents() []*SyscallEventFilter {
 	if m != nil {
@@ -256,6 +399,55 @@ func (m *EventFilter) GetNetworkEvents() []*NetworkEventFilter {
 	return nil
 }
 
+func (m *EventFilter) GetSignalEvents() []*SignalEventFilter {
+	if m != nil {
+		return m.SignalEvents
+	}
+	return nil
+}
+
+func (m *EventFilter) GetNamespaceEvents() []*NamespaceEventFilter {
+	if m != nil {
+		return m.NamespaceEvents
+	}
+	return nil
+}
+
+func (m *EventFilter) GetMountEvents() []*MountEventFilter {
+	if m != nil {
+		return m.MountEvents
+	}
+	return nil
+}
+
+func (m *EventFilter) GetKernelModuleEvents() []*KernelModuleEventFilter {
+	if m != nil {
+		return m.KernelModuleEvents
+	}
+	return nil
+}
+
+func (m *EventFilter) GetProcessAccessEvents() []*ProcessAccessEventFilter {
+	if m != nil {
+		return m.ProcessAccessEvents
+	}
+	return nil
+}
+
+func (m *EventFilter) GetUserEvents() []*UserFunctionCallFilter {
+	if m != nil {
+		return m.UserEvents
+	}
+	return nil
+}
+
+func (m *EventFilter) GetTracepointEvents() []*TracepointEventFilter {
+	if m != nil {
+		return m.TracepointEvents
+	}
+	return nil
+}
+
 func (m *EventFilter) GetContainerEvents() []*ContainerEventFilter {
 	if m != nil {
 		return m.ContainerEvents
@@ -263,6 +455,13 @@ func (m *EventFilter) GetContainerEvents() []*ContainerEventFilter {
 	return nil
 }
 
+func (m *EventFilter) GetContainerMetricsEvents() []*ContainerMetricsEventFilter {
+	if m != nil {
+		return m.ContainerMetricsEvents
+	}
+	return nil
+}
+
 func (m *EventFilter) GetChargenEvents() []*ChargenEventFilter {
 	if m != nil {
 		return m.ChargenEvents
@@ -281,9 +480,25 @@ func (m *EventFilter) GetTickerEvents() []*TickerEventFilter {
 // include in the Subscription. The specified fields are effectively
 // "ANDed" to specify a matching event.
 type SyscallEventFilter struct {
-	// Required; type of system call event (entry or exit)
-	Type             SyscallEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.SyscallEventType" json:"type,omitempty"`
-	FilterExpression *Expression      `protobuf:"bytes,100,opt,name=filter_expression,json=filterExpression" json:"filter_expression,omitempty"`
+	// Required; type of system call event (entry, exit, or complete).
+	// Complete events pair each enter event with the exit event of the
+	// same thread, and are only supported for system calls selected by
+	// name.
+	Type SyscallEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.SyscallEventType" json:"type,omitempty"`
+	// Optional, but required for complete events; the name of the system
+	// call to match (e.g., "openat"). When present, the filter does not
+	// need to filter on "id", and the filter expression for enter and
+	// complete events may use the names of the
+	// system call's arguments (e.g., "filename") as they are named in
+	// the system call's sys_enter tracepoint format.
+	Name string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	// Optional; a filter to apply to events. Unless name is present,
+	// the expression must filter on "id". The fields available are
+	// "id" and "arg0" through "arg5" for enter events, and "id" and
+	// "ret" for exit events. Complete events have the fields of both
+	// enter and exit events as well as "duration_nanos"; their filters
+	// are evaluated by the Sensor.
+	FilterExpression *Expression `protobuf:"bytes,100,opt,name=filter_expression,json=filterExpression" json:"filter_expression,omitempty"`
 	// Required; system call number from
 	// arch/x86/entry/syscalls/syscall_64.tbl
 	Id *google_protobuf1.Int64Value `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
@@ -301,7 +516,7 @@ type SyscallEventFilter struct {
 func (m *SyscallEventFilter) Reset()                    { *m = SyscallEventFilter{} }
 func (m *SyscallEventFilter) String() string            { return proto.CompactTextString(m) }
 func (*SyscallEventFilter) ProtoMessage()               {}
-func (*SyscallEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{3} }
+func (*SyscallEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5} }
 
 func (m *SyscallEventFilter) GetType() SyscallEventType {
 	if m != nil {
@@ -310,6 +525,13 @@ func (m *SyscallEventFilter) GetType() SyscallEventType {
 	return SyscallEventType_SYSCALL_EVENT_TYPE_UNKNOWN
 }
 
+func (m *SyscallEventFilter) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 func (m *SyscallEventFilter) GetFilterExpression() *Expression {
 	if m != nil {
 		return m.FilterExpression
@@ -391,7 +613,7 @@ type ProcessEventFilter struct {
 func (m *ProcessEventFilter) Reset()                    { *m = ProcessEventFilter{} }
 func (m *ProcessEventFilter) String() string            { return proto.CompactTextString(m) }
 func (*ProcessEventFilter) ProtoMessage()               {}
-func (*ProcessEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4} }
+func (*ProcessEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{6} }
 
 func (m *ProcessEventFilter) GetType() ProcessEventType {
 	if m != nil {
@@ -433,11 +655,36 @@ func (m *ProcessEventFilter) GetExitCode() *google_protobuf1.Int32Value {
 // specify a matching event.
 type FileEventFilter struct {
 	// Required; the file event type to match
-	Type             FileEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.FileEventType" json:"type,omitempty"`
-	FilterExpression *Expression   `protobuf:"bytes,100,opt,name=filter_expression,json=filterExpression" json:"filter_expression,omitempty"`
-	// Optional; require exact match on the filename being acted upon
+	Type FileEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.FileEventType" json:"type,omitempty"`
+	// Optional; for file rename events, require exact match on the
+	// filename of the file being renamed
+	OldFilename *google_protobuf1.StringValue `protobuf:"bytes,20,opt,name=old_filename,json=oldFilename" json:"old_filename,omitempty"`
+	// Optional; for file rename events, require pattern match on the
+	// filename of the file being renamed
+	OldFilenamePattern *google_protobuf1.StringValue `protobuf:"bytes,21,opt,name=old_filename_pattern,json=oldFilenamePattern" json:"old_filename_pattern,omitempty"`
+	// Optional; for file rename events, require exact match on the new
+	// filename of the file being renamed
+	NewFilename *google_protobuf1.StringValue `protobuf:"bytes,22,opt,name=new_filename,json=newFilename" json:"new_filename,omitempty"`
+	// Optional; for file rename events, require pattern match on the
+	// new filename of the file being renamed
+	NewFilenamePattern *google_protobuf1.StringValue `protobuf:"bytes,23,opt,name=new_filename_pattern,json=newFilenamePattern" json:"new_filename_pattern,omitempty"`
+	// Optional; for file permission change and directory creation
+	// events, require a match of the bits set for the mode argument
+	ModeMask *google_protobuf1.Int32Value `protobuf:"bytes,24,opt,name=mode_mask,json=modeMask" json:"mode_mask,omitempty"`
+	// Optional; for file ownership change events, require exact match
+	// on the new owner's uid
+	Uid *google_protobuf1.UInt32Value `protobuf:"bytes,25,opt,name=uid" json:"uid,omitempty"`
+	// Optional; for file ownership change events, require exact match
+	// on the new group's gid
+	Gid              *google_protobuf1.UInt32Value `protobuf:"bytes,26,opt,name=gid" json:"gid,omitempty"`
+	FilterExpression *Expression                   `protobuf:"bytes,100,opt,name=filter_expression,json=filterExpression" json:"filter_expression,omitempty"`
+	// Optional; require exact match on the filename being acted upon.
+	// For file rename events, either the old or the new filename may
+	// match.
 	Filename *google_protobuf1.StringValue `protobuf:"bytes,10,opt,name=filename" json:"filename,omitempty"`
-	// Optional; require pattern match on the filename being acted upon
+	// Optional; require pattern match on the filename being acted upon.
+	// For file rename events, either the old or the new filename may
+	// match.
 	FilenamePattern *google_protobuf1.StringValue `protobuf:"bytes,11,opt,name=filename_pattern,json=filenamePattern" json:"filename_pattern,omitempty"`
 	// Optional; for file open events, require a match of the bits set
 	// for the open(2) flags argument
@@ -450,7 +697,7 @@ type FileEventFilter struct {
 func (m *FileEventFilter) Reset()                    { *m = FileEventFilter{} }
 func (m *FileEventFilter) String() string            { return proto.CompactTextString(m) }
 func (*FileEventFilter) ProtoMessage()               {}
-func (*FileEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5} }
+func (*FileEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{7} }
 
 func (m *FileEventFilter) GetType() FileEventType {
 	if m != nil {
@@ -459,6 +706,55 @@ func (m *FileEventFilter) GetType() FileEventType {
 	return FileEventType_FILE_EVENT_TYPE_UNKNOWN
 }
 
+func (m *FileEventFilter) GetOldFilename() *google_protobuf1.StringValue {
+	if m != nil {
+		return m.OldFilename
+	}
+	return nil
+}
+
+func (m *FileEventFilter) GetOldFilenamePattern() *google_protobuf1.StringValue {
+	if m != nil {
+		return m.OldFilenamePattern
+	}
+	return nil
+}
+
+func (m *FileEventFilter) GetNewFilename() *google_protobuf1.StringValue {
+	if m != nil {
+		return m.NewFilename
+	}
+	return nil
+}
+
+func (m *FileEventFilter) GetNewFilenamePattern() *google_protobuf1.StringValue {
+	if m != nil {
+		return m.NewFilenamePattern
+	}
+	return nil
+}
+
+func (m *FileEventFilter) GetModeMask() *google_protobuf1.Int32Value {
+	if m != nil {
+		return m.ModeMask
+	}
+	return nil
+}
+
+func (m *FileEventFilter) GetUid() *google_protobuf1.UInt32Value {
+	if m != nil {
+		return m.Uid
+	}
+	return nil
+}
+
+func (m *FileEventFilter) GetGid() *google_protobuf1.UInt32Value {
+	if m != nil {
+		return m.Gid
+	}
+	return nil
+}
+
 func (m *FileEventFilter) GetFilterExpression() *Expression {
 	if m != nil {
 		return m.FilterExpression
@@ -522,7 +818,7 @@ type KernelFunctionCallFilter struct {
 func (m *KernelFunctionCallFilter) Reset()                    { *m = KernelFunctionCallFilter{} }
 func (m *KernelFunctionCallFilter) String() string            { return proto.CompactTextString(m) }
 func (*KernelFunctionCallFilter) ProtoMessage()               {}
-func (*KernelFunctionCallFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{6} }
+func (*KernelFunctionCallFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{8} }
 
 func (m *KernelFunctionCallFilter) GetType() KernelFunctionCallEventType {
 	if m != nil {
@@ -552,6 +848,120 @@ func (m *KernelFunctionCallFilter) GetFilterExpression() *Expression {
 	return nil
 }
 
+// The UserFunctionCallFilter specifies which user-space function call
+// events to include in the Subscription. The function is located in an
+// executable or shared library either by symbol name or by file offset. As
+// with KernelFunctionCallFilter, the arguments map defines values that will
+// be fetched at each call and returned along with the event, and a filter
+// may be included that filters calls based on the fetched values.
+type UserFunctionCallFilter struct {
+	// Required; the user function call event type to match
+	Type UserFunctionCallEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.UserFunctionCallEventType" json:"type,omitempty"`
+	// Required; the absolute path of the executable or shared library
+	// containing the function
+	Executable string `protobuf:"bytes,10,opt,name=executable" json:"executable,omitempty"`
+	// The symbol to match on. Either symbol or offset is required.
+	Symbol string `protobuf:"bytes,11,opt,name=symbol" json:"symbol,omitempty"`
+	// The offset of the function in the executable file. Only used if
+	// symbol is empty.
+	Offset uint64 `protobuf:"varint,12,opt,name=offset" json:"offset,omitempty"`
+	// Optional; the field names and data to be returned when the event
+	// triggers. The values are fetchargs as for KernelFunctionCallFilter,
+	// usually an expression involving a register and a type suffix.
+	Arguments map[string]string `protobuf:"bytes,13,rep,name=arguments" json:"arguments,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional; the ID of the container in which to resolve the
+	// executable path. If set, the path is resolved within the
+	// container's root filesystem.
+	ContainerId string `protobuf:"bytes,14,opt,name=container_id,json=containerId" json:"container_id,omitempty"`
+	// Optional; a filter to apply to the user probe.
+	FilterExpression *Expression `protobuf:"bytes,100,opt,name=filter_expression,json=filterExpression" json:"filter_expression,omitempty"`
+}
+
+func (m *UserFunctionCallFilter) Reset()                    { *m = UserFunctionCallFilter{} }
+func (m *UserFunctionCallFilter) String() string            { return proto.CompactTextString(m) }
+func (*UserFunctionCallFilter) ProtoMessage()               {}
+func (*UserFunctionCallFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{9} }
+
+func (m *UserFunctionCallFilter) GetType() UserFunctionCallEventType {
+	if m != nil {
+		return m.Type
+	}
+	return UserFunctionCallEventType_USER_FUNCTION_CALL_EVENT_TYPE_UNKNOWN
+}
+
+func (m *UserFunctionCallFilter) GetExecutable() string {
+	if m != nil {
+		return m.Executable
+	}
+	return ""
+}
+
+func (m *UserFunctionCallFilter) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *UserFunctionCallFilter) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *UserFunctionCallFilter) GetArguments() map[string]string {
+	if m != nil {
+		return m.Arguments
+	}
+	return nil
+}
+
+func (m *UserFunctionCallFilter) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *UserFunctionCallFilter) GetFilterExpression() *Expression {
+	if m != nil {
+		return m.FilterExpression
+	}
+	return nil
+}
+
+// The TracepointEventFilter specifies an arbitrary kernel tracepoint to
+// include in the Subscription. Events for the tracepoint include all of the
+// fields defined by the tracepoint's format.
+type TracepointEventFilter struct {
+	// Required; the tracepoint to match, as "subsystem:name" (e.g.,
+	// "sched:sched_switch"). "subsystem/name" is also accepted.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// Optional; a filter to apply to the tracepoint. The filter may use
+	// any of the tracepoint's fields.
+	FilterExpression *Expression `protobuf:"bytes,100,opt,name=filter_expression,json=filterExpression" json:"filter_expression,omitempty"`
+}
+
+func (m *TracepointEventFilter) Reset()                    { *m = TracepointEventFilter{} }
+func (m *TracepointEventFilter) String() string            { return proto.CompactTextString(m) }
+func (*TracepointEventFilter) ProtoMessage()               {}
+func (*TracepointEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{10} }
+
+func (m *TracepointEventFilter) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TracepointEventFilter) GetFilterExpression() *Expression {
+	if m != nil {
+		return m.FilterExpression
+	}
+	return nil
+}
+
 // The NetworkEventFilter specifies which network events to include in
 // the Subscription. The included filter can be used to specify
 // precisely which network events should be included.
@@ -566,7 +976,7 @@ type NetworkEventFilter struct {
 func (m *NetworkEventFilter) Reset()                    { *m = NetworkEventFilter{} }
 func (m *NetworkEventFilter) String() string            { return proto.CompactTextString(m) }
 func (*NetworkEventFilter) ProtoMessage()               {}
-func (*NetworkEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{7} }
+func (*NetworkEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{11} }
 
 func (m *NetworkEventFilter) GetType() NetworkEventType {
 	if m != nil {
@@ -582,6 +992,171 @@ func (m *NetworkEventFilter) GetFilterExpression() *Expression {
 	return nil
 }
 
+// The SignalEventFilter specifies which signal events to include in the
+// Subscription. The included filter can be used to specify precisely which
+// signal events should be included.
+type SignalEventFilter struct {
+	// Required; the signal event type to match
+	Type SignalEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.SignalEventType" json:"type,omitempty"`
+	// Optional; a filter to apply to events. Only events for which the
+	// evaluation of the filter expression is true will be returned. The
+	// fields available are those of the signal/signal_generate and
+	// signal/signal_deliver tracepoints (e.g., "sig", "pid", "result").
+	FilterExpression *Expression `protobuf:"bytes,100,opt,name=filter_expression,json=filterExpression" json:"filter_expression,omitempty"`
+}
+
+func (m *SignalEventFilter) Reset()                    { *m = SignalEventFilter{} }
+func (m *SignalEventFilter) String() string            { return proto.CompactTextString(m) }
+func (*SignalEventFilter) ProtoMessage()               {}
+func (*SignalEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{12} }
+
+func (m *SignalEventFilter) GetType() SignalEventType {
+	if m != nil {
+		return m.Type
+	}
+	return SignalEventType_SIGNAL_EVENT_TYPE_UNKNOWN
+}
+
+func (m *SignalEventFilter) GetFilterExpression() *Expression {
+	if m != nil {
+		return m.FilterExpression
+	}
+	return nil
+}
+
+// The NamespaceEventFilter specifies which namespace events to include in
+// the Subscription. The included filter can be used to specify precisely
+// which namespace events should be included.
+type NamespaceEventFilter struct {
+	// Required; the namespace event type to match
+	Type NamespaceEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.NamespaceEventType" json:"type,omitempty"`
+	// Optional; a filter to apply to events. Only events for which the
+	// evaluation of the filter expression is true will be returned. The
+	// fields available are "fd" and "nstype" for setns events and
+	// "flags" for unshare events.
+	FilterExpression *Expression `protobuf:"bytes,100,opt,name=filter_expression,json=filterExpression" json:"filter_expression,omitempty"`
+}
+
+func (m *NamespaceEventFilter) Reset()                    { *m = NamespaceEventFilter{} }
+func (m *NamespaceEventFilter) String() string            { return proto.CompactTextString(m) }
+func (*NamespaceEventFilter) ProtoMessage()               {}
+func (*NamespaceEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{13} }
+
+func (m *NamespaceEventFilter) GetType() NamespaceEventType {
+	if m != nil {
+		return m.Type
+	}
+	return NamespaceEventType_NAMESPACE_EVENT_TYPE_UNKNOWN
+}
+
+func (m *NamespaceEventFilter) GetFilterExpression() *Expression {
+	if m != nil {
+		return m.FilterExpression
+	}
+	return nil
+}
+
+// The MountEventFilter specifies which mount events to include in the
+// Subscription. The included filter can be used to specify precisely which
+// mount events should be included.
+type MountEventFilter struct {
+	// Required; the mount event type to match
+	Type MountEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.MountEventType" json:"type,omitempty"`
+	// Optional; a filter to apply to events. Only events for which the
+	// evaluation of the filter expression is true will be returned. The
+	// fields available are "source", "target", "fstype" and "flags" for
+	// mount events, "target" and "flags" for umount events, and
+	// "target" and "put_old" for pivot_root events.
+	FilterExpression *Expression `protobuf:"bytes,100,opt,name=filter_expression,json=filterExpression" json:"filter_expression,omitempty"`
+}
+
+func (m *MountEventFilter) Reset()                    { *m = MountEventFilter{} }
+func (m *MountEventFilter) String() string            { return proto.CompactTextString(m) }
+func (*MountEventFilter) ProtoMessage()               {}
+func (*MountEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{14} }
+
+func (m *MountEventFilter) GetType() MountEventType {
+	if m != nil {
+		return m.Type
+	}
+	return MountEventType_MOUNT_EVENT_TYPE_UNKNOWN
+}
+
+func (m *MountEventFilter) GetFilterExpression() *Expression {
+	if m != nil {
+		return m.FilterExpression
+	}
+	return nil
+}
+
+// The KernelModuleEventFilter specifies which kernel module events to
+// include in the Subscription. The included filter can be used to specify
+// precisely which kernel module events should be included.
+type KernelModuleEventFilter struct {
+	// Required; the kernel module event type to match
+	Type KernelModuleEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.KernelModuleEventType" json:"type,omitempty"`
+	// Optional; a filter to apply to events. Only events for which the
+	// evaluation of the filter expression is true will be returned. The
+	// fields available are "params" for load attempts, "name" and
+	// "taints" for load events, "name" and "flags" for unload attempts,
+	// and "name" for unload events.
+	FilterExpression *Expression `protobuf:"bytes,100,opt,name=filter_expression,json=filterExpression" json:"filter_expression,omitempty"`
+}
+
+func (m *KernelModuleEventFilter) Reset()                    { *m = KernelModuleEventFilter{} }
+func (m *KernelModuleEventFilter) String() string            { return proto.CompactTextString(m) }
+func (*KernelModuleEventFilter) ProtoMessage()               {}
+func (*KernelModuleEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{15} }
+
+func (m *KernelModuleEventFilter) GetType() KernelModuleEventType {
+	if m != nil {
+		return m.Type
+	}
+	return KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_UNKNOWN
+}
+
+func (m *KernelModuleEventFilter) GetFilterExpression() *Expression {
+	if m != nil {
+		return m.FilterExpression
+	}
+	return nil
+}
+
+// The ProcessAccessEventFilter specifies which process access events to
+// include in the Subscription. The included filter can be used to specify
+// precisely which process access events should be included.
+type ProcessAccessEventFilter struct {
+	// Required; the process access event type to match
+	Type ProcessAccessEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.ProcessAccessEventType" json:"type,omitempty"`
+	// Optional; a filter to apply to events. Only events for which the
+	// evaluation of the filter expression is true will be returned. The
+	// fields available are "tracer_pid", "tracee_pid", "request",
+	// "tracer_container_id", "tracee_container_id", "cross_process" and
+	// "cross_container". The "request" field is only present for ptrace
+	// events. Container IDs are resolved by the Sensor, so the filter is
+	// evaluated by the Sensor rather than by the kernel.
+	FilterExpression *Expression `protobuf:"bytes,100,opt,name=filter_expression,json=filterExpression" json:"filter_expression,omitempty"`
+}
+
+func (m *ProcessAccessEventFilter) Reset()                    { *m = ProcessAccessEventFilter{} }
+func (m *ProcessAccessEventFilter) String() string            { return proto.CompactTextString(m) }
+func (*ProcessAccessEventFilter) ProtoMessage()               {}
+func (*ProcessAccessEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{16} }
+
+func (m *ProcessAccessEventFilter) GetType() ProcessAccessEventType {
+	if m != nil {
+		return m.Type
+	}
+	return ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_UNKNOWN
+}
+
+func (m *ProcessAccessEventFilter) GetFilterExpression() *Expression {
+	if m != nil {
+		return m.FilterExpression
+	}
+	return nil
+}
+
 // The ContainerEventFilter specifies which container lifecycle events
 // to include in the Subscription. In order to restrict them to
 // specific containers, use the ContainerFilter.
@@ -598,7 +1173,7 @@ type ContainerEventFilter struct {
 func (m *ContainerEventFilter) Reset()                    { *m = ContainerEventFilter{} }
 func (m *ContainerEventFilter) String() string            { return proto.CompactTextString(m) }
 func (*ContainerEventFilter) ProtoMessage()               {}
-func (*ContainerEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{8} }
+func (*ContainerEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{17} }
 
 func (m *ContainerEventFilter) GetType() ContainerEventType {
 	if m != nil {
@@ -621,6 +1196,26 @@ func (m *ContainerEventFilter) GetFilterExpression() *Expression {
 	return nil
 }
 
+// The ContainerMetricsEventFilter configures periodic resource metrics for
+// each running container. In order to restrict them to specific containers,
+// use the ContainerFilter.
+type ContainerMetricsEventFilter struct {
+	// Required; the interval in nanoseconds at which metrics are read
+	Interval int64 `protobuf:"varint,1,opt,name=interval" json:"interval,omitempty"`
+}
+
+func (m *ContainerMetricsEventFilter) Reset()                    { *m = ContainerMetricsEventFilter{} }
+func (m *ContainerMetricsEventFilter) String() string            { return proto.CompactTextString(m) }
+func (*ContainerMetricsEventFilter) ProtoMessage()               {}
+func (*ContainerMetricsEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{18} }
+
+func (m *ContainerMetricsEventFilter) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
 // The ChargenEventFilter configures a character stream generator and
 // includes events from it in the Subscription.
 type ChargenEventFilter struct {
@@ -631,7 +1226,7 @@ type ChargenEventFilter struct {
 func (m *ChargenEventFilter) Reset()                    { *m = ChargenEventFilter{} }
 func (m *ChargenEventFilter) String() string            { return proto.CompactTextString(m) }
 func (*ChargenEventFilter) ProtoMessage()               {}
-func (*ChargenEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{9} }
+func (*ChargenEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{19} }
 
 func (m *ChargenEventFilter) GetLength() uint64 {
 	if m != nil {
@@ -650,7 +1245,7 @@ type TickerEventFilter struct {
 func (m *TickerEventFilter) Reset()                    { *m = TickerEventFilter{} }
 func (m *TickerEventFilter) String() string            { return proto.CompactTextString(m) }
 func (*TickerEventFilter) ProtoMessage()               {}
-func (*TickerEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{10} }
+func (*TickerEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{20} }
 
 func (m *TickerEventFilter) GetInterval() int64 {
 	if m != nil {
@@ -670,7 +1265,7 @@ type Modifier struct {
 func (m *Modifier) Reset()                    { *m = Modifier{} }
 func (m *Modifier) String() string            { return proto.CompactTextString(m) }
 func (*Modifier) ProtoMessage()               {}
-func (*Modifier) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{11} }
+func (*Modifier) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{21} }
 
 func (m *Modifier) GetThrottle() *ThrottleModifier {
 	if m != nil {
@@ -698,7 +1293,7 @@ type ThrottleModifier struct {
 func (m *ThrottleModifier) Reset()                    { *m = ThrottleModifier{} }
 func (m *ThrottleModifier) String() string            { return proto.CompactTextString(m) }
 func (*ThrottleModifier) ProtoMessage()               {}
-func (*ThrottleModifier) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{12} }
+func (*ThrottleModifier) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{22} }
 
 func (m *ThrottleModifier) GetInterval() int64 {
 	if m != nil {
@@ -725,7 +1320,7 @@ type LimitModifier struct {
 func (m *LimitModifier) Reset()                    { *m = LimitModifier{} }
 func (m *LimitModifier) String() string            { return proto.CompactTextString(m) }
 func (*LimitModifier) ProtoMessage()               {}
-func (*LimitModifier) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{13} }
+func (*LimitModifier) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{23} }
 
 func (m *LimitModifier) GetLimit() int64 {
 	if m != nil {
@@ -736,19 +1331,30 @@ func (m *LimitModifier) GetLimit() int64 {
 
 func init() {
 	proto.RegisterType((*Subscription)(nil), "capsule8.api.v0.Subscription")
+	proto.RegisterType((*BatchOptions)(nil), "capsule8.api.v0.BatchOptions")
+	proto.RegisterType((*BackpressureOptions)(nil), "capsule8.api.v0.BackpressureOptions")
 	proto.RegisterType((*ContainerFilter)(nil), "capsule8.api.v0.ContainerFilter")
 	proto.RegisterType((*EventFilter)(nil), "capsule8.api.v0.EventFilter")
 	proto.RegisterType((*SyscallEventFilter)(nil), "capsule8.api.v0.SyscallEventFilter")
 	proto.RegisterType((*ProcessEventFilter)(nil), "capsule8.api.v0.ProcessEventFilter")
 	proto.RegisterType((*FileEventFilter)(nil), "capsule8.api.v0.FileEventFilter")
 	proto.RegisterType((*KernelFunctionCallFilter)(nil), "capsule8.api.v0.KernelFunctionCallFilter")
+	proto.RegisterType((*UserFunctionCallFilter)(nil), "capsule8.api.v0.UserFunctionCallFilter")
+	proto.RegisterType((*TracepointEventFilter)(nil), "capsule8.api.v0.TracepointEventFilter")
 	proto.RegisterType((*NetworkEventFilter)(nil), "capsule8.api.v0.NetworkEventFilter")
+	proto.RegisterType((*SignalEventFilter)(nil), "capsule8.api.v0.SignalEventFilter")
+	proto.RegisterType((*NamespaceEventFilter)(nil), "capsule8.api.v0.NamespaceEventFilter")
+	proto.RegisterType((*MountEventFilter)(nil), "capsule8.api.v0.MountEventFilter")
+	proto.RegisterType((*KernelModuleEventFilter)(nil), "capsule8.api.v0.KernelModuleEventFilter")
+	proto.RegisterType((*ProcessAccessEventFilter)(nil), "capsule8.api.v0.ProcessAccessEventFilter")
 	proto.RegisterType((*ContainerEventFilter)(nil), "capsule8.api.v0.ContainerEventFilter")
+	proto.RegisterType((*ContainerMetricsEventFilter)(nil), "capsule8.api.v0.ContainerMetricsEventFilter")
 	proto.RegisterType((*ChargenEventFilter)(nil), "capsule8.api.v0.ChargenEventFilter")
 	proto.RegisterType((*TickerEventFilter)(nil), "capsule8.api.v0.TickerEventFilter")
 	proto.RegisterType((*Modifier)(nil), "capsule8.api.v0.Modifier")
 	proto.RegisterType((*ThrottleModifier)(nil), "capsule8.api.v0.ThrottleModifier")
 	proto.RegisterType((*LimitModifier)(nil), "capsule8.api.v0.LimitModifier")
+	proto.RegisterEnum("capsule8.api.v0.BackpressurePolicy", BackpressurePolicy_name, BackpressurePolicy_value)
 	proto.RegisterEnum("capsule8.api.v0.ContainerEventView", ContainerEventView_name, ContainerEventView_value)
 	proto.RegisterEnum("capsule8.api.v0.ThrottleModifier_IntervalType", ThrottleModifier_IntervalType_name, ThrottleModifier_IntervalType_value)
 }
@@ -756,80 +1362,125 @@ func init() {
 func init() { proto.RegisterFile("capsule8/api/v0/subscription.proto", fileDescriptor3) }
 
 var fileDescriptor3 = []byte{
-	// 1194 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdb, 0x6e, 0x1a, 0x47,
-	0x18, 0x0e, 0x07, 0x47, 0xf0, 0x73, 0xcc, 0xd4, 0xad, 0xa8, 0x13, 0xa5, 0xee, 0x46, 0x96, 0x92,
-	0x36, 0x05, 0x07, 0xdb, 0x8d, 0x55, 0xf5, 0xe4, 0x10, 0x93, 0xd0, 0xd8, 0x8e, 0xb5, 0xd8, 0xbe,
-	0x45, 0xeb, 0xdd, 0x01, 0x8f, 0x58, 0x76, 0x57, 0x33, 0x03, 0x36, 0x57, 0x7d, 0x8a, 0x5e, 0xb6,
-	0x8f, 0xd3, 0x07, 0xa8, 0x2a, 0xf5, 0x05, 0x7a, 0xd7, 0x97, 0xa8, 0xe6, 0xb0, 0xb0, 0xb0, 0xc1,
-	0x70, 0x11, 0xdf, 0xed, 0xfc, 0xf3, 0x7d, 0x1f, 0xff, 0x69, 0xfe, 0x19, 0xc0, 0xb0, 0xad, 0x80,
-	0x0d, 0x5d, 0xbc, 0x5f, 0xb3, 0x02, 0x52, 0x1b, 0x6d, 0xd7, 0xd8, 0xf0, 0x92, 0xd9, 0x94, 0x04,
-	0x9c, 0xf8, 0x5e, 0x35, 0xa0, 0x3e, 0xf7, 0x51, 0x29, 0xc4, 0x54, 0xad, 0x80, 0x54, 0x47, 0xdb,
-	0x1b, 0x5b, 0xf3, 0x24, 0x8e, 0x5d, 0x3c, 0xc0, 0x9c, 0x8e, 0x3b, 0x78, 0x84, 0x3d, 0xae, 0x78,
-	0x1b, 0x9b, 0xf3, 0x30, 0x7c, 0x13, 0x50, 0xcc, 0xd8, 0x44, 0x79, 0xe3, 0x71, 0xcf, 0xf7, 0x7b,
-	0x2e, 0xae, 0xc9, 0xd5, 0xe5, 0xb0, 0x5b, 0xbb, 0xa6, 0x56, 0x10, 0x60, 0xca, 0xd4, 0xbe, 0xf1,
-	0x4f, 0x12, 0xf2, 0xed, 0x88, 0x43, 0xe8, 0x27, 0xc8, 0xcb, 0x5f, 0xe8, 0x74, 0x89, 0xcb, 0x31,
-	0xad, 0x24, 0x36, 0x13, 0x4f, 0x73, 0xf5, 0x47, 0xd5, 0x39, 0x0f, 0xab, 0x87, 0x02, 0xd4, 0x94,
-	0x18, 0x33, 0x87, 0xa7, 0x0b, 0xf4, 0x0e, 0xca, 0xb6, 0xef, 0x71, 0x8b, 0x78, 0x98, 0x86, 0x22,
-	0x49, 0x29, 0xb2, 0x19, 0x13, 0x69, 0x84, 0x40, 0x2d, 0x54, 0xb2, 0x67, 0x0d, 0xe8, 0x15, 0x14,
-	0x19, 0xf1, 0x6c, 0xdc, 0x71, 0x86, 0xd4, 0x12, 0xfe, 0x55, 0x40, 0x4a, 0x3d, 0xac, 0xaa, 0xb8,
-	0xaa, 0x61, 0x5c, 0xd5, 0x96, 0xc7, 0xbf, 0xdd, 0xbd, 0xb0, 0xdc, 0x21, 0x36, 0x0b, 0x92, 0xf2,
-	0x5a, 0x33, 0xd0, 0x8f, 0x90, 0xef, 0xfa, 0x74, 0xaa, 0x90, 0x5b, 0xae, 0x90, 0xeb, 0xfa, 0x74,
-	0xc2, 0xdf, 0x83, 0xcc, 0xc0, 0x77, 0x48, 0x97, 0x60, 0x5a, 0x59, 0x97, 0xdc, 0xcf, 0x63, 0x81,
-	0x1c, 0x6b, 0x80, 0x39, 0x81, 0x1a, 0xd7, 0x50, 0x9a, 0x0b, 0x0f, 0x95, 0x21, 0x45, 0x1c, 0x56,
-	0x49, 0x6c, 0xa6, 0x9e, 0x66, 0x4d, 0xf1, 0x89, 0xd6, 0x61, 0xcd, 0xb3, 0x06, 0x98, 0x55, 0x92,
-	0xd2, 0xa6, 0x16, 0xe8, 0x21, 0x64, 0xc9, 0xc0, 0xea, 0xe1, 0x8e, 0x40, 0xa7, 0xe4, 0x4e, 0x46,
-	0x1a, 0x5a, 0x0e, 0x43, 0x5f, 0x40, 0x4e, 0x6d, 0x2a, 0x62, 0x5a, 0x6e, 0x83, 0x34, 0x9d, 0x08,
-	0x8b, 0xf1, 0x5f, 0x1a, 0x72, 0x91, 0xea, 0xa0, 0x5f, 0xa0, 0xc8, 0xc6, 0xcc, 0xb6, 0x5c, 0x57,
-	0xf5, 0x8e, 0x72, 0x20, 0x57, 0x7f, 0x12, 0x8b, 0xa2, 0xad, 0x60, 0xd1, 0xd2, 0x16, 0x58, 0xc4,
-	0xc6, 0x84, 0x56, 0x40, 0x7d, 0x1b, 0x33, 0x16, 0x6a, 0x25, 0x17, 0x68, 0x9d, 0x2a, 0xd8, 0x8c,
-	0x56, 0x10, 0xb1, 0x31, 0x74, 0x00, 0xb9, 0x2e, 0x71, 0x71, 0x28, 0x94, 0x92, 0x42, 0xf1, 0x1e,
-	0x69, 0x12, 0x17, 0x47, 0x55, 0xa0, 0x1b, 0x1a, 0x18, 0x3a, 0x81, 0x42, 0x1f, 0x53, 0x0f, 0x4f,
-	0x22, 0x4b, 0x4b, 0x91, 0x67, 0x31, 0x91, 0x77, 0x12, 0xd5, 0x1c, 0x7a, 0xb6, 0x28, 0x69, 0xc3,
-	0x72, 0x5d, 0xad, 0x96, 0x57, 0xfc, 0x69, 0x78, 0x1e, 0xe6, 0xd7, 0x3e, 0xed, 0x87, 0x82, 0x6b,
-	0x0b, 0xc2, 0x3b, 0x51, 0xb0, 0x99, 0xf0, 0xbc, 0x88, 0x8d, 0xa1, 0xd3, 0xe8, 0x39, 0xd0, 0x6a,
-	0x20, 0xd5, 0xb6, 0x16, 0x9f, 0x83, 0xa8, 0xde, 0xf4, 0x30, 0x4c, 0xbd, 0xb3, 0xaf, 0x2c, 0xda,
-	0xc3, 0x5e, 0xa8, 0xe7, 0x2c, 0xf0, 0xae, 0xa1, 0x60, 0x33, 0xde, 0xd9, 0x11, 0x1b, 0x43, 0x6f,
-	0xa0, 0xc0, 0x89, 0xdd, 0x9f, 0xba, 0x86, 0xa5, 0x94, 0x11, 0x93, 0x3a, 0x93, 0xa8, 0xa8, 0x52,
-	0x9e, 0x4f, 0x4d, 0xcc, 0xf8, 0x3d, 0x0d, 0x28, 0xde, 0x37, 0x68, 0x0f, 0xd2, 0x7c, 0x1c, 0x60,
-	0x39, 0x3e, 0x8a, 0xf5, 0x2f, 0x6f, 0x6d, 0xb5, 0xb3, 0x71, 0x80, 0x4d, 0x09, 0x47, 0x6f, 0xe1,
-	0x81, 0x1a, 0x19, 0x9d, 0xe9, 0x24, 0xab, 0x38, 0xfa, 0xc0, 0xc6, 0x46, 0xd0, 0x04, 0x62, 0x96,
-	0x15, 0x6b, 0x6a, 0x41, 0x5f, 0x43, 0x92, 0x38, 0x7a, 0xf0, 0xdc, 0x7a, 0xd6, 0x93, 0xc4, 0x41,
-	0xdb, 0x90, 0xb6, 0x68, 0x6f, 0x5b, 0x0f, 0x97, 0x47, 0x31, 0xf8, 0x79, 0x04, 0x2f, 0x91, 0x9a,
-	0xf1, 0x42, 0x0f, 0x93, 0xe5, 0x8c, 0x17, 0x9a, 0x51, 0xaf, 0xe4, 0x57, 0x64, 0xd4, 0x35, 0x63,
-	0xa7, 0x52, 0x58, 0x91, 0xb1, 0xa3, 0x19, 0xbb, 0x95, 0xe2, 0x8a, 0x8c, 0x5d, 0xcd, 0xd8, 0xab,
-	0x94, 0x56, 0x64, 0xec, 0xa1, 0x6f, 0x20, 0x45, 0x31, 0xd7, 0x93, 0xf0, 0xd6, 0xcc, 0x0a, 0x9c,
-	0xf1, 0x6f, 0x12, 0x50, 0x7c, 0x16, 0x2c, 0xed, 0x8f, 0x28, 0xe5, 0x4e, 0xfa, 0xe3, 0x00, 0x0a,
-	0xf8, 0x06, 0xdb, 0xe2, 0x86, 0xc2, 0x62, 0x92, 0x2e, 0xac, 0x4b, 0x9b, 0x53, 0xe2, 0xf5, 0x54,
-	0x44, 0x79, 0x41, 0x69, 0x6a, 0x06, 0x3a, 0x85, 0x4f, 0x67, 0x24, 0x3a, 0x81, 0xc5, 0x39, 0xa6,
-	0xde, 0xc2, 0x82, 0x45, 0xa5, 0x3e, 0x89, 0x4a, 0x9d, 0x2a, 0x22, 0xda, 0x87, 0x2c, 0xbe, 0x21,
-	0xbc, 0x63, 0xfb, 0x0e, 0xd6, 0x45, 0xfc, 0x60, 0x86, 0x77, 0xea, 0x4a, 0x24, 0x23, 0xd0, 0x0d,
-	0xdf, 0xc1, 0xc6, 0x1f, 0x29, 0x28, 0xcd, 0x4d, 0x4a, 0x54, 0x9f, 0xc9, 0xf1, 0xe3, 0xc5, 0x93,
-	0xf5, 0x4e, 0x12, 0xbc, 0x0f, 0x99, 0x49, 0x6e, 0x61, 0x85, 0x84, 0x4c, 0xd0, 0xe8, 0x0d, 0x94,
-	0x63, 0x29, 0xcd, 0xad, 0xa0, 0x50, 0xea, 0xce, 0xa5, 0xb3, 0x01, 0x25, 0x3f, 0xc0, 0x5e, 0xa7,
-	0xeb, 0x5a, 0x3d, 0xd6, 0x19, 0x58, 0xac, 0xaf, 0xab, 0x7c, 0x6b, 0x52, 0x0b, 0x82, 0xd3, 0x14,
-	0x94, 0x63, 0x8b, 0xf5, 0xd1, 0x21, 0x94, 0x6d, 0x8a, 0x2d, 0x8e, 0x3b, 0x03, 0xdf, 0xc1, 0x4a,
-	0xa5, 0xb0, 0x5c, 0xa5, 0xa8, 0x48, 0xc7, 0xbe, 0x83, 0x85, 0x8c, 0xf1, 0x77, 0x12, 0x2a, 0x8b,
-	0x6e, 0x21, 0xf4, 0xf3, 0x4c, 0xa5, 0x9e, 0xaf, 0x70, 0x7d, 0xcd, 0xd7, 0xed, 0x33, 0xb8, 0xcf,
-	0xc6, 0x83, 0x4b, 0xdf, 0x95, 0xb9, 0xce, 0x9a, 0x7a, 0x85, 0x2e, 0x20, 0x6b, 0xd1, 0xde, 0x70,
-	0x20, 0x67, 0x7c, 0x4e, 0xce, 0xf8, 0xfd, 0x95, 0x6f, 0xc7, 0xea, 0x41, 0x48, 0x3d, 0xf4, 0x38,
-	0x1d, 0x9b, 0x53, 0xa9, 0x8f, 0xd7, 0x27, 0x1b, 0xdf, 0x43, 0x71, 0xf6, 0x67, 0xc4, 0x33, 0xa9,
-	0x8f, 0xc7, 0x32, 0x19, 0x59, 0x53, 0x7c, 0x8a, 0x67, 0xd2, 0x48, 0x64, 0x55, 0xce, 0xf3, 0xac,
-	0xa9, 0x16, 0xdf, 0x25, 0xf7, 0x13, 0xc6, 0x6f, 0x09, 0x40, 0xf1, 0xbb, 0x78, 0xe9, 0x78, 0x89,
-	0x52, 0xee, 0xa2, 0xfb, 0x8d, 0xbf, 0x12, 0xb0, 0xfe, 0xa1, 0x5b, 0x1d, 0xbd, 0x9c, 0xf1, 0xec,
-	0xc9, 0x92, 0xa7, 0x40, 0xc4, 0xb7, 0x97, 0x90, 0x1e, 0x11, 0x7c, 0x2d, 0x53, 0xb0, 0x9c, 0x78,
-	0x41, 0xf0, 0xb5, 0x29, 0x09, 0x1f, 0x31, 0xa8, 0xe7, 0x80, 0xe2, 0x2f, 0x0b, 0xd1, 0x7a, 0x2e,
-	0xf6, 0x7a, 0xfc, 0x4a, 0xc6, 0x94, 0x36, 0xf5, 0xca, 0xa8, 0xc1, 0x83, 0xd8, 0xe3, 0x01, 0x6d,
-	0x40, 0x86, 0x78, 0x1c, 0xd3, 0x91, 0xe5, 0x4a, 0x78, 0xca, 0x9c, 0xac, 0x8d, 0x5f, 0x21, 0x13,
-	0xbe, 0xa3, 0xd1, 0x0f, 0x90, 0xe1, 0x57, 0xd4, 0xe7, 0xdc, 0xc5, 0xfa, 0x2f, 0x48, 0xbc, 0x88,
-	0x67, 0x1a, 0x30, 0x7d, 0x7c, 0x87, 0x14, 0xb4, 0x0b, 0x6b, 0x2e, 0x19, 0x10, 0xae, 0x1f, 0x00,
-	0xf1, 0xd9, 0x77, 0x24, 0x76, 0x27, 0x44, 0x05, 0x36, 0xfe, 0x4c, 0x40, 0x79, 0x5e, 0xf4, 0x36,
-	0x8f, 0x51, 0x1b, 0x0a, 0xe1, 0x77, 0x47, 0x56, 0x55, 0x15, 0xa7, 0xba, 0xd4, 0x55, 0x31, 0x29,
-	0x24, 0x4d, 0x16, 0x38, 0x4f, 0x22, 0x2b, 0xe3, 0x00, 0xf2, 0xd1, 0x5d, 0x54, 0x82, 0xdc, 0x71,
-	0xeb, 0xe8, 0xa8, 0xd5, 0x3e, 0x6c, 0xbc, 0x3f, 0x79, 0x5d, 0xbe, 0x87, 0x00, 0xee, 0xeb, 0xef,
-	0x84, 0xf8, 0x3e, 0x6e, 0x9d, 0x9c, 0x9f, 0x1d, 0x96, 0x93, 0x28, 0x03, 0xe9, 0xb7, 0xef, 0xcf,
-	0xcd, 0x72, 0xca, 0xd8, 0x82, 0xc2, 0x4c, 0x80, 0xe2, 0x00, 0xa9, 0x7c, 0xa8, 0x08, 0xd4, 0xe2,
-	0xab, 0x67, 0x80, 0xe2, 0x5d, 0x83, 0xb2, 0xb0, 0xf6, 0xea, 0xa0, 0xdd, 0x6a, 0x94, 0xef, 0x09,
-	0xc5, 0xe6, 0xf9, 0xd1, 0x51, 0x39, 0x71, 0x79, 0x5f, 0xce, 0xb8, 0x9d, 0xff, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0xf7, 0x49, 0x8a, 0x4a, 0xce, 0x0e, 0x00, 0x00,
+	// 1907 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcb, 0x72, 0xdb, 0xc8,
+	0xd5, 0x36, 0x48, 0x5a, 0x3f, 0x79, 0x08, 0x8a, 0x74, 0x5b, 0xd6, 0xc0, 0xf2, 0xd8, 0x96, 0xe1,
+	0xdf, 0x33, 0x1a, 0xc7, 0xa1, 0x3c, 0x92, 0x3c, 0xa3, 0xcc, 0x24, 0xe3, 0x48, 0x14, 0x35, 0x66,
+	0x4c, 0x5d, 0x0a, 0x94, 0x9c, 0x4a, 0xaa, 0x52, 0x2c, 0x08, 0x68, 0x52, 0x28, 0x81, 0x00, 0x82,
+	0x06, 0x74, 0x59, 0xa4, 0xf2, 0x06, 0xa9, 0x4a, 0xa5, 0xb2, 0x98, 0x55, 0x2a, 0xcb, 0x2c, 0xf3,
+	0x12, 0x79, 0x80, 0x54, 0x56, 0x59, 0xe7, 0x41, 0x52, 0xdd, 0xb8, 0x35, 0x08, 0x5e, 0xb0, 0xb0,
+	0xaa, 0xb2, 0x43, 0x9f, 0xfe, 0xbe, 0x8f, 0xa7, 0xcf, 0xe9, 0xcb, 0x39, 0x04, 0x59, 0x53, 0x1d,
+	0xe2, 0x9b, 0x78, 0x7b, 0x5d, 0x75, 0x8c, 0xf5, 0xcb, 0xd7, 0xeb, 0xc4, 0x3f, 0x23, 0x9a, 0x6b,
+	0x38, 0x9e, 0x61, 0x5b, 0x4d, 0xc7, 0xb5, 0x3d, 0x1b, 0xd5, 0x23, 0x4c, 0x53, 0x75, 0x8c, 0xe6,
+	0xe5, 0xeb, 0x95, 0x17, 0xe3, 0x24, 0x0f, 0x9b, 0x78, 0x84, 0x3d, 0xf7, 0xa6, 0x8f, 0x2f, 0xb1,
+	0xe5, 0x05, 0xbc, 0x95, 0xd5, 0x71, 0x18, 0xbe, 0x76, 0x5c, 0x4c, 0x48, 0xac, 0xbc, 0xf2, 0x64,
+	0x68, 0xdb, 0x43, 0x13, 0xaf, 0xb3, 0xd1, 0x99, 0x3f, 0x58, 0xbf, 0x72, 0x55, 0xc7, 0xc1, 0x2e,
+	0x09, 0xe6, 0xe5, 0x1f, 0x4a, 0x20, 0xf6, 0x38, 0x87, 0xd0, 0x5b, 0x10, 0xd9, 0x2f, 0xf4, 0x07,
+	0x86, 0xe9, 0x61, 0x57, 0x12, 0x56, 0x85, 0xb5, 0xea, 0xc6, 0xa7, 0xcd, 0x31, 0x0f, 0x9b, 0x6d,
+	0x0a, 0xda, 0x67, 0x18, 0xa5, 0x8a, 0x93, 0x01, 0x7a, 0x0f, 0x0d, 0xcd, 0xb6, 0x3c, 0xd5, 0xb0,
+	0xb0, 0x1b, 0x89, 0x14, 0x98, 0xc8, 0x6a, 0x46, 0xa4, 0x15, 0x01, 0x43, 0xa1, 0xba, 0x96, 0x36,
+	0xa0, 0x5d, 0x58, 0x24, 0x86, 0xa5, 0xe1, 0xbe, 0xee, 0xbb, 0x2a, 0xf5, 0x4f, 0x02, 0x26, 0xf5,
+	0xa8, 0x19, 0xac, 0xab, 0x19, 0xad, 0xab, 0xd9, 0xb1, 0xbc, 0xaf, 0xb6, 0x3e, 0xa8, 0xa6, 0x8f,
+	0x95, 0x1a, 0xa3, 0xec, 0x85, 0x0c, 0xf4, 0x1d, 0x88, 0x03, 0xdb, 0x4d, 0x14, 0xaa, 0xf3, 0x15,
+	0xaa, 0x03, 0xdb, 0x8d, 0xf9, 0x6f, 0xa0, 0x3c, 0xb2, 0x75, 0x63, 0x60, 0x60, 0x57, 0x5a, 0x62,
+	0xdc, 0x87, 0x99, 0x85, 0x1c, 0x84, 0x00, 0x25, 0x86, 0xa2, 0x5d, 0xa8, 0x9d, 0xa9, 0x9e, 0x76,
+	0xde, 0xb7, 0x59, 0x60, 0x89, 0xf4, 0x84, 0x71, 0x1f, 0x67, 0xb8, 0xbb, 0x14, 0x75, 0x14, 0x80,
+	0x14, 0xf1, 0x8c, 0x1b, 0xa1, 0x77, 0x20, 0x9e, 0xa9, 0xda, 0x05, 0xcb, 0xa9, 0xef, 0x62, 0x69,
+	0x8d, 0x49, 0xfc, 0xff, 0x04, 0x89, 0x04, 0xc4, 0x29, 0x25, 0x46, 0xb4, 0x01, 0x0f, 0x1c, 0xd7,
+	0xd6, 0x30, 0x21, 0x7d, 0xd3, 0xb0, 0xb0, 0x3a, 0xc4, 0x7d, 0x1d, 0x3b, 0xde, 0xb9, 0xb4, 0xb1,
+	0x2a, 0xac, 0xd5, 0x94, 0xfb, 0xe1, 0x64, 0x37, 0x98, 0xdb, 0xa3, 0x53, 0xf2, 0x21, 0x88, 0xbc,
+	0x6f, 0xe8, 0x31, 0xc0, 0x48, 0xbd, 0x0e, 0x36, 0x20, 0x61, 0x1b, 0xa3, 0xa6, 0x54, 0x46, 0xea,
+	0x35, 0xdb, 0x0a, 0x04, 0x3d, 0x85, 0x2a, 0x9d, 0x36, 0x55, 0x0f, 0x5b, 0xda, 0x0d, 0xcb, 0x79,
+	0x51, 0xa1, 0x8c, 0x6e, 0x60, 0x91, 0x7d, 0xb8, 0x3f, 0xc1, 0x51, 0xf4, 0x2d, 0x2c, 0x38, 0xb6,
+	0x69, 0x68, 0x37, 0x4c, 0x72, 0x71, 0xe3, 0xf9, 0xcc, 0xe5, 0x1d, 0x33, 0xa8, 0x12, 0x52, 0xd0,
+	0x33, 0x10, 0x7f, 0xeb, 0x63, 0x1f, 0xf7, 0x4d, 0x6c, 0x0d, 0xbd, 0x73, 0xf6, 0xab, 0x35, 0xa5,
+	0xca, 0x6c, 0x5d, 0x66, 0x92, 0xaf, 0xa0, 0x3e, 0xb6, 0xcf, 0x50, 0x03, 0x8a, 0x86, 0x4e, 0x97,
+	0x50, 0x5c, 0xab, 0x28, 0xf4, 0x13, 0x2d, 0xc1, 0x5d, 0x4b, 0x1d, 0x61, 0x22, 0x15, 0x98, 0x2d,
+	0x18, 0xa0, 0x47, 0x50, 0x31, 0x46, 0x34, 0x56, 0x14, 0x5d, 0x64, 0x33, 0x65, 0x66, 0xe8, 0xe8,
+	0x6c, 0xbd, 0xc1, 0x64, 0x40, 0x2c, 0xb1, 0x69, 0x60, 0xa6, 0x43, 0x6a, 0x91, 0xff, 0x00, 0x50,
+	0xe5, 0x8e, 0x09, 0xfa, 0x05, 0x2c, 0x92, 0x1b, 0xa2, 0xa9, 0xa6, 0x99, 0xc4, 0xb0, 0xb8, 0x56,
+	0x9d, 0xb0, 0xe0, 0x5e, 0x00, 0xe3, 0xcf, 0x58, 0x8d, 0x70, 0x36, 0x42, 0xb5, 0xa2, 0x7c, 0x86,
+	0x5a, 0x85, 0x29, 0x5a, 0xc7, 0x01, 0x2c, 0xa5, 0xe5, 0x70, 0x36, 0x82, 0x76, 0xa0, 0x3a, 0x30,
+	0x4c, 0x1c, 0x09, 0x15, 0x57, 0x8b, 0x13, 0x0f, 0xeb, 0xbe, 0x61, 0x62, 0x5e, 0x05, 0x06, 0x91,
+	0x81, 0xa0, 0x43, 0xa8, 0x5d, 0x60, 0xd7, 0xc2, 0xf1, 0xca, 0x4a, 0x4c, 0xe4, 0x8b, 0x8c, 0xc8,
+	0x7b, 0x86, 0xda, 0xf7, 0x2d, 0x8d, 0x26, 0xbf, 0xa5, 0x9a, 0x66, 0xa8, 0x26, 0x06, 0xfc, 0x64,
+	0x79, 0x16, 0xf6, 0xae, 0x6c, 0xf7, 0x22, 0x12, 0xbc, 0x3b, 0x65, 0x79, 0x87, 0x01, 0x2c, 0xb5,
+	0x3c, 0x8b, 0xb3, 0x11, 0xf4, 0x3d, 0xd4, 0x88, 0x31, 0xb4, 0xd4, 0xd8, 0xb7, 0x05, 0x26, 0x25,
+	0x67, 0xa3, 0xce, 0x50, 0xbc, 0x92, 0x48, 0x12, 0x13, 0x41, 0xc7, 0xd0, 0x60, 0xa9, 0x76, 0x54,
+	0x2d, 0x0e, 0xd6, 0xff, 0x31, 0xad, 0x17, 0x59, 0xb7, 0x22, 0x20, 0x2f, 0x57, 0xb7, 0x52, 0x56,
+	0x82, 0xf6, 0x40, 0x1c, 0xd9, 0xbe, 0xe5, 0x45, 0x6a, 0x65, 0xa6, 0xf6, 0x6c, 0xc2, 0xf5, 0xe2,
+	0x5b, 0x5e, 0xea, 0xc6, 0x1d, 0xc5, 0x16, 0x82, 0x7e, 0x0d, 0x4b, 0x61, 0xf0, 0x47, 0xb6, 0xee,
+	0x27, 0x89, 0xac, 0x30, 0xb5, 0xb5, 0x29, 0x39, 0x38, 0x60, 0x58, 0x5e, 0x14, 0x5d, 0x8c, 0x4f,
+	0x10, 0xf4, 0x9b, 0xe4, 0xde, 0x50, 0x35, 0x7e, 0xbb, 0x55, 0xa7, 0x24, 0x38, 0xdc, 0x6e, 0x3b,
+	0xda, 0xf8, 0xa6, 0xbb, 0xef, 0x64, 0x66, 0xe8, 0x05, 0x57, 0xf5, 0x09, 0x76, 0x23, 0xd1, 0x1a,
+	0x13, 0xfd, 0x3c, 0x23, 0x7a, 0x4a, 0xb0, 0x3b, 0x61, 0xcf, 0x00, 0xe5, 0x86, 0x4a, 0x3d, 0xb8,
+	0xe7, 0xb9, 0xaa, 0x86, 0x1d, 0xdb, 0x48, 0xe2, 0xb9, 0xc8, 0xf4, 0x3e, 0xcb, 0xe8, 0x9d, 0xc4,
+	0x48, 0xde, 0xc3, 0x86, 0x97, 0x36, 0xb3, 0x8c, 0x27, 0x6f, 0x59, 0xa8, 0x09, 0x53, 0x32, 0x1e,
+	0xdf, 0x31, 0xa9, 0x8c, 0x6b, 0x29, 0x2b, 0x41, 0x03, 0x90, 0x12, 0x45, 0xfa, 0xa0, 0x1b, 0x5a,
+	0x1c, 0x52, 0x91, 0x29, 0xbf, 0x9a, 0xae, 0x7c, 0x10, 0xe0, 0xf9, 0x1f, 0x58, 0xd6, 0x26, 0x4d,
+	0xb2, 0x03, 0xa4, 0x9d, 0xab, 0xee, 0x10, 0x5b, 0x91, 0xba, 0x3e, 0xe5, 0x00, 0xb5, 0x02, 0x58,
+	0xea, 0x00, 0x69, 0x9c, 0x8d, 0x1d, 0x20, 0xcf, 0xd0, 0x2e, 0x92, 0x10, 0xe0, 0x29, 0x07, 0xe8,
+	0x84, 0xa1, 0x52, 0x07, 0xc8, 0x4b, 0x4c, 0x44, 0xfe, 0x7b, 0x09, 0x50, 0xf6, 0x6a, 0x43, 0x6f,
+	0xa0, 0xe4, 0xdd, 0x38, 0x38, 0xbc, 0xfe, 0x9f, 0xcd, 0xbc, 0x0d, 0x4f, 0x6e, 0x1c, 0xac, 0x30,
+	0x38, 0x42, 0x50, 0xa2, 0xe7, 0x49, 0x2a, 0xae, 0x0a, 0x6b, 0x15, 0x85, 0x7d, 0xa3, 0x77, 0x70,
+	0x2f, 0x28, 0x39, 0xfa, 0x49, 0x25, 0x24, 0xe9, 0xe1, 0x83, 0x9f, 0x29, 0x61, 0x62, 0x88, 0xd2,
+	0x08, 0x58, 0x89, 0x05, 0xfd, 0x08, 0x0a, 0x86, 0x2e, 0x15, 0xe6, 0xd7, 0x0a, 0x05, 0x43, 0x47,
+	0xaf, 0xa1, 0xa4, 0xba, 0xc3, 0xd7, 0x61, 0x71, 0xf2, 0x69, 0x06, 0x7e, 0xca, 0xe1, 0x19, 0x32,
+	0x64, 0x7c, 0x29, 0x55, 0x73, 0x32, 0xbe, 0x0c, 0x19, 0x1b, 0x92, 0x98, 0x93, 0xb1, 0x11, 0x32,
+	0x36, 0xa5, 0x5a, 0x4e, 0xc6, 0x66, 0xc8, 0xd8, 0x92, 0x16, 0x73, 0x32, 0xb6, 0x42, 0xc6, 0x1b,
+	0xa9, 0x9e, 0x93, 0xf1, 0x06, 0xfd, 0x18, 0x8a, 0x2e, 0xf6, 0xa4, 0xa5, 0xf9, 0x91, 0xa5, 0x38,
+	0xf9, 0x3f, 0x05, 0x40, 0xd9, 0x27, 0x6c, 0xee, 0x9e, 0xe1, 0x29, 0xdc, 0x9e, 0xf9, 0x78, 0xfb,
+	0x63, 0x07, 0x6a, 0xf8, 0x1a, 0x6b, 0xb4, 0xc2, 0xc5, 0x6c, 0x1b, 0x4e, 0xcb, 0x4b, 0xcf, 0x73,
+	0x0d, 0x6b, 0x18, 0xac, 0x48, 0xa4, 0x94, 0xfd, 0x90, 0x81, 0x8e, 0xe1, 0x41, 0x4a, 0xa2, 0xef,
+	0xa8, 0x9e, 0x87, 0x5d, 0x4b, 0xaa, 0xe5, 0x90, 0xba, 0xcf, 0x4b, 0x1d, 0x07, 0x44, 0xb4, 0x0d,
+	0x15, 0x7c, 0x6d, 0x78, 0x7d, 0xcd, 0xd6, 0xb1, 0xb4, 0x38, 0x3d, 0xc2, 0x9b, 0x1b, 0x81, 0x48,
+	0x99, 0xa2, 0x5b, 0xb6, 0x8e, 0xe5, 0x7f, 0x2f, 0x40, 0x7d, 0xec, 0x81, 0x47, 0x1b, 0xa9, 0x18,
+	0x3f, 0x99, 0x5e, 0x10, 0x70, 0x01, 0x7e, 0x0b, 0xa2, 0x6d, 0xea, 0x49, 0x54, 0x96, 0x72, 0x2c,
+	0xa5, 0x6a, 0x9b, 0x7a, 0x1c, 0x94, 0x43, 0x58, 0xe2, 0x05, 0xe2, 0x98, 0x3c, 0xc8, 0x21, 0x84,
+	0x38, 0xa1, 0x28, 0x24, 0x6f, 0x41, 0xb4, 0xf0, 0x55, 0xe2, 0xd0, 0x72, 0x1e, 0x87, 0x2c, 0x7c,
+	0xc5, 0x3b, 0xc4, 0x0b, 0xc4, 0x0e, 0x7d, 0x92, 0xc7, 0x21, 0x4e, 0x88, 0xcb, 0xd1, 0xc8, 0xd6,
+	0x71, 0x7f, 0xa4, 0x92, 0x0b, 0x49, 0xca, 0x91, 0x23, 0x8a, 0x3e, 0x50, 0xc9, 0x05, 0x6a, 0x42,
+	0xd1, 0x37, 0x74, 0xe9, 0xe1, 0x8c, 0xa3, 0x16, 0x91, 0x28, 0x90, 0xe2, 0x87, 0x86, 0x2e, 0xad,
+	0xe4, 0xc1, 0x0f, 0x0d, 0xfd, 0x23, 0x1e, 0x8e, 0x6d, 0x28, 0xc7, 0x01, 0x87, 0x1c, 0x71, 0x8a,
+	0xd1, 0xe8, 0x7b, 0x68, 0x64, 0x22, 0x5d, 0xcd, 0xa1, 0x50, 0x1f, 0x8c, 0x85, 0xb9, 0x05, 0x75,
+	0xdb, 0xc1, 0x56, 0x7f, 0x60, 0xaa, 0x43, 0x12, 0x04, 0x5b, 0x9c, 0x1f, 0xec, 0x1a, 0xe5, 0xec,
+	0x53, 0x0a, 0x8b, 0x78, 0x1b, 0x1a, 0x9a, 0x8b, 0x55, 0x0f, 0xf7, 0x93, 0x94, 0xd5, 0xe6, 0xab,
+	0x2c, 0x06, 0xa4, 0x83, 0x30, 0x71, 0xf2, 0xbf, 0x0a, 0x20, 0x4d, 0x2b, 0x7c, 0xd1, 0xcf, 0x53,
+	0xa7, 0xec, 0x55, 0x8e, 0x8a, 0x79, 0xfc, 0xcc, 0x2d, 0xc3, 0x02, 0xb9, 0x19, 0x9d, 0xd9, 0x26,
+	0x8b, 0x75, 0x45, 0x09, 0x47, 0xe8, 0x03, 0x54, 0x54, 0x77, 0xe8, 0x8f, 0xb8, 0x7a, 0x6d, 0x3b,
+	0x77, 0x41, 0xde, 0xdc, 0x89, 0xa8, 0x6d, 0xcb, 0x73, 0x6f, 0x94, 0x44, 0xea, 0xe3, 0xed, 0x93,
+	0x95, 0x9f, 0xc2, 0x62, 0xfa, 0x67, 0x68, 0x67, 0x76, 0x81, 0x83, 0x4e, 0xb0, 0xa2, 0xd0, 0x4f,
+	0xda, 0x99, 0x5d, 0xd2, 0xa8, 0xb2, 0xb7, 0xb8, 0xa2, 0x04, 0x83, 0x6f, 0x0a, 0xdb, 0x82, 0xfc,
+	0xb7, 0x22, 0x2c, 0x4f, 0xae, 0x0c, 0xd1, 0x77, 0xa9, 0xa0, 0xbe, 0x9c, 0x5b, 0x50, 0x8e, 0x87,
+	0xf4, 0x09, 0x00, 0xbd, 0x5f, 0x7d, 0x4f, 0x3d, 0x33, 0x71, 0x18, 0x56, 0xce, 0xc2, 0x85, 0xbc,
+	0x9a, 0x0a, 0xf9, 0x32, 0x2c, 0xd8, 0x83, 0x01, 0xc1, 0x1e, 0xdb, 0x6c, 0x25, 0x25, 0x1c, 0xa1,
+	0x13, 0x3e, 0x15, 0x41, 0x95, 0xfb, 0x55, 0xce, 0x2a, 0x77, 0x46, 0x22, 0x9e, 0x81, 0x98, 0x14,
+	0x93, 0x86, 0xce, 0x6e, 0xfc, 0x8a, 0x52, 0x8d, 0x6d, 0x1d, 0xfd, 0x7f, 0x26, 0x57, 0x3e, 0x3c,
+	0x98, 0x58, 0x74, 0xc7, 0x55, 0x9c, 0x70, 0x1b, 0x55, 0x9c, 0xfc, 0x67, 0x01, 0x50, 0xb6, 0x43,
+	0x9c, 0x5b, 0x3d, 0xf0, 0x94, 0xdb, 0xa8, 0x1e, 0xe4, 0x3f, 0x09, 0x70, 0x2f, 0xd3, 0x6e, 0xa2,
+	0xad, 0x94, 0x5b, 0xab, 0xb3, 0x1a, 0xd4, 0x5b, 0xf1, 0xea, 0x07, 0x01, 0x96, 0x26, 0x35, 0xae,
+	0xe8, 0xeb, 0x94, 0x63, 0xcf, 0xe7, 0x74, 0xbb, 0xb7, 0xe2, 0xdb, 0x1f, 0x05, 0x68, 0x8c, 0xb7,
+	0xc1, 0x68, 0x33, 0xe5, 0xd7, 0xd3, 0x19, 0x7d, 0xf3, 0xad, 0xf8, 0xf4, 0x17, 0x01, 0x3e, 0x99,
+	0xd2, 0x4c, 0xa3, 0x6f, 0x52, 0xae, 0x7d, 0x36, 0xbf, 0x09, 0xbf, 0x15, 0x0f, 0xff, 0x2a, 0x80,
+	0x34, 0xad, 0x23, 0x47, 0xdf, 0xa6, 0x5c, 0xfc, 0x3c, 0x47, 0x2b, 0x7f, 0x2b, 0x3e, 0xfe, 0x53,
+	0x80, 0xa5, 0x49, 0xcd, 0xf3, 0xdc, 0x5d, 0x97, 0x26, 0x71, 0xbe, 0x7d, 0x0d, 0xa5, 0x4b, 0x03,
+	0x5f, 0x49, 0x85, 0x5c, 0xc4, 0x0f, 0x06, 0xbe, 0x52, 0x18, 0xe1, 0x23, 0x2e, 0xea, 0x27, 0xf0,
+	0x68, 0x46, 0xdb, 0x8e, 0x56, 0xa0, 0x6c, 0x58, 0x1e, 0x76, 0x2f, 0x55, 0x93, 0x2d, 0xaf, 0xa8,
+	0xc4, 0x63, 0xf9, 0x15, 0xa0, 0x6c, 0x4f, 0x4e, 0x5f, 0x96, 0xf0, 0x2f, 0x4e, 0x21, 0x78, 0x59,
+	0x82, 0x91, 0xbc, 0x0e, 0xf7, 0x32, 0x6d, 0xf7, 0x4c, 0xf9, 0xdf, 0x43, 0x39, 0xfa, 0xb7, 0x1a,
+	0xfd, 0x0c, 0xca, 0xde, 0xb9, 0x6b, 0x7b, 0x9e, 0x89, 0xc3, 0x3f, 0xfa, 0xb3, 0x77, 0xe1, 0x49,
+	0x08, 0x48, 0xfe, 0xe2, 0x8e, 0x28, 0x68, 0x0b, 0xee, 0x9a, 0xc6, 0xc8, 0xf0, 0xc2, 0x36, 0x39,
+	0xdb, 0x21, 0x74, 0xe9, 0x6c, 0x4c, 0x0c, 0xc0, 0xf2, 0x3f, 0x04, 0x68, 0x8c, 0x8b, 0xce, 0xf2,
+	0x18, 0xf5, 0xa0, 0x16, 0x7d, 0xf7, 0xd9, 0x86, 0x08, 0xf2, 0xda, 0x9c, 0xeb, 0x6a, 0xb3, 0x13,
+	0xd2, 0xd8, 0xde, 0x10, 0x0d, 0x6e, 0x24, 0xef, 0x80, 0xc8, 0xcf, 0xa2, 0x3a, 0x54, 0x0f, 0x3a,
+	0xdd, 0x6e, 0xa7, 0xd7, 0x6e, 0x1d, 0x1d, 0xee, 0x35, 0xee, 0x20, 0x80, 0x85, 0xf0, 0x5b, 0xa0,
+	0xdf, 0x07, 0x9d, 0xc3, 0xd3, 0x93, 0x76, 0xa3, 0x80, 0xca, 0x50, 0x7a, 0x77, 0x74, 0xaa, 0x34,
+	0x8a, 0xf2, 0x0b, 0xa8, 0xa5, 0x16, 0x48, 0x9f, 0xbf, 0x20, 0x1e, 0xc1, 0x0a, 0x82, 0xc1, 0xcb,
+	0xdf, 0x01, 0xca, 0xfe, 0x81, 0x8d, 0x1e, 0xc3, 0xc3, 0xdd, 0x9d, 0xd6, 0xfb, 0x63, 0xa5, 0xdd,
+	0xeb, 0x9d, 0x2a, 0xed, 0xfe, 0xf1, 0x51, 0xb7, 0xd3, 0xfa, 0x55, 0x7f, 0xb7, 0x7b, 0xd4, 0x7a,
+	0xdf, 0xb8, 0x83, 0x9e, 0xc3, 0xd3, 0x49, 0xd3, 0x7b, 0xca, 0xd1, 0x71, 0xff, 0xb0, 0xfd, 0xcb,
+	0x76, 0xef, 0xa4, 0x21, 0xcc, 0x04, 0x1d, 0x75, 0xf7, 0x28, 0xa8, 0xf0, 0xf2, 0x0b, 0x40, 0xd9,
+	0xfd, 0x8e, 0x2a, 0x70, 0x77, 0x77, 0xa7, 0xd7, 0x69, 0x35, 0xee, 0xd0, 0x05, 0xed, 0x9f, 0x76,
+	0xbb, 0x0d, 0xe1, 0x6c, 0x81, 0x15, 0xb3, 0x9b, 0xff, 0x1d, 0x00, 0x9b, 0x65, 0x9d, 0x1e, 0xb3,
+	0x1a, 0x00, 0x00,
 }
@@ -57,6 +57,11 @@ const (
 	ProcessEventType_PROCESS_EVENT_TYPE_EXEC ProcessEventType = 2
 	// The event is a process exit event
 	ProcessEventType_PROCESS_EVENT_TYPE_EXIT ProcessEventType = 3
+	// The event is a process credentials change event
+	ProcessEventType_PROCESS_EVENT_TYPE_CREDENTIALS_CHANGED ProcessEventType = 4
+	// The event reports the hash of an executable that was exec'd. It
+	// follows the exec event once the executable has been hashed.
+	ProcessEventType_PROCESS_EVENT_TYPE_EXEC_HASHED ProcessEventType = 5
 )
 
 var ProcessEventType_name = map[int32]string{
@@ -64,12 +69,16 @@ var ProcessEventType_name = map[int32]string{
 	1: "PROCESS_EVENT_TYPE_FORK",
 	2: "PROCESS_EVENT_TYPE_EXEC",
 	3: "PROCESS_EVENT_TYPE_EXIT",
+	4: "PROCESS_EVENT_TYPE_CREDENTIALS_CHANGED",
+	5: "PROCESS_EVENT_TYPE_EXEC_HASHED",
 }
 var ProcessEventType_value = map[string]int32{
-	"PROCESS_EVENT_TYPE_UNKNOWN": 0,
-	"PROCESS_EVENT_TYPE_FORK":    1,
-	"PROCESS_EVENT_TYPE_EXEC":    2,
-	"PROCESS_EVENT_TYPE_EXIT":    3,
+	"PROCESS_EVENT_TYPE_UNKNOWN":             0,
+	"PROCESS_EVENT_TYPE_FORK":                1,
+	"PROCESS_EVENT_TYPE_EXEC":                2,
+	"PROCESS_EVENT_TYPE_EXIT":                3,
+	"PROCESS_EVENT_TYPE_CREDENTIALS_CHANGED": 4,
+	"PROCESS_EVENT_TYPE_EXEC_HASHED":         5,
 }
 
 func (x ProcessEventType) String() string {
@@ -87,17 +96,22 @@ const (
 	SyscallEventType_SYSCALL_EVENT_TYPE_ENTER SyscallEventType = 1
 	// The event is a syscall exit event
 	SyscallEventType_SYSCALL_EVENT_TYPE_EXIT SyscallEventType = 2
+	// The event is a completed syscall, pairing the enter and exit
+	// events of a syscall made by a thread
+	SyscallEventType_SYSCALL_EVENT_TYPE_COMPLETE SyscallEventType = 3
 )
 
 var SyscallEventType_name = map[int32]string{
 	0: "SYSCALL_EVENT_TYPE_UNKNOWN",
 	1: "SYSCALL_EVENT_TYPE_ENTER",
 	2: "SYSCALL_EVENT_TYPE_EXIT",
+	3: "SYSCALL_EVENT_TYPE_COMPLETE",
 }
 var SyscallEventType_value = map[string]int32{
-	"SYSCALL_EVENT_TYPE_UNKNOWN": 0,
-	"SYSCALL_EVENT_TYPE_ENTER":   1,
-	"SYSCALL_EVENT_TYPE_EXIT":    2,
+	"SYSCALL_EVENT_TYPE_UNKNOWN":  0,
+	"SYSCALL_EVENT_TYPE_ENTER":    1,
+	"SYSCALL_EVENT_TYPE_EXIT":     2,
+	"SYSCALL_EVENT_TYPE_COMPLETE": 3,
 }
 
 func (x SyscallEventType) String() string {
@@ -113,15 +127,39 @@ const (
 	FileEventType_FILE_EVENT_TYPE_UNKNOWN FileEventType = 0
 	// The event is a file open event
 	FileEventType_FILE_EVENT_TYPE_OPEN FileEventType = 1
+	// The event is a file unlink event
+	FileEventType_FILE_EVENT_TYPE_UNLINK FileEventType = 2
+	// The event is a file rename event
+	FileEventType_FILE_EVENT_TYPE_RENAME FileEventType = 3
+	// The event is a file permission change event
+	FileEventType_FILE_EVENT_TYPE_CHMOD FileEventType = 4
+	// The event is a file ownership change event
+	FileEventType_FILE_EVENT_TYPE_CHOWN FileEventType = 5
+	// The event is a file truncate event
+	FileEventType_FILE_EVENT_TYPE_TRUNCATE FileEventType = 6
+	// The event is a directory creation event
+	FileEventType_FILE_EVENT_TYPE_MKDIR FileEventType = 7
 )
 
 var FileEventType_name = map[int32]string{
 	0: "FILE_EVENT_TYPE_UNKNOWN",
 	1: "FILE_EVENT_TYPE_OPEN",
+	2: "FILE_EVENT_TYPE_UNLINK",
+	3: "FILE_EVENT_TYPE_RENAME",
+	4: "FILE_EVENT_TYPE_CHMOD",
+	5: "FILE_EVENT_TYPE_CHOWN",
+	6: "FILE_EVENT_TYPE_TRUNCATE",
+	7: "FILE_EVENT_TYPE_MKDIR",
 }
 var FileEventType_value = map[string]int32{
-	"FILE_EVENT_TYPE_UNKNOWN": 0,
-	"FILE_EVENT_TYPE_OPEN":    1,
+	"FILE_EVENT_TYPE_UNKNOWN":  0,
+	"FILE_EVENT_TYPE_OPEN":     1,
+	"FILE_EVENT_TYPE_UNLINK":   2,
+	"FILE_EVENT_TYPE_RENAME":   3,
+	"FILE_EVENT_TYPE_CHMOD":    4,
+	"FILE_EVENT_TYPE_CHOWN":    5,
+	"FILE_EVENT_TYPE_TRUNCATE": 6,
+	"FILE_EVENT_TYPE_MKDIR":    7,
 }
 
 func (x FileEventType) String() string {
@@ -157,6 +195,34 @@ func (x KernelFunctionCallEventType) String() string {
 }
 func (KernelFunctionCallEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{4} }
 
+// Possible UserFunctionCallEvent types
+type UserFunctionCallEventType int32
+
+const (
+	// The type of event is unknown
+	UserFunctionCallEventType_USER_FUNCTION_CALL_EVENT_TYPE_UNKNOWN UserFunctionCallEventType = 0
+	// The event is a user function being entered.
+	UserFunctionCallEventType_USER_FUNCTION_CALL_EVENT_TYPE_ENTER UserFunctionCallEventType = 1
+	// The event is a user function being exited.
+	UserFunctionCallEventType_USER_FUNCTION_CALL_EVENT_TYPE_EXIT UserFunctionCallEventType = 2
+)
+
+var UserFunctionCallEventType_name = map[int32]string{
+	0: "USER_FUNCTION_CALL_EVENT_TYPE_UNKNOWN",
+	1: "USER_FUNCTION_CALL_EVENT_TYPE_ENTER",
+	2: "USER_FUNCTION_CALL_EVENT_TYPE_EXIT",
+}
+var UserFunctionCallEventType_value = map[string]int32{
+	"USER_FUNCTION_CALL_EVENT_TYPE_UNKNOWN": 0,
+	"USER_FUNCTION_CALL_EVENT_TYPE_ENTER":   1,
+	"USER_FUNCTION_CALL_EVENT_TYPE_EXIT":    2,
+}
+
+func (x UserFunctionCallEventType) String() string {
+	return proto.EnumName(UserFunctionCallEventType_name, int32(x))
+}
+func (UserFunctionCallEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{5} }
+
 // Possible network event types
 type NetworkEventType int32
 
@@ -226,7 +292,166 @@ var NetworkEventType_value = map[string]int32{
 func (x NetworkEventType) String() string {
 	return proto.EnumName(NetworkEventType_name, int32(x))
 }
-func (NetworkEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{5} }
+func (NetworkEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{6} }
+
+// Possible SignalEvent types
+type SignalEventType int32
+
+const (
+	// The type of event is unknown
+	SignalEventType_SIGNAL_EVENT_TYPE_UNKNOWN SignalEventType = 0
+	// The event is a signal being generated (sent)
+	SignalEventType_SIGNAL_EVENT_TYPE_GENERATE SignalEventType = 1
+	// The event is a signal being delivered to its target
+	SignalEventType_SIGNAL_EVENT_TYPE_DELIVER SignalEventType = 2
+)
+
+var SignalEventType_name = map[int32]string{
+	0: "SIGNAL_EVENT_TYPE_UNKNOWN",
+	1: "SIGNAL_EVENT_TYPE_GENERATE",
+	2: "SIGNAL_EVENT_TYPE_DELIVER",
+}
+var SignalEventType_value = map[string]int32{
+	"SIGNAL_EVENT_TYPE_UNKNOWN":  0,
+	"SIGNAL_EVENT_TYPE_GENERATE": 1,
+	"SIGNAL_EVENT_TYPE_DELIVER":  2,
+}
+
+func (x SignalEventType) String() string {
+	return proto.EnumName(SignalEventType_name, int32(x))
+}
+func (SignalEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{7} }
+
+// Possible NamespaceEvent types
+type NamespaceEventType int32
+
+const (
+	// The type of event is unknown
+	NamespaceEventType_NAMESPACE_EVENT_TYPE_UNKNOWN NamespaceEventType = 0
+	// The event is an attempt to join a namespace via setns(2)
+	NamespaceEventType_NAMESPACE_EVENT_TYPE_SETNS NamespaceEventType = 1
+	// The event is an attempt to create new namespaces via unshare(2)
+	NamespaceEventType_NAMESPACE_EVENT_TYPE_UNSHARE NamespaceEventType = 2
+)
+
+var NamespaceEventType_name = map[int32]string{
+	0: "NAMESPACE_EVENT_TYPE_UNKNOWN",
+	1: "NAMESPACE_EVENT_TYPE_SETNS",
+	2: "NAMESPACE_EVENT_TYPE_UNSHARE",
+}
+var NamespaceEventType_value = map[string]int32{
+	"NAMESPACE_EVENT_TYPE_UNKNOWN": 0,
+	"NAMESPACE_EVENT_TYPE_SETNS":   1,
+	"NAMESPACE_EVENT_TYPE_UNSHARE": 2,
+}
+
+func (x NamespaceEventType) String() string {
+	return proto.EnumName(NamespaceEventType_name, int32(x))
+}
+func (NamespaceEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{8} }
+
+// Possible MountEvent types
+type MountEventType int32
+
+const (
+	// The type of event is unknown
+	MountEventType_MOUNT_EVENT_TYPE_UNKNOWN MountEventType = 0
+	// The event is an attempt to mount a filesystem via mount(2)
+	MountEventType_MOUNT_EVENT_TYPE_MOUNT MountEventType = 1
+	// The event is an attempt to unmount a filesystem via umount2(2)
+	MountEventType_MOUNT_EVENT_TYPE_UMOUNT MountEventType = 2
+	// The event is an attempt to change the root filesystem via
+	// pivot_root(2)
+	MountEventType_MOUNT_EVENT_TYPE_PIVOT_ROOT MountEventType = 3
+)
+
+var MountEventType_name = map[int32]string{
+	0: "MOUNT_EVENT_TYPE_UNKNOWN",
+	1: "MOUNT_EVENT_TYPE_MOUNT",
+	2: "MOUNT_EVENT_TYPE_UMOUNT",
+	3: "MOUNT_EVENT_TYPE_PIVOT_ROOT",
+}
+var MountEventType_value = map[string]int32{
+	"MOUNT_EVENT_TYPE_UNKNOWN":    0,
+	"MOUNT_EVENT_TYPE_MOUNT":      1,
+	"MOUNT_EVENT_TYPE_UMOUNT":     2,
+	"MOUNT_EVENT_TYPE_PIVOT_ROOT": 3,
+}
+
+func (x MountEventType) String() string {
+	return proto.EnumName(MountEventType_name, int32(x))
+}
+func (MountEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{9} }
+
+// Possible KernelModuleEvent types
+type KernelModuleEventType int32
+
+const (
+	// The type of event is unknown
+	KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_UNKNOWN KernelModuleEventType = 0
+	// The event is an attempt to load a kernel module via
+	// init_module(2) or finit_module(2)
+	KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_LOAD_ATTEMPT KernelModuleEventType = 1
+	// The event is a kernel module being loaded by the kernel
+	KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_LOAD KernelModuleEventType = 2
+	// The event is an attempt to unload a kernel module via
+	// delete_module(2)
+	KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_UNLOAD_ATTEMPT KernelModuleEventType = 3
+	// The event is a kernel module being freed by the kernel
+	KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_UNLOAD KernelModuleEventType = 4
+)
+
+var KernelModuleEventType_name = map[int32]string{
+	0: "KERNEL_MODULE_EVENT_TYPE_UNKNOWN",
+	1: "KERNEL_MODULE_EVENT_TYPE_LOAD_ATTEMPT",
+	2: "KERNEL_MODULE_EVENT_TYPE_LOAD",
+	3: "KERNEL_MODULE_EVENT_TYPE_UNLOAD_ATTEMPT",
+	4: "KERNEL_MODULE_EVENT_TYPE_UNLOAD",
+}
+var KernelModuleEventType_value = map[string]int32{
+	"KERNEL_MODULE_EVENT_TYPE_UNKNOWN":        0,
+	"KERNEL_MODULE_EVENT_TYPE_LOAD_ATTEMPT":   1,
+	"KERNEL_MODULE_EVENT_TYPE_LOAD":           2,
+	"KERNEL_MODULE_EVENT_TYPE_UNLOAD_ATTEMPT": 3,
+	"KERNEL_MODULE_EVENT_TYPE_UNLOAD":         4,
+}
+
+func (x KernelModuleEventType) String() string {
+	return proto.EnumName(KernelModuleEventType_name, int32(x))
+}
+func (KernelModuleEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{10} }
+
+// Possible ProcessAccessEvent types
+type ProcessAccessEventType int32
+
+const (
+	// The type of event is unknown
+	ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_UNKNOWN ProcessAccessEventType = 0
+	// The event is a call to ptrace(2)
+	ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_PTRACE ProcessAccessEventType = 1
+	// The event is a call to process_vm_readv(2)
+	ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_VM_READ ProcessAccessEventType = 2
+	// The event is a call to process_vm_writev(2)
+	ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_VM_WRITE ProcessAccessEventType = 3
+)
+
+var ProcessAccessEventType_name = map[int32]string{
+	0: "PROCESS_ACCESS_EVENT_TYPE_UNKNOWN",
+	1: "PROCESS_ACCESS_EVENT_TYPE_PTRACE",
+	2: "PROCESS_ACCESS_EVENT_TYPE_VM_READ",
+	3: "PROCESS_ACCESS_EVENT_TYPE_VM_WRITE",
+}
+var ProcessAccessEventType_value = map[string]int32{
+	"PROCESS_ACCESS_EVENT_TYPE_UNKNOWN":  0,
+	"PROCESS_ACCESS_EVENT_TYPE_PTRACE":   1,
+	"PROCESS_ACCESS_EVENT_TYPE_VM_READ":  2,
+	"PROCESS_ACCESS_EVENT_TYPE_VM_WRITE": 3,
+}
+
+func (x ProcessAccessEventType) String() string {
+	return proto.EnumName(ProcessAccessEventType_name, int32(x))
+}
+func (ProcessAccessEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{11} }
 
 // Possible field types
 type KernelFunctionCallEvent_FieldType int32
@@ -254,6 +479,8 @@ const (
 	KernelFunctionCallEvent_UINT32 KernelFunctionCallEvent_FieldType = 9
 	// The field type is an unsigned 64-bit integer
 	KernelFunctionCallEvent_UINT64 KernelFunctionCallEvent_FieldType = 10
+	// The field type is an array of values
+	KernelFunctionCallEvent_ARRAY KernelFunctionCallEvent_FieldType = 11
 )
 
 var KernelFunctionCallEvent_FieldType_name = map[int32]string{
@@ -268,6 +495,7 @@ var KernelFunctionCallEvent_FieldType_name = map[int32]string{
 	8:  "UINT16",
 	9:  "UINT32",
 	10: "UINT64",
+	11: "ARRAY",
 }
 var KernelFunctionCallEvent_FieldType_value = map[string]int32{
 	"UNKNOWN": 0,
@@ -281,13 +509,14 @@ var KernelFunctionCallEvent_FieldType_value = map[string]int32{
 	"UINT16":  8,
 	"UINT32":  9,
 	"UINT64":  10,
+	"ARRAY":   11,
 }
 
 func (x KernelFunctionCallEvent_FieldType) String() string {
 	return proto.EnumName(KernelFunctionCallEvent_FieldType_name, int32(x))
 }
 func (KernelFunctionCallEvent_FieldType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{8, 0}
+	return fileDescriptor1, []int{11, 0}
 }
 
 // An event observed by the Sensor.
@@ -317,7 +546,8 @@ type TelemetryEvent struct {
 	SensorMonotimeNanos int64 `protobuf:"varint,7,opt,name=sensor_monotime_nanos,json=sensorMonotimeNanos" json:"sensor_monotime_nanos,omitempty"`
 	// Process Lineage contains one process context for each process in the
 	// hierarchy, starting with the current process, up to the root of the
-	// process namespace.
+	// process namespace. It is only present if requested by the
+	// subscription (see Subscription.process_lineage_depth).
 	ProcessLineage []*Process `protobuf:"bytes,8,rep,name=process_lineage,json=processLineage" json:"process_lineage,omitempty"`
 	// Name of container associated with the event
 	ContainerName string `protobuf:"bytes,30,opt,name=container_name,json=containerName" json:"container_name,omitempty"`
@@ -334,7 +564,17 @@ type TelemetryEvent struct {
 	//	*TelemetryEvent_File
 	//	*TelemetryEvent_KernelCall
 	//	*TelemetryEvent_Network
+	//	*TelemetryEvent_Signal
+	//	*TelemetryEvent_Namespace
+	//	*TelemetryEvent_Mount
+	//	*TelemetryEvent_KernelModule
+	//	*TelemetryEvent_ProcessAccess
+	//	*TelemetryEvent_UserCall
+	//	*TelemetryEvent_Tracepoint
 	//	*TelemetryEvent_Container
+	//	*TelemetryEvent_ContainerMetrics
+	//	*TelemetryEvent_LostEvents
+	//	*TelemetryEvent_DroppedEvents
 	//	*TelemetryEvent_Chargen
 	//	*TelemetryEvent_Ticker
 	Event isTelemetryEvent_Event `protobuf_oneof:"event"`
@@ -371,9 +611,39 @@ type TelemetryEvent_KernelCall struct {
 type TelemetryEvent_Network struct {
 	Network *NetworkEvent `protobuf:"bytes,14,opt,name=network,oneof"`
 }
+type TelemetryEvent_Signal struct {
+	Signal *SignalEvent `protobuf:"bytes,15,opt,name=signal,oneof"`
+}
+type TelemetryEvent_Namespace struct {
+	Namespace *NamespaceEvent `protobuf:"bytes,16,opt,name=namespace,oneof"`
+}
+type TelemetryEvent_Mount struct {
+	Mount *MountEvent `protobuf:"bytes,17,opt,name=mount,oneof"`
+}
+type TelemetryEvent_KernelModule struct {
+	KernelModule *KernelModuleEvent `protobuf:"bytes,18,opt,name=kernel_module,json=kernelModule,oneof"`
+}
+type TelemetryEvent_ProcessAccess struct {
+	ProcessAccess *ProcessAccessEvent `protobuf:"bytes,19,opt,name=process_access,json=processAccess,oneof"`
+}
+type TelemetryEvent_UserCall struct {
+	UserCall *UserFunctionCallEvent `protobuf:"bytes,22,opt,name=user_call,json=userCall,oneof"`
+}
+type TelemetryEvent_Tracepoint struct {
+	Tracepoint *TracepointEvent `protobuf:"bytes,23,opt,name=tracepoint,oneof"`
+}
 type TelemetryEvent_Container struct {
 	Container *ContainerEvent `protobuf:"bytes,20,opt,name=container,oneof"`
 }
+type TelemetryEvent_ContainerMetrics struct {
+	ContainerMetrics *ContainerMetricsEvent `protobuf:"bytes,21,opt,name=container_metrics,json=containerMetrics,oneof"`
+}
+type TelemetryEvent_LostEvents struct {
+	LostEvents *LostEventsEvent `protobuf:"bytes,50,opt,name=lost_events,json=lostEvents,oneof"`
+}
+type TelemetryEvent_DroppedEvents struct {
+	DroppedEvents *DroppedEventsEvent `protobuf:"bytes,51,opt,name=dropped_events,json=droppedEvents,oneof"`
+}
 type TelemetryEvent_Chargen struct {
 	Chargen *ChargenEvent `protobuf:"bytes,100,opt,name=chargen,oneof"`
 }
@@ -381,14 +651,24 @@ type TelemetryEvent_Ticker struct {
 	Ticker *TickerEvent `protobuf:"bytes,101,opt,name=ticker,oneof"`
 }
 
-func (*TelemetryEvent_Syscall) isTelemetryEvent_Event()    {}
-func (*TelemetryEvent_Process) isTelemetryEvent_Event()    {}
-func (*TelemetryEvent_File) isTelemetryEvent_Event()       {}
-func (*TelemetryEvent_KernelCall) isTelemetryEvent_Event() {}
-func (*TelemetryEvent_Network) isTelemetryEvent_Event()    {}
-func (*TelemetryEvent_Container) isTelemetryEvent_Event()  {}
-func (*TelemetryEvent_Chargen) isTelemetryEvent_Event()    {}
-func (*TelemetryEvent_Ticker) isTelemetryEvent_Event()     {}
+func (*TelemetryEvent_Syscall) isTelemetryEvent_Event()          {}
+func (*TelemetryEvent_Process) isTelemetryEvent_Event()          {}
+func (*TelemetryEvent_File) isTelemetryEvent_Event()             {}
+func (*TelemetryEvent_KernelCall) isTelemetryEvent_Event()       {}
+func (*TelemetryEvent_Network) isTelemetryEvent_Event()          {}
+func (*TelemetryEvent_Signal) isTelemetryEvent_Event()           {}
+func (*TelemetryEvent_Namespace) isTelemetryEvent_Event()        {}
+func (*TelemetryEvent_Mount) isTelemetryEvent_Event()            {}
+func (*TelemetryEvent_KernelModule) isTelemetryEvent_Event()     {}
+func (*TelemetryEvent_ProcessAccess) isTelemetryEvent_Event()    {}
+func (*TelemetryEvent_UserCall) isTelemetryEvent_Event()         {}
+func (*TelemetryEvent_Tracepoint) isTelemetryEvent_Event()       {}
+func (*TelemetryEvent_Container) isTelemetryEvent_Event()        {}
+func (*TelemetryEvent_ContainerMetrics) isTelemetryEvent_Event() {}
+func (*TelemetryEvent_LostEvents) isTelemetryEvent_Event()       {}
+func (*TelemetryEvent_DroppedEvents) isTelemetryEvent_Event()    {}
+func (*TelemetryEvent_Chargen) isTelemetryEvent_Event()          {}
+func (*TelemetryEvent_Ticker) isTelemetryEvent_Event()           {}
 
 func (m *TelemetryEvent) GetEvent() isTelemetryEvent_Event {
 	if m != nil {
@@ -509,6 +789,55 @@ func (m *TelemetryEvent) GetNetwork() *NetworkEvent {
 	return nil
 }
 
+func (m *TelemetryEvent) GetSignal() *SignalEvent {
+	if x, ok := m.GetEvent().(*TelemetryEvent_Signal); ok {
+		return x.Signal
+	}
+	return nil
+}
+
+func (m *TelemetryEvent) GetNamespace() *NamespaceEvent {
+	if x, ok := m.GetEvent().(*TelemetryEvent_Namespace); ok {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (m *TelemetryEvent) GetMount() *MountEvent {
+	if x, ok := m.GetEvent().(*TelemetryEvent_Mount); ok {
+		return x.Mount
+	}
+	return nil
+}
+
+func (m *TelemetryEvent) GetKernelModule() *KernelModuleEvent {
+	if x, ok := m.GetEvent().(*TelemetryEvent_KernelModule); ok {
+		return x.KernelModule
+	}
+	return nil
+}
+
+func (m *TelemetryEvent) GetProcessAccess() *ProcessAccessEvent {
+	if x, ok := m.GetEvent().(*TelemetryEvent_ProcessAccess); ok {
+		return x.ProcessAccess
+	}
+	return nil
+}
+
+func (m *TelemetryEvent) GetUserCall() *UserFunctionCallEvent {
+	if x, ok := m.GetEvent().(*TelemetryEvent_UserCall); ok {
+		return x.UserCall
+	}
+	return nil
+}
+
+func (m *TelemetryEvent) GetTracepoint() *TracepointEvent {
+	if x, ok := m.GetEvent().(*TelemetryEvent_Tracepoint); ok {
+		return x.Tracepoint
+	}
+	return nil
+}
+
 func (m *TelemetryEvent) GetContainer() *ContainerEvent {
 	if x, ok := m.GetEvent().(*TelemetryEvent_Container); ok {
 		return x.Container
@@ -516,6 +845,27 @@ func (m *TelemetryEvent) GetContainer() *ContainerEvent {
 	return nil
 }
 
+func (m *TelemetryEvent) GetContainerMetrics() *ContainerMetricsEvent {
+	if x, ok := m.GetEvent().(*TelemetryEvent_ContainerMetrics); ok {
+		return x.ContainerMetrics
+	}
+	return nil
+}
+
+func (m *TelemetryEvent) GetLostEvents() *LostEventsEvent {
+	if x, ok := m.GetEvent().(*TelemetryEvent_LostEvents); ok {
+		return x.LostEvents
+	}
+	return nil
+}
+
+func (m *TelemetryEvent) GetDroppedEvents() *DroppedEventsEvent {
+	if x, ok := m.GetEvent().(*TelemetryEvent_DroppedEvents); ok {
+		return x.DroppedEvents
+	}
+	return nil
+}
+
 func (m *TelemetryEvent) GetChargen() *ChargenEvent {
 	if x, ok := m.GetEvent().(*TelemetryEvent_Chargen); ok {
 		return x.Chargen
@@ -559,7 +909,17 @@ func (*TelemetryEvent) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer
 		(*TelemetryEvent_File)(nil),
 		(*TelemetryEvent_KernelCall)(nil),
 		(*TelemetryEvent_Network)(nil),
+		(*TelemetryEvent_Signal)(nil),
+		(*TelemetryEvent_Namespace)(nil),
+		(*TelemetryEvent_Mount)(nil),
+		(*TelemetryEvent_KernelModule)(nil),
+		(*TelemetryEvent_ProcessAccess)(nil),
+		(*TelemetryEvent_UserCall)(nil),
+		(*TelemetryEvent_Tracepoint)(nil),
 		(*TelemetryEvent_Container)(nil),
+		(*TelemetryEvent_ContainerMetrics)(nil),
+		(*TelemetryEvent_LostEvents)(nil),
+		(*TelemetryEvent_DroppedEvents)(nil),
 		(*TelemetryEvent_Chargen)(nil),
 		(*TelemetryEvent_Ticker)(nil),
 	}
@@ -594,11 +954,61 @@ func _TelemetryEvent_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Network); err != nil {
 			return err
 		}
+	case *TelemetryEvent_Signal:
+		b.EncodeVarint(15<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Signal); err != nil {
+			return err
+		}
+	case *TelemetryEvent_Namespace:
+		b.EncodeVarint(16<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Namespace); err != nil {
+			return err
+		}
+	case *TelemetryEvent_Mount:
+		b.EncodeVarint(17<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Mount); err != nil {
+			return err
+		}
+	case *TelemetryEvent_KernelModule:
+		b.EncodeVarint(18<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.KernelModule); err != nil {
+			return err
+		}
+	case *TelemetryEvent_ProcessAccess:
+		b.EncodeVarint(19<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ProcessAccess); err != nil {
+			return err
+		}
+	case *TelemetryEvent_UserCall:
+		b.EncodeVarint(22<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.UserCall); err != nil {
+			return err
+		}
+	case *TelemetryEvent_Tracepoint:
+		b.EncodeVarint(23<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Tracepoint); err != nil {
+			return err
+		}
 	case *TelemetryEvent_Container:
 		b.EncodeVarint(20<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.Container); err != nil {
 			return err
 		}
+	case *TelemetryEvent_ContainerMetrics:
+		b.EncodeVarint(21<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ContainerMetrics); err != nil {
+			return err
+		}
+	case *TelemetryEvent_LostEvents:
+		b.EncodeVarint(50<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.LostEvents); err != nil {
+			return err
+		}
+	case *TelemetryEvent_DroppedEvents:
+		b.EncodeVarint(51<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DroppedEvents); err != nil {
+			return err
+		}
 	case *TelemetryEvent_Chargen:
 		b.EncodeVarint(100<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.Chargen); err != nil {
@@ -659,6 +1069,62 @@ func _TelemetryEvent_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto
 		err := b.DecodeMessage(msg)
 		m.Event = &TelemetryEvent_Network{msg}
 		return true, err
+	case 15: // event.signal
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SignalEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &TelemetryEvent_Signal{msg}
+		return true, err
+	case 16: // event.namespace
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(NamespaceEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &TelemetryEvent_Namespace{msg}
+		return true, err
+	case 17: // event.mount
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MountEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &TelemetryEvent_Mount{msg}
+		return true, err
+	case 18: // event.kernel_module
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(KernelModuleEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &TelemetryEvent_KernelModule{msg}
+		return true, err
+	case 19: // event.process_access
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ProcessAccessEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &TelemetryEvent_ProcessAccess{msg}
+		return true, err
+	case 22: // event.user_call
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(UserFunctionCallEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &TelemetryEvent_UserCall{msg}
+		return true, err
+	case 23: // event.tracepoint
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TracepointEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &TelemetryEvent_Tracepoint{msg}
+		return true, err
 	case 20: // event.container
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
@@ -667,6 +1133,30 @@ func _TelemetryEvent_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto
 		err := b.DecodeMessage(msg)
 		m.Event = &TelemetryEvent_Container{msg}
 		return true, err
+	case 21: // event.container_metrics
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ContainerMetricsEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &TelemetryEvent_ContainerMetrics{msg}
+		return true, err
+	case 50: // event.lost_events
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(LostEventsEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &TelemetryEvent_LostEvents{msg}
+		return true, err
+	case 51: // event.dropped_events
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DroppedEventsEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &TelemetryEvent_DroppedEvents{msg}
+		return true, err
 	case 100: // event.chargen
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
@@ -717,11 +1207,61 @@ func _TelemetryEvent_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(14<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *TelemetryEvent_Signal:
+		s := proto.Size(x.Signal)
+		n += proto.SizeVarint(15<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TelemetryEvent_Namespace:
+		s := proto.Size(x.Namespace)
+		n += proto.SizeVarint(16<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TelemetryEvent_Mount:
+		s := proto.Size(x.Mount)
+		n += proto.SizeVarint(17<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TelemetryEvent_KernelModule:
+		s := proto.Size(x.KernelModule)
+		n += proto.SizeVarint(18<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TelemetryEvent_ProcessAccess:
+		s := proto.Size(x.ProcessAccess)
+		n += proto.SizeVarint(19<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TelemetryEvent_UserCall:
+		s := proto.Size(x.UserCall)
+		n += proto.SizeVarint(22<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TelemetryEvent_Tracepoint:
+		s := proto.Size(x.Tracepoint)
+		n += proto.SizeVarint(23<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case *TelemetryEvent_Container:
 		s := proto.Size(x.Container)
 		n += proto.SizeVarint(20<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *TelemetryEvent_ContainerMetrics:
+		s := proto.Size(x.ContainerMetrics)
+		n += proto.SizeVarint(21<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TelemetryEvent_LostEvents:
+		s := proto.Size(x.LostEvents)
+		n += proto.SizeVarint(50<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TelemetryEvent_DroppedEvents:
+		s := proto.Size(x.DroppedEvents)
+		n += proto.SizeVarint(51<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case *TelemetryEvent_Chargen:
 		s := proto.Size(x.Chargen)
 		n += proto.SizeVarint(100<<3 | proto.WireBytes)
@@ -908,86 +1448,429 @@ func (m *ContainerEvent) GetOciConfigJson() string {
 	return ""
 }
 
-// ProcessEvent describes an event that occurred related to processes starting
-// and exiting as detected by the Sensor.
-type ProcessEvent struct {
-	// The type of event described by this ProcessEvent message
-	Type ProcessEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.ProcessEventType" json:"type,omitempty"`
-	// Present when the event is a fork event. This is the PID of the
-	// new child process.
-	ForkChildPid int32 `protobuf:"zigzag32,10,opt,name=fork_child_pid,json=forkChildPid" json:"fork_child_pid,omitempty"`
-	// Present when the event is a fork event. This is the Sensor's process
-	// ID of the new child process.
-	ForkChildId string `protobuf:"bytes,11,opt,name=fork_child_id,json=forkChildId" json:"fork_child_id,omitempty"`
-	// Present when the event is an exec event. This is the filename of the
-	// executable that was executed.
-	ExecFilename string `protobuf:"bytes,20,opt,name=exec_filename,json=execFilename" json:"exec_filename,omitempty"`
-	// Present when the event is an exec event. Repeated for each argument
-	// passed to the executable on the command-line.
-	ExecCommandLine []string `protobuf:"bytes,21,rep,name=exec_command_line,json=execCommandLine" json:"exec_command_line,omitempty"`
-	// Present when the event is an exit event. This is the exit code that
-	// the process exited with.
-	ExitCode int32 `protobuf:"zigzag32,30,opt,name=exit_code,json=exitCode" json:"exit_code,omitempty"`
-	// Present when the event is an exit event. This will typically be one9
-	// of the values defined in stdlib.h like EXIT_SUCCESS, EXIT_FAILURE,
-	// or EXIT_USAGE.
-	ExitStatus uint32 `protobuf:"varint,31,opt,name=exit_status,json=exitStatus" json:"exit_status,omitempty"`
-	// Present when the event is an exit event. If non-zero, this is the
-	// signal number that the process was terminated with.
-	ExitSignal uint32 `protobuf:"varint,32,opt,name=exit_signal,json=exitSignal" json:"exit_signal,omitempty"`
-	// Present when the event is an exit event. If true, indicates that the
-	// process dumped a core when it terminated.
-	ExitCoreDumped bool `protobuf:"varint,33,opt,name=exit_core_dumped,json=exitCoreDumped" json:"exit_core_dumped,omitempty"`
+// Pressure stall averages for some or all tasks in a container
+type PressureStallAverages struct {
+	// The percentage of time that tasks were stalled over the last 10
+	// seconds
+	Avg10 float64 `protobuf:"fixed64,1,opt,name=avg10" json:"avg10,omitempty"`
+	// The percentage of time that tasks were stalled over the last 60
+	// seconds
+	Avg60 float64 `protobuf:"fixed64,2,opt,name=avg60" json:"avg60,omitempty"`
+	// The percentage of time that tasks were stalled over the last 300
+	// seconds
+	Avg300 float64 `protobuf:"fixed64,3,opt,name=avg300" json:"avg300,omitempty"`
+	// The total time that tasks were stalled in microseconds
+	TotalMicros uint64 `protobuf:"varint,4,opt,name=total_micros,json=totalMicros" json:"total_micros,omitempty"`
 }
 
-func (m *ProcessEvent) Reset()                    { *m = ProcessEvent{} }
-func (m *ProcessEvent) String() string            { return proto.CompactTextString(m) }
-func (*ProcessEvent) ProtoMessage()               {}
-func (*ProcessEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{4} }
+func (m *PressureStallAverages) Reset()                    { *m = PressureStallAverages{} }
+func (m *PressureStallAverages) String() string            { return proto.CompactTextString(m) }
+func (*PressureStallAverages) ProtoMessage()               {}
+func (*PressureStallAverages) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{4} }
 
-func (m *ProcessEvent) GetType() ProcessEventType {
+func (m *PressureStallAverages) GetAvg10() float64 {
 	if m != nil {
-		return m.Type
+		return m.Avg10
 	}
-	return ProcessEventType_PROCESS_EVENT_TYPE_UNKNOWN
+	return 0
 }
 
-func (m *ProcessEvent) GetForkChildPid() int32 {
+func (m *PressureStallAverages) GetAvg60() float64 {
 	if m != nil {
-		return m.ForkChildPid
+		return m.Avg60
 	}
 	return 0
 }
 
-func (m *ProcessEvent) GetForkChildId() string {
+func (m *PressureStallAverages) GetAvg300() float64 {
 	if m != nil {
-		return m.ForkChildId
+		return m.Avg300
 	}
-	return ""
+	return 0
 }
 
-func (m *ProcessEvent) GetExecFilename() string {
+func (m *PressureStallAverages) GetTotalMicros() uint64 {
 	if m != nil {
-		return m.ExecFilename
+		return m.TotalMicros
 	}
-	return ""
+	return 0
 }
 
-func (m *ProcessEvent) GetExecCommandLine() []string {
+// Pressure stall information for a resource in a container
+type PressureStall struct {
+	// Time that at least one task was stalled on the resource
+	Some *PressureStallAverages `protobuf:"bytes,1,opt,name=some" json:"some,omitempty"`
+	// Time that all tasks were stalled on the resource
+	Full *PressureStallAverages `protobuf:"bytes,2,opt,name=full" json:"full,omitempty"`
+}
+
+func (m *PressureStall) Reset()                    { *m = PressureStall{} }
+func (m *PressureStall) String() string            { return proto.CompactTextString(m) }
+func (*PressureStall) ProtoMessage()               {}
+func (*PressureStall) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{5} }
+
+func (m *PressureStall) GetSome() *PressureStallAverages {
 	if m != nil {
-		return m.ExecCommandLine
+		return m.Some
 	}
 	return nil
 }
 
-func (m *ProcessEvent) GetExitCode() int32 {
+func (m *PressureStall) GetFull() *PressureStallAverages {
 	if m != nil {
-		return m.ExitCode
+		return m.Full
 	}
-	return 0
+	return nil
 }
 
-func (m *ProcessEvent) GetExitStatus() uint32 {
+// A periodic snapshot of the resource usage and limits of a container, read
+// from its cgroups. Limits that are not set are zero.
+type ContainerMetricsEvent struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// Unique identifier of the container image
+	ImageId string `protobuf:"bytes,2,opt,name=image_id,json=imageId" json:"image_id,omitempty"`
+	// Name of the container image
+	ImageName string `protobuf:"bytes,3,opt,name=image_name,json=imageName" json:"image_name,omitempty"`
+	// The cgroup version (1 or 2) that the metrics were read from
+	CgroupVersion uint32 `protobuf:"varint,4,opt,name=cgroup_version,json=cgroupVersion" json:"cgroup_version,omitempty"`
+	// Total CPU time consumed in nanoseconds
+	CpuUsageNanos uint64 `protobuf:"varint,10,opt,name=cpu_usage_nanos,json=cpuUsageNanos" json:"cpu_usage_nanos,omitempty"`
+	// CPU time consumed in user mode in nanoseconds
+	CpuUserNanos uint64 `protobuf:"varint,11,opt,name=cpu_user_nanos,json=cpuUserNanos" json:"cpu_user_nanos,omitempty"`
+	// CPU time consumed in kernel mode in nanoseconds
+	CpuSystemNanos uint64 `protobuf:"varint,12,opt,name=cpu_system_nanos,json=cpuSystemNanos" json:"cpu_system_nanos,omitempty"`
+	// The number of CPU bandwidth enforcement periods that have elapsed
+	CpuPeriods uint64 `protobuf:"varint,13,opt,name=cpu_periods,json=cpuPeriods" json:"cpu_periods,omitempty"`
+	// The number of periods in which the container was throttled
+	CpuThrottledPeriods uint64 `protobuf:"varint,14,opt,name=cpu_throttled_periods,json=cpuThrottledPeriods" json:"cpu_throttled_periods,omitempty"`
+	// The total time that the container was throttled in nanoseconds
+	CpuThrottledNanos uint64 `protobuf:"varint,15,opt,name=cpu_throttled_nanos,json=cpuThrottledNanos" json:"cpu_throttled_nanos,omitempty"`
+	// Current memory usage in bytes
+	MemoryUsageBytes uint64 `protobuf:"varint,20,opt,name=memory_usage_bytes,json=memoryUsageBytes" json:"memory_usage_bytes,omitempty"`
+	// Memory limit in bytes
+	MemoryLimitBytes uint64 `protobuf:"varint,21,opt,name=memory_limit_bytes,json=memoryLimitBytes" json:"memory_limit_bytes,omitempty"`
+	// The number of processes killed by the OOM killer
+	MemoryOomKills uint64 `protobuf:"varint,22,opt,name=memory_oom_kills,json=memoryOomKills" json:"memory_oom_kills,omitempty"`
+	// The number of tasks in the container
+	PidsCurrent uint64 `protobuf:"varint,30,opt,name=pids_current,json=pidsCurrent" json:"pids_current,omitempty"`
+	// The limit on the number of tasks in the container
+	PidsMax uint64 `protobuf:"varint,31,opt,name=pids_max,json=pidsMax" json:"pids_max,omitempty"`
+	// Block I/O summed over all devices
+	IoReadBytes  uint64 `protobuf:"varint,40,opt,name=io_read_bytes,json=ioReadBytes" json:"io_read_bytes,omitempty"`
+	IoWriteBytes uint64 `protobuf:"varint,41,opt,name=io_write_bytes,json=ioWriteBytes" json:"io_write_bytes,omitempty"`
+	IoReadOps    uint64 `protobuf:"varint,42,opt,name=io_read_ops,json=ioReadOps" json:"io_read_ops,omitempty"`
+	IoWriteOps   uint64 `protobuf:"varint,43,opt,name=io_write_ops,json=ioWriteOps" json:"io_write_ops,omitempty"`
+	// Pressure stall information, only available with cgroup v2
+	CpuPressure    *PressureStall `protobuf:"bytes,50,opt,name=cpu_pressure,json=cpuPressure" json:"cpu_pressure,omitempty"`
+	MemoryPressure *PressureStall `protobuf:"bytes,51,opt,name=memory_pressure,json=memoryPressure" json:"memory_pressure,omitempty"`
+	IoPressure     *PressureStall `protobuf:"bytes,52,opt,name=io_pressure,json=ioPressure" json:"io_pressure,omitempty"`
+}
+
+func (m *ContainerMetricsEvent) Reset()                    { *m = ContainerMetricsEvent{} }
+func (m *ContainerMetricsEvent) String() string            { return proto.CompactTextString(m) }
+func (*ContainerMetricsEvent) ProtoMessage()               {}
+func (*ContainerMetricsEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{6} }
+
+func (m *ContainerMetricsEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContainerMetricsEvent) GetImageId() string {
+	if m != nil {
+		return m.ImageId
+	}
+	return ""
+}
+
+func (m *ContainerMetricsEvent) GetImageName() string {
+	if m != nil {
+		return m.ImageName
+	}
+	return ""
+}
+
+func (m *ContainerMetricsEvent) GetCgroupVersion() uint32 {
+	if m != nil {
+		return m.CgroupVersion
+	}
+	return 0
+}
+
+func (m *ContainerMetricsEvent) GetCpuUsageNanos() uint64 {
+	if m != nil {
+		return m.CpuUsageNanos
+	}
+	return 0
+}
+
+func (m *ContainerMetricsEvent) GetCpuUserNanos() uint64 {
+	if m != nil {
+		return m.CpuUserNanos
+	}
+	return 0
+}
+
+func (m *ContainerMetricsEvent) GetCpuSystemNanos() uint64 {
+	if m != nil {
+		return m.CpuSystemNanos
+	}
+	return 0
+}
+
+func (m *ContainerMetricsEvent) GetCpuPeriods() uint64 {
+	if m != nil {
+		return m.CpuPeriods
+	}
+	return 0
+}
+
+func (m *ContainerMetricsEvent) GetCpuThrottledPeriods() uint64 {
+	if m != nil {
+		return m.CpuThrottledPeriods
+	}
+	return 0
+}
+
+func (m *ContainerMetricsEvent) GetCpuThrottledNanos() uint64 {
+	if m != nil {
+		return m.CpuThrottledNanos
+	}
+	return 0
+}
+
+func (m *ContainerMetricsEvent) GetMemoryUsageBytes() uint64 {
+	if m != nil {
+		return m.MemoryUsageBytes
+	}
+	return 0
+}
+
+func (m *ContainerMetricsEvent) GetMemoryLimitBytes() uint64 {
+	if m != nil {
+		return m.MemoryLimitBytes
+	}
+	return 0
+}
+
+func (m *ContainerMetricsEvent) GetMemoryOomKills() uint64 {
+	if m != nil {
+		return m.MemoryOomKills
+	}
+	return 0
+}
+
+func (m *ContainerMetricsEvent) GetPidsCurrent() uint64 {
+	if m != nil {
+		return m.PidsCurrent
+	}
+	return 0
+}
+
+func (m *ContainerMetricsEvent) GetPidsMax() uint64 {
+	if m != nil {
+		return m.PidsMax
+	}
+	return 0
+}
+
+func (m *ContainerMetricsEvent) GetIoReadBytes() uint64 {
+	if m != nil {
+		return m.IoReadBytes
+	}
+	return 0
+}
+
+func (m *ContainerMetricsEvent) GetIoWriteBytes() uint64 {
+	if m != nil {
+		return m.IoWriteBytes
+	}
+	return 0
+}
+
+func (m *ContainerMetricsEvent) GetIoReadOps() uint64 {
+	if m != nil {
+		return m.IoReadOps
+	}
+	return 0
+}
+
+func (m *ContainerMetricsEvent) GetIoWriteOps() uint64 {
+	if m != nil {
+		return m.IoWriteOps
+	}
+	return 0
+}
+
+func (m *ContainerMetricsEvent) GetCpuPressure() *PressureStall {
+	if m != nil {
+		return m.CpuPressure
+	}
+	return nil
+}
+
+func (m *ContainerMetricsEvent) GetMemoryPressure() *PressureStall {
+	if m != nil {
+		return m.MemoryPressure
+	}
+	return nil
+}
+
+func (m *ContainerMetricsEvent) GetIoPressure() *PressureStall {
+	if m != nil {
+		return m.IoPressure
+	}
+	return nil
+}
+
+// ProcessEvent describes an event that occurred related to processes starting
+// and exiting as detected by the Sensor.
+type ProcessEvent struct {
+	// The type of event described by this ProcessEvent message
+	Type ProcessEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.ProcessEventType" json:"type,omitempty"`
+	// Present when the event is a fork event. This is the PID of the
+	// new child process.
+	ForkChildPid int32 `protobuf:"zigzag32,10,opt,name=fork_child_pid,json=forkChildPid" json:"fork_child_pid,omitempty"`
+	// Present when the event is a fork event. This is the Sensor's process
+	// ID of the new child process.
+	ForkChildId string `protobuf:"bytes,11,opt,name=fork_child_id,json=forkChildId" json:"fork_child_id,omitempty"`
+	// Present when the event is an exec or exec hashed event. This is the
+	// filename of the executable that was executed.
+	ExecFilename string `protobuf:"bytes,20,opt,name=exec_filename,json=execFilename" json:"exec_filename,omitempty"`
+	// Present when the event is an exec event. Repeated for each argument
+	// passed to the executable on the command-line.
+	ExecCommandLine []string `protobuf:"bytes,21,rep,name=exec_command_line,json=execCommandLine" json:"exec_command_line,omitempty"`
+	// Present when the event is an exec event. These are the environment
+	// variables passed to the executable that are named by the Sensor's
+	// ExecEnvironment configuration, keyed by name.
+	ExecEnvironment map[string]string `protobuf:"bytes,22,rep,name=exec_environment,json=execEnvironment" json:"exec_environment,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Present when the event is an exec hashed event. This is the
+	// hex-encoded SHA-256 of the executed file.
+	ExecSha256 string `protobuf:"bytes,23,opt,name=exec_sha256,json=execSha256" json:"exec_sha256,omitempty"`
+	// Present when the event is an exec hashed event. This is the size
+	// of the executed file in bytes.
+	ExecSize uint64 `protobuf:"varint,24,opt,name=exec_size,json=execSize" json:"exec_size,omitempty"`
+	// Present when the event is an exec hashed event. This is the inode
+	// number of the executed file.
+	ExecInode uint64 `protobuf:"varint,25,opt,name=exec_inode,json=execInode" json:"exec_inode,omitempty"`
+	// Present when the event is an exec hashed event. This is the
+	// modification time of the executed file in nanoseconds since
+	// January 1, 1970 UTC.
+	ExecMtimeNanos int64 `protobuf:"varint,26,opt,name=exec_mtime_nanos,json=execMtimeNanos" json:"exec_mtime_nanos,omitempty"`
+	// Present when the event is an exit event. This is the exit code that
+	// the process exited with.
+	ExitCode int32 `protobuf:"zigzag32,30,opt,name=exit_code,json=exitCode" json:"exit_code,omitempty"`
+	// Present when the event is an exit event. This will typically be one9
+	// of the values defined in stdlib.h like EXIT_SUCCESS, EXIT_FAILURE,
+	// or EXIT_USAGE.
+	ExitStatus uint32 `protobuf:"varint,31,opt,name=exit_status,json=exitStatus" json:"exit_status,omitempty"`
+	// Present when the event is an exit event. If non-zero, this is the
+	// signal number that the process was terminated with.
+	ExitSignal uint32 `protobuf:"varint,32,opt,name=exit_signal,json=exitSignal" json:"exit_signal,omitempty"`
+	// Present when the event is an exit event. If true, indicates that the
+	// process dumped a core when it terminated.
+	ExitCoreDumped bool `protobuf:"varint,33,opt,name=exit_core_dumped,json=exitCoreDumped" json:"exit_core_dumped,omitempty"`
+	// Present when the event is an exit event. This is the resource
+	// usage of the exiting task (thread), read from /proc in the
+	// background after the exit, so exit events may be delivered
+	// after later events. Fields other than lifetime_nanos are zero
+	// if the task has already been reaped by the time it's read. All
+	// fields are zero if the task was not started while the Sensor
+	// was running.
+	ExitResourceUsage *ResourceUsage `protobuf:"bytes,34,opt,name=exit_resource_usage,json=exitResourceUsage" json:"exit_resource_usage,omitempty"`
+	// Present when the event is a credentials change event. These are
+	// the credentials of the process before the change.
+	OldCredentials *Credentials `protobuf:"bytes,40,opt,name=old_credentials,json=oldCredentials" json:"old_credentials,omitempty"`
+	// Present when the event is a credentials change event. These are
+	// the credentials of the process after the change.
+	NewCredentials *Credentials `protobuf:"bytes,41,opt,name=new_credentials,json=newCredentials" json:"new_credentials,omitempty"`
+}
+
+func (m *ProcessEvent) Reset()                    { *m = ProcessEvent{} }
+func (m *ProcessEvent) String() string            { return proto.CompactTextString(m) }
+func (*ProcessEvent) ProtoMessage()               {}
+func (*ProcessEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{7} }
+
+func (m *ProcessEvent) GetType() ProcessEventType {
+	if m != nil {
+		return m.Type
+	}
+	return ProcessEventType_PROCESS_EVENT_TYPE_UNKNOWN
+}
+
+func (m *ProcessEvent) GetForkChildPid() int32 {
+	if m != nil {
+		return m.ForkChildPid
+	}
+	return 0
+}
+
+func (m *ProcessEvent) GetForkChildId() string {
+	if m != nil {
+		return m.ForkChildId
+	}
+	return ""
+}
+
+func (m *ProcessEvent) GetExecFilename() string {
+	if m != nil {
+		return m.ExecFilename
+	}
+	return ""
+}
+
+func (m *ProcessEvent) GetExecCommandLine() []string {
+	if m != nil {
+		return m.ExecCommandLine
+	}
+	return nil
+}
+
+func (m *ProcessEvent) GetExecEnvironment() map[string]string {
+	if m != nil {
+		return m.ExecEnvironment
+	}
+	return nil
+}
+
+func (m *ProcessEvent) GetExecSha256() string {
+	if m != nil {
+		return m.ExecSha256
+	}
+	return ""
+}
+
+func (m *ProcessEvent) GetExecSize() uint64 {
+	if m != nil {
+		return m.ExecSize
+	}
+	return 0
+}
+
+func (m *ProcessEvent) GetExecInode() uint64 {
+	if m != nil {
+		return m.ExecInode
+	}
+	return 0
+}
+
+func (m *ProcessEvent) GetExecMtimeNanos() int64 {
+	if m != nil {
+		return m.ExecMtimeNanos
+	}
+	return 0
+}
+
+func (m *ProcessEvent) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *ProcessEvent) GetExitStatus() uint32 {
 	if m != nil {
 		return m.ExitStatus
 	}
@@ -1008,6 +1891,27 @@ func (m *ProcessEvent) GetExitCoreDumped() bool {
 	return false
 }
 
+func (m *ProcessEvent) GetExitResourceUsage() *ResourceUsage {
+	if m != nil {
+		return m.ExitResourceUsage
+	}
+	return nil
+}
+
+func (m *ProcessEvent) GetOldCredentials() *Credentials {
+	if m != nil {
+		return m.OldCredentials
+	}
+	return nil
+}
+
+func (m *ProcessEvent) GetNewCredentials() *Credentials {
+	if m != nil {
+		return m.NewCredentials
+	}
+	return nil
+}
+
 // SyscallEvent describes an event that occurred related to system calls being
 // made or returning as detected by the Sensor.
 type SyscallEvent struct {
@@ -1015,33 +1919,47 @@ type SyscallEvent struct {
 	Type SyscallEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.SyscallEventType" json:"type,omitempty"`
 	// The syscall number for either enter or exit events.
 	Id int64 `protobuf:"varint,2,opt,name=id" json:"id,omitempty"`
-	// Present when the event is an enter event. This is the first
-	// argument passed to the system call.
+	// The name of the system call (e.g., "openat") for either enter or
+	// exit events. This is resolved by the Sensor for the architecture
+	// on which it is running, and is empty if the syscall number is not
+	// known.
+	Name string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	// Present when the event is an enter or complete event. This is the
+	// first argument passed to the system call.
 	Arg0 uint64 `protobuf:"varint,10,opt,name=arg0" json:"arg0,omitempty"`
-	// Present when the event is an enter event. This is the second
-	// argument passed to the system call.
+	// Present when the event is an enter or complete event. This is the
+	// second argument passed to the system call.
 	Arg1 uint64 `protobuf:"varint,11,opt,name=arg1" json:"arg1,omitempty"`
-	// Present when the event is an enter event. This is the third
-	// argument passed to the system call.
+	// Present when the event is an enter or complete event. This is the
+	// third argument passed to the system call.
 	Arg2 uint64 `protobuf:"varint,12,opt,name=arg2" json:"arg2,omitempty"`
-	// Present when the event is an enter event. This is the fourth
-	// argument passed to the system call.
+	// Present when the event is an enter or complete event. This is the
+	// fourth argument passed to the system call.
 	Arg3 uint64 `protobuf:"varint,13,opt,name=arg3" json:"arg3,omitempty"`
-	// Present when the event is an enter event. This is the fifth
-	// argument passed to the system call.
+	// Present when the event is an enter or complete event. This is the
+	// fifth argument passed to the system call.
 	Arg4 uint64 `protobuf:"varint,14,opt,name=arg4" json:"arg4,omitempty"`
-	// Present when the event is an enter event. This is the sixth
-	// argument passed to the system call.
+	// Present when the event is an enter or complete event. This is the
+	// sixth argument passed to the system call.
 	Arg5 uint64 `protobuf:"varint,15,opt,name=arg5" json:"arg5,omitempty"`
-	// Present when the event is an exit event. This is the value that was
-	// returned from the system call.
+	// Present when the event is an enter or complete event for a system
+	// call selected by name in the SyscallEventFilter. This is a map of the
+	// system call's argument names to their values, typed as described
+	// by the system call's sys_enter tracepoint format. Arguments that
+	// point to user-space strings (e.g., "filename") are strings.
+	Arguments map[string]*KernelFunctionCallEvent_FieldValue `protobuf:"bytes,16,rep,name=arguments" json:"arguments,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Present when the event is an exit or complete event. This is the
+	// value that was returned from the system call.
 	Ret int64 `protobuf:"varint,20,opt,name=ret" json:"ret,omitempty"`
+	// Present when the event is a complete event. This is the time
+	// between the enter and exit of the system call in nanoseconds.
+	DurationNanos uint64 `protobuf:"varint,21,opt,name=duration_nanos,json=durationNanos" json:"duration_nanos,omitempty"`
 }
 
 func (m *SyscallEvent) Reset()                    { *m = SyscallEvent{} }
 func (m *SyscallEvent) String() string            { return proto.CompactTextString(m) }
 func (*SyscallEvent) ProtoMessage()               {}
-func (*SyscallEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{5} }
+func (*SyscallEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{8} }
 
 func (m *SyscallEvent) GetType() SyscallEventType {
 	if m != nil {
@@ -1057,6 +1975,13 @@ func (m *SyscallEvent) GetId() int64 {
 	return 0
 }
 
+func (m *SyscallEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 func (m *SyscallEvent) GetArg0() uint64 {
 	if m != nil {
 		return m.Arg0
@@ -1099,6 +2024,13 @@ func (m *SyscallEvent) GetArg5() uint64 {
 	return 0
 }
 
+func (m *SyscallEvent) GetArguments() map[string]*KernelFunctionCallEvent_FieldValue {
+	if m != nil {
+		return m.Arguments
+	}
+	return nil
+}
+
 func (m *SyscallEvent) GetRet() int64 {
 	if m != nil {
 		return m.Ret
@@ -1106,6 +2038,13 @@ func (m *SyscallEvent) GetRet() int64 {
 	return 0
 }
 
+func (m *SyscallEvent) GetDurationNanos() uint64 {
+	if m != nil {
+		return m.DurationNanos
+	}
+	return 0
+}
+
 // FileEvent describes an event that occurred related to file operations
 // occurring as detected by the Sensor.
 type FileEvent struct {
@@ -1121,12 +2060,32 @@ type FileEvent struct {
 	// Present when the event is a file open event. This is the set of file
 	// permissions used in a creat(2) system call.
 	OpenMode int32 `protobuf:"zigzag32,12,opt,name=open_mode,json=openMode" json:"open_mode,omitempty"`
+	// Present when the event is a file rename event. This is the
+	// filename of the file being renamed.
+	OldFilename string `protobuf:"bytes,13,opt,name=old_filename,json=oldFilename" json:"old_filename,omitempty"`
+	// Present when the event is a file rename event. This is the new
+	// filename for the file being renamed.
+	NewFilename string `protobuf:"bytes,14,opt,name=new_filename,json=newFilename" json:"new_filename,omitempty"`
+	// Present when the event is a file permission change or directory
+	// creation event. This is the set of file permissions requested.
+	Mode int32 `protobuf:"zigzag32,15,opt,name=mode" json:"mode,omitempty"`
+	// Present when the event is a file ownership change event. This is
+	// the new owner's uid, or 0xffffffff (-1) if the owner is not
+	// being changed.
+	Uid uint32 `protobuf:"varint,16,opt,name=uid" json:"uid,omitempty"`
+	// Present when the event is a file ownership change event. This is
+	// the new group's gid, or 0xffffffff (-1) if the group is not
+	// being changed.
+	Gid uint32 `protobuf:"varint,17,opt,name=gid" json:"gid,omitempty"`
+	// Present when the event is a file truncate event. This is the
+	// length to which the file is being truncated.
+	Length int64 `protobuf:"zigzag64,18,opt,name=length" json:"length,omitempty"`
 }
 
 func (m *FileEvent) Reset()                    { *m = FileEvent{} }
 func (m *FileEvent) String() string            { return proto.CompactTextString(m) }
 func (*FileEvent) ProtoMessage()               {}
-func (*FileEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{6} }
+func (*FileEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{9} }
 
 func (m *FileEvent) GetType() FileEventType {
 	if m != nil {
@@ -1156,15 +2115,57 @@ func (m *FileEvent) GetOpenMode() int32 {
 	return 0
 }
 
-type Process struct {
-	Pid     int32  `protobuf:"zigzag32,1,opt,name=pid" json:"pid,omitempty"`
-	Command string `protobuf:"bytes,2,opt,name=command" json:"command,omitempty"`
+func (m *FileEvent) GetOldFilename() string {
+	if m != nil {
+		return m.OldFilename
+	}
+	return ""
 }
 
-func (m *Process) Reset()                    { *m = Process{} }
-func (m *Process) String() string            { return proto.CompactTextString(m) }
-func (*Process) ProtoMessage()               {}
-func (*Process) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{7} }
+func (m *FileEvent) GetNewFilename() string {
+	if m != nil {
+		return m.NewFilename
+	}
+	return ""
+}
+
+func (m *FileEvent) GetMode() int32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *FileEvent) GetUid() uint32 {
+	if m != nil {
+		return m.Uid
+	}
+	return 0
+}
+
+func (m *FileEvent) GetGid() uint32 {
+	if m != nil {
+		return m.Gid
+	}
+	return 0
+}
+
+func (m *FileEvent) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+type Process struct {
+	Pid     int32  `protobuf:"zigzag32,1,opt,name=pid" json:"pid,omitempty"`
+	Command string `protobuf:"bytes,2,opt,name=command" json:"command,omitempty"`
+}
+
+func (m *Process) Reset()                    { *m = Process{} }
+func (m *Process) String() string            { return proto.CompactTextString(m) }
+func (*Process) ProtoMessage()               {}
+func (*Process) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{10} }
 
 func (m *Process) GetPid() int32 {
 	if m != nil {
@@ -1193,7 +2194,7 @@ type KernelFunctionCallEvent struct {
 func (m *KernelFunctionCallEvent) Reset()                    { *m = KernelFunctionCallEvent{} }
 func (m *KernelFunctionCallEvent) String() string            { return proto.CompactTextString(m) }
 func (*KernelFunctionCallEvent) ProtoMessage()               {}
-func (*KernelFunctionCallEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{8} }
+func (*KernelFunctionCallEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{11} }
 
 func (m *KernelFunctionCallEvent) GetArguments() map[string]*KernelFunctionCallEvent_FieldValue {
 	if m != nil {
@@ -1212,6 +2213,7 @@ type KernelFunctionCallEvent_FieldValue struct {
 	//	*KernelFunctionCallEvent_FieldValue_StringValue
 	//	*KernelFunctionCallEvent_FieldValue_SignedValue
 	//	*KernelFunctionCallEvent_FieldValue_UnsignedValue
+	//	*KernelFunctionCallEvent_FieldValue_ArrayValue
 	Value isKernelFunctionCallEvent_FieldValue_Value `protobuf_oneof:"value"`
 }
 
@@ -1219,7 +2221,7 @@ func (m *KernelFunctionCallEvent_FieldValue) Reset()         { *m = KernelFuncti
 func (m *KernelFunctionCallEvent_FieldValue) String() string { return proto.CompactTextString(m) }
 func (*KernelFunctionCallEvent_FieldValue) ProtoMessage()    {}
 func (*KernelFunctionCallEvent_FieldValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{8, 0}
+	return fileDescriptor1, []int{11, 0}
 }
 
 type isKernelFunctionCallEvent_FieldValue_Value interface {
@@ -1238,12 +2240,16 @@ type KernelFunctionCallEvent_FieldValue_SignedValue struct {
 type KernelFunctionCallEvent_FieldValue_UnsignedValue struct {
 	UnsignedValue uint64 `protobuf:"varint,5,opt,name=unsigned_value,json=unsignedValue,oneof"`
 }
+type KernelFunctionCallEvent_FieldValue_ArrayValue struct {
+	ArrayValue *KernelFunctionCallEvent_FieldValueArray `protobuf:"bytes,6,opt,name=array_value,json=arrayValue,oneof"`
+}
 
 func (*KernelFunctionCallEvent_FieldValue_BytesValue) isKernelFunctionCallEvent_FieldValue_Value()  {}
 func (*KernelFunctionCallEvent_FieldValue_StringValue) isKernelFunctionCallEvent_FieldValue_Value() {}
 func (*KernelFunctionCallEvent_FieldValue_SignedValue) isKernelFunctionCallEvent_FieldValue_Value() {}
 func (*KernelFunctionCallEvent_FieldValue_UnsignedValue) isKernelFunctionCallEvent_FieldValue_Value() {
 }
+func (*KernelFunctionCallEvent_FieldValue_ArrayValue) isKernelFunctionCallEvent_FieldValue_Value() {}
 
 func (m *KernelFunctionCallEvent_FieldValue) GetValue() isKernelFunctionCallEvent_FieldValue_Value {
 	if m != nil {
@@ -1287,6 +2293,13 @@ func (m *KernelFunctionCallEvent_FieldValue) GetUnsignedValue() uint64 {
 	return 0
 }
 
+func (m *KernelFunctionCallEvent_FieldValue) GetArrayValue() *KernelFunctionCallEvent_FieldValueArray {
+	if x, ok := m.GetValue().(*KernelFunctionCallEvent_FieldValue_ArrayValue); ok {
+		return x.ArrayValue
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*KernelFunctionCallEvent_FieldValue) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _KernelFunctionCallEvent_FieldValue_OneofMarshaler, _KernelFunctionCallEvent_FieldValue_OneofUnmarshaler, _KernelFunctionCallEvent_FieldValue_OneofSizer, []interface{}{
@@ -1294,6 +2307,7 @@ func (*KernelFunctionCallEvent_FieldValue) XXX_OneofFuncs() (func(msg proto.Mess
 		(*KernelFunctionCallEvent_FieldValue_StringValue)(nil),
 		(*KernelFunctionCallEvent_FieldValue_SignedValue)(nil),
 		(*KernelFunctionCallEvent_FieldValue_UnsignedValue)(nil),
+		(*KernelFunctionCallEvent_FieldValue_ArrayValue)(nil),
 	}
 }
 
@@ -1313,6 +2327,11 @@ func _KernelFunctionCallEvent_FieldValue_OneofMarshaler(msg proto.Message, b *pr
 	case *KernelFunctionCallEvent_FieldValue_UnsignedValue:
 		b.EncodeVarint(5<<3 | proto.WireVarint)
 		b.EncodeVarint(uint64(x.UnsignedValue))
+	case *KernelFunctionCallEvent_FieldValue_ArrayValue:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ArrayValue); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("KernelFunctionCallEvent_FieldValue.Value has unexpected type %T", x)
@@ -1351,6 +2370,14 @@ func _KernelFunctionCallEvent_FieldValue_OneofUnmarshaler(msg proto.Message, tag
 		x, err := b.DecodeVarint()
 		m.Value = &KernelFunctionCallEvent_FieldValue_UnsignedValue{x}
 		return true, err
+	case 6: // value.array_value
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(KernelFunctionCallEvent_FieldValueArray)
+		err := b.DecodeMessage(msg)
+		m.Value = &KernelFunctionCallEvent_FieldValue_ArrayValue{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1374,6 +2401,11 @@ func _KernelFunctionCallEvent_FieldValue_OneofSizer(msg proto.Message) (n int) {
 	case *KernelFunctionCallEvent_FieldValue_UnsignedValue:
 		n += proto.SizeVarint(5<<3 | proto.WireVarint)
 		n += proto.SizeVarint(uint64(x.UnsignedValue))
+	case *KernelFunctionCallEvent_FieldValue_ArrayValue:
+		s := proto.Size(x.ArrayValue)
+		n += proto.SizeVarint(6<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1381,6 +2413,131 @@ func _KernelFunctionCallEvent_FieldValue_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+// The representation of an array field value. Arrays of 8-bit
+// values are represented as BYTES instead.
+type KernelFunctionCallEvent_FieldValueArray struct {
+	Values []*KernelFunctionCallEvent_FieldValue `protobuf:"bytes,1,rep,name=values" json:"values,omitempty"`
+}
+
+func (m *KernelFunctionCallEvent_FieldValueArray) Reset() {
+	*m = KernelFunctionCallEvent_FieldValueArray{}
+}
+func (m *KernelFunctionCallEvent_FieldValueArray) String() string { return proto.CompactTextString(m) }
+func (*KernelFunctionCallEvent_FieldValueArray) ProtoMessage()    {}
+func (*KernelFunctionCallEvent_FieldValueArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{11, 1}
+}
+
+func (m *KernelFunctionCallEvent_FieldValueArray) GetValues() []*KernelFunctionCallEvent_FieldValue {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// UserFunctionCallEvent describes an event that occurred related to
+// user-space functions being entered or exited.
+type UserFunctionCallEvent struct {
+	// The type of user function call event
+	Type UserFunctionCallEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.UserFunctionCallEventType" json:"type,omitempty"`
+	// The path of the probed executable, as given in the filter
+	Executable string `protobuf:"bytes,2,opt,name=executable" json:"executable,omitempty"`
+	// The probed symbol, if the function was located by symbol
+	Symbol string `protobuf:"bytes,3,opt,name=symbol" json:"symbol,omitempty"`
+	// The probed file offset, if the function was located by offset
+	Offset uint64 `protobuf:"varint,4,opt,name=offset" json:"offset,omitempty"`
+	// The ID of the container in which the executable was resolved, if
+	// any
+	ContainerId string `protobuf:"bytes,5,opt,name=container_id,json=containerId" json:"container_id,omitempty"`
+	// The fetched arguments, as for KernelFunctionCallEvent
+	Arguments map[string]*KernelFunctionCallEvent_FieldValue `protobuf:"bytes,10,rep,name=arguments" json:"arguments,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *UserFunctionCallEvent) Reset()                    { *m = UserFunctionCallEvent{} }
+func (m *UserFunctionCallEvent) String() string            { return proto.CompactTextString(m) }
+func (*UserFunctionCallEvent) ProtoMessage()               {}
+func (*UserFunctionCallEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{12} }
+
+func (m *UserFunctionCallEvent) GetType() UserFunctionCallEventType {
+	if m != nil {
+		return m.Type
+	}
+	return UserFunctionCallEventType_USER_FUNCTION_CALL_EVENT_TYPE_UNKNOWN
+}
+
+func (m *UserFunctionCallEvent) GetExecutable() string {
+	if m != nil {
+		return m.Executable
+	}
+	return ""
+}
+
+func (m *UserFunctionCallEvent) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *UserFunctionCallEvent) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *UserFunctionCallEvent) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *UserFunctionCallEvent) GetArguments() map[string]*KernelFunctionCallEvent_FieldValue {
+	if m != nil {
+		return m.Arguments
+	}
+	return nil
+}
+
+// TracepointEvent describes an occurrence of an arbitrary kernel
+// tracepoint.
+type TracepointEvent struct {
+	// The tracepoint's subsystem (e.g., "sched")
+	Subsystem string `protobuf:"bytes,1,opt,name=subsystem" json:"subsystem,omitempty"`
+	// The tracepoint's name within its subsystem (e.g., "sched_switch")
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// The tracepoint's fields as described by its format. The keys are
+	// the field names.
+	Fields map[string]*KernelFunctionCallEvent_FieldValue `protobuf:"bytes,10,rep,name=fields" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *TracepointEvent) Reset()                    { *m = TracepointEvent{} }
+func (m *TracepointEvent) String() string            { return proto.CompactTextString(m) }
+func (*TracepointEvent) ProtoMessage()               {}
+func (*TracepointEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{13} }
+
+func (m *TracepointEvent) GetSubsystem() string {
+	if m != nil {
+		return m.Subsystem
+	}
+	return ""
+}
+
+func (m *TracepointEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TracepointEvent) GetFields() map[string]*KernelFunctionCallEvent_FieldValue {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
 // NetworkEvent describes an event that occurred related to network activity
 // occurring as detected by the Sensor.
 type NetworkEvent struct {
@@ -1406,7 +2563,7 @@ type NetworkEvent struct {
 func (m *NetworkEvent) Reset()                    { *m = NetworkEvent{} }
 func (m *NetworkEvent) String() string            { return proto.CompactTextString(m) }
 func (*NetworkEvent) ProtoMessage()               {}
-func (*NetworkEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{9} }
+func (*NetworkEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{14} }
 
 func (m *NetworkEvent) GetType() NetworkEventType {
 	if m != nil {
@@ -1443,140 +2600,757 @@ func (m *NetworkEvent) GetBacklog() uint64 {
 	return 0
 }
 
+// LostEventsEvent describes kernel events that were lost before they could be
+// read by the Sensor because the kernel's buffer was full. The buffer is shared
+// by all of the Sensor's kernel events, so the lost events may or may not have
+// matched the subscription. The CPU on which the events were lost is reported
+// in the enclosing TelemetryEvent's cpu field.
+type LostEventsEvent struct {
+	// The number of events that were lost, across all subscriptions.
+	Count uint64 `protobuf:"varint,1,opt,name=count" json:"count,omitempty"`
+	// The events were lost after this time, which is the time of the
+	// last event received from the same CPU. This is zero if no prior
+	// event from the CPU is known.
+	StartMonotimeNanos int64 `protobuf:"varint,2,opt,name=start_monotime_nanos,json=startMonotimeNanos" json:"start_monotime_nanos,omitempty"`
+	// The events were lost before this time.
+	EndMonotimeNanos int64 `protobuf:"varint,3,opt,name=end_monotime_nanos,json=endMonotimeNanos" json:"end_monotime_nanos,omitempty"`
+}
+
+func (m *LostEventsEvent) Reset()                    { *m = LostEventsEvent{} }
+func (m *LostEventsEvent) String() string            { return proto.CompactTextString(m) }
+func (*LostEventsEvent) ProtoMessage()               {}
+func (*LostEventsEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{15} }
+
+func (m *LostEventsEvent) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *LostEventsEvent) GetStartMonotimeNanos() int64 {
+	if m != nil {
+		return m.StartMonotimeNanos
+	}
+	return 0
+}
+
+func (m *LostEventsEvent) GetEndMonotimeNanos() int64 {
+	if m != nil {
+		return m.EndMonotimeNanos
+	}
+	return 0
+}
+
+// DroppedEventsEvent describes events that matched the subscription but were
+// dropped by the Sensor because the subscriber was not receiving events as
+// fast as they occurred. See BackpressureOptions.
+type DroppedEventsEvent struct {
+	// The number of events that were dropped since the last
+	// DroppedEventsEvent delivered to the subscriber.
+	Count uint64 `protobuf:"varint,1,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *DroppedEventsEvent) Reset()                    { *m = DroppedEventsEvent{} }
+func (m *DroppedEventsEvent) String() string            { return proto.CompactTextString(m) }
+func (*DroppedEventsEvent) ProtoMessage()               {}
+func (*DroppedEventsEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{16} }
+
+func (m *DroppedEventsEvent) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// SignalEvent describes an event that occurred related to signals being
+// sent or delivered as detected by the Sensor. The process associated with
+// the event is the sender for generate events and the target for deliver
+// events.
+type SignalEvent struct {
+	// The type of event described by this SignalEvent message
+	Type SignalEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.SignalEventType" json:"type,omitempty"`
+	// The signal number
+	Signal uint32 `protobuf:"varint,2,opt,name=signal" json:"signal,omitempty"`
+	// The signal code (e.g., SI_USER, SI_KERNEL, SI_QUEUE, etc.)
+	Code int32 `protobuf:"zigzag32,3,opt,name=code" json:"code,omitempty"`
+	// Present when the event is a generate event. This is the PID of
+	// the sending process.
+	SenderPid int32 `protobuf:"zigzag32,10,opt,name=sender_pid,json=senderPid" json:"sender_pid,omitempty"`
+	// The PID of the target process.
+	TargetPid int32 `protobuf:"zigzag32,11,opt,name=target_pid,json=targetPid" json:"target_pid,omitempty"`
+	// Present when the event is a generate event. This is the result
+	// of generating the signal: 0 if it was delivered, 1 if it was
+	// ignored, 2 if it was already pending, 3 if it could not be
+	// queued, or 4 if it was delivered without its siginfo.
+	Result int32 `protobuf:"zigzag32,12,opt,name=result" json:"result,omitempty"`
+	// Present when the event is a generate event. True if the signal
+	// was sent to the whole thread group of the target rather than to a
+	// single thread.
+	Group bool `protobuf:"varint,13,opt,name=group" json:"group,omitempty"`
+	// Present when the event is a generate event. This is the container
+	// ID of the target process, if any.
+	TargetContainerId string `protobuf:"bytes,14,opt,name=target_container_id,json=targetContainerId" json:"target_container_id,omitempty"`
+	// Present when the event is a generate event. True if the sender
+	// and target processes are not in the same container (including
+	// when only one of them is in a container).
+	CrossContainer bool `protobuf:"varint,15,opt,name=cross_container,json=crossContainer" json:"cross_container,omitempty"`
+}
+
+func (m *SignalEvent) Reset()                    { *m = SignalEvent{} }
+func (m *SignalEvent) String() string            { return proto.CompactTextString(m) }
+func (*SignalEvent) ProtoMessage()               {}
+func (*SignalEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{17} }
+
+func (m *SignalEvent) GetType() SignalEventType {
+	if m != nil {
+		return m.Type
+	}
+	return SignalEventType_SIGNAL_EVENT_TYPE_UNKNOWN
+}
+
+func (m *SignalEvent) GetSignal() uint32 {
+	if m != nil {
+		return m.Signal
+	}
+	return 0
+}
+
+func (m *SignalEvent) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *SignalEvent) GetSenderPid() int32 {
+	if m != nil {
+		return m.SenderPid
+	}
+	return 0
+}
+
+func (m *SignalEvent) GetTargetPid() int32 {
+	if m != nil {
+		return m.TargetPid
+	}
+	return 0
+}
+
+func (m *SignalEvent) GetResult() int32 {
+	if m != nil {
+		return m.Result
+	}
+	return 0
+}
+
+func (m *SignalEvent) GetGroup() bool {
+	if m != nil {
+		return m.Group
+	}
+	return false
+}
+
+func (m *SignalEvent) GetTargetContainerId() string {
+	if m != nil {
+		return m.TargetContainerId
+	}
+	return ""
+}
+
+func (m *SignalEvent) GetCrossContainer() bool {
+	if m != nil {
+		return m.CrossContainer
+	}
+	return false
+}
+
+// NamespaceEvent describes an attempt by a process to change its namespaces
+// as detected by the Sensor.
+type NamespaceEvent struct {
+	// The type of event described by this NamespaceEvent message
+	Type NamespaceEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.NamespaceEventType" json:"type,omitempty"`
+	// The namespace type flags (CLONE_NEW*) passed to the system call.
+	// For setns events, this is the nstype argument, which may be zero
+	// to allow any type of namespace. For unshare events, this is the
+	// flags argument, which may include other flags as well.
+	Flags uint64 `protobuf:"varint,2,opt,name=flags" json:"flags,omitempty"`
+	// The names of the namespace types in flags (e.g., "mnt", "pid",
+	// "net", "user", etc.)
+	Namespaces []string `protobuf:"bytes,3,rep,name=namespaces" json:"namespaces,omitempty"`
+	// Present when the event is a setns event. This is the file
+	// descriptor referring to the namespace to join.
+	Fd int32 `protobuf:"zigzag32,4,opt,name=fd" json:"fd,omitempty"`
+}
+
+func (m *NamespaceEvent) Reset()                    { *m = NamespaceEvent{} }
+func (m *NamespaceEvent) String() string            { return proto.CompactTextString(m) }
+func (*NamespaceEvent) ProtoMessage()               {}
+func (*NamespaceEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{18} }
+
+func (m *NamespaceEvent) GetType() NamespaceEventType {
+	if m != nil {
+		return m.Type
+	}
+	return NamespaceEventType_NAMESPACE_EVENT_TYPE_UNKNOWN
+}
+
+func (m *NamespaceEvent) GetFlags() uint64 {
+	if m != nil {
+		return m.Flags
+	}
+	return 0
+}
+
+func (m *NamespaceEvent) GetNamespaces() []string {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *NamespaceEvent) GetFd() int32 {
+	if m != nil {
+		return m.Fd
+	}
+	return 0
+}
+
+// MountEvent describes an attempt by a process to change its mounts as
+// detected by the Sensor.
+type MountEvent struct {
+	// The type of event described by this MountEvent message
+	Type MountEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.MountEventType" json:"type,omitempty"`
+	// Present when the event is a mount event. This is the source of
+	// the mount (e.g., a device or a directory for bind mounts).
+	Source string `protobuf:"bytes,2,opt,name=source" json:"source,omitempty"`
+	// The target of the event. For mount and umount events, this is the
+	// mount point. For pivot_root events, this is the new root.
+	Target string `protobuf:"bytes,3,opt,name=target" json:"target,omitempty"`
+	// Present when the event is a mount event. This is the filesystem
+	// type (e.g., "ext4", "proc", "overlay", etc.)
+	Fstype string `protobuf:"bytes,4,opt,name=fstype" json:"fstype,omitempty"`
+	// The flags passed to the system call. For mount events, these are
+	// the MS_* mount flags. For umount events, these are the MNT_*
+	// unmount flags.
+	Flags uint64 `protobuf:"varint,5,opt,name=flags" json:"flags,omitempty"`
+	// The names of the flags in flags (e.g., "ro", "bind", "remount",
+	// "detach", etc.)
+	FlagNames []string `protobuf:"bytes,6,rep,name=flag_names,json=flagNames" json:"flag_names,omitempty"`
+	// Present when the event is a pivot_root event. This is the
+	// directory to which the current root is moved.
+	PutOld string `protobuf:"bytes,7,opt,name=put_old,json=putOld" json:"put_old,omitempty"`
+}
+
+func (m *MountEvent) Reset()                    { *m = MountEvent{} }
+func (m *MountEvent) String() string            { return proto.CompactTextString(m) }
+func (*MountEvent) ProtoMessage()               {}
+func (*MountEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{19} }
+
+func (m *MountEvent) GetType() MountEventType {
+	if m != nil {
+		return m.Type
+	}
+	return MountEventType_MOUNT_EVENT_TYPE_UNKNOWN
+}
+
+func (m *MountEvent) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *MountEvent) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *MountEvent) GetFstype() string {
+	if m != nil {
+		return m.Fstype
+	}
+	return ""
+}
+
+func (m *MountEvent) GetFlags() uint64 {
+	if m != nil {
+		return m.Flags
+	}
+	return 0
+}
+
+func (m *MountEvent) GetFlagNames() []string {
+	if m != nil {
+		return m.FlagNames
+	}
+	return nil
+}
+
+func (m *MountEvent) GetPutOld() string {
+	if m != nil {
+		return m.PutOld
+	}
+	return ""
+}
+
+// KernelModuleEvent describes the loading or unloading of a kernel module
+// as detected by the Sensor. The process loading or unloading the module is
+// the process associated with the event.
+type KernelModuleEvent struct {
+	// The type of event described by this KernelModuleEvent message
+	Type KernelModuleEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.KernelModuleEventType" json:"type,omitempty"`
+	// The name of the module. This is not present for load attempts,
+	// since the name is not known until the module image is parsed by
+	// the kernel.
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// The flags passed to the system call. For finit_module(2) load
+	// attempts, these are the MODULE_INIT_* flags. For unload attempts,
+	// these are the O_* flags passed to delete_module(2).
+	Flags uint64 `protobuf:"varint,3,opt,name=flags" json:"flags,omitempty"`
+	// The names of the flags in flags (e.g., "ignore_modversions",
+	// "ignore_vermagic", "nonblock", "trunc")
+	FlagNames []string `protobuf:"bytes,4,rep,name=flag_names,json=flagNames" json:"flag_names,omitempty"`
+	// Present when the event is a load attempt. These are the module
+	// parameters passed to the system call.
+	Params string `protobuf:"bytes,5,opt,name=params" json:"params,omitempty"`
+	// Present when the event is a load attempt via finit_module(2). This
+	// is the file descriptor of the module image; it is -1 for
+	// init_module(2).
+	Fd int32 `protobuf:"zigzag32,6,opt,name=fd" json:"fd,omitempty"`
+	// Present when the event is a load event. This is the set of taint
+	// flags for the module.
+	Taints uint32 `protobuf:"varint,7,opt,name=taints" json:"taints,omitempty"`
+}
+
+func (m *KernelModuleEvent) Reset()                    { *m = KernelModuleEvent{} }
+func (m *KernelModuleEvent) String() string            { return proto.CompactTextString(m) }
+func (*KernelModuleEvent) ProtoMessage()               {}
+func (*KernelModuleEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{20} }
+
+func (m *KernelModuleEvent) GetType() KernelModuleEventType {
+	if m != nil {
+		return m.Type
+	}
+	return KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_UNKNOWN
+}
+
+func (m *KernelModuleEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *KernelModuleEvent) GetFlags() uint64 {
+	if m != nil {
+		return m.Flags
+	}
+	return 0
+}
+
+func (m *KernelModuleEvent) GetFlagNames() []string {
+	if m != nil {
+		return m.FlagNames
+	}
+	return nil
+}
+
+func (m *KernelModuleEvent) GetParams() string {
+	if m != nil {
+		return m.Params
+	}
+	return ""
+}
+
+func (m *KernelModuleEvent) GetFd() int32 {
+	if m != nil {
+		return m.Fd
+	}
+	return 0
+}
+
+func (m *KernelModuleEvent) GetTaints() uint32 {
+	if m != nil {
+		return m.Taints
+	}
+	return 0
+}
+
+// ProcessAccessEvent describes an attempt by a process to access another
+// process via ptrace(2) or process_vm_readv(2)/process_vm_writev(2) as
+// detected by the Sensor.
+type ProcessAccessEvent struct {
+	// The type of event described by this ProcessAccessEvent message
+	Type ProcessAccessEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.ProcessAccessEventType" json:"type,omitempty"`
+	// The pid of the task accessing the tracee. For PTRACE_TRACEME
+	// requests, this is the parent of the calling task.
+	TracerPid int32 `protobuf:"zigzag32,2,opt,name=tracer_pid,json=tracerPid" json:"tracer_pid,omitempty"`
+	// The pid of the task being accessed. For PTRACE_TRACEME requests,
+	// this is the calling task.
+	TraceePid int32 `protobuf:"zigzag32,3,opt,name=tracee_pid,json=traceePid" json:"tracee_pid,omitempty"`
+	// Present when the event is a ptrace event. This is the ptrace
+	// request (e.g., PTRACE_ATTACH, PTRACE_POKETEXT, etc.)
+	Request int64 `protobuf:"zigzag64,4,opt,name=request" json:"request,omitempty"`
+	// Present when the event is a ptrace event. This is the name of the
+	// ptrace request (e.g., "attach", "poketext", etc.) if it is known.
+	RequestName string `protobuf:"bytes,5,opt,name=request_name,json=requestName" json:"request_name,omitempty"`
+	// The ID of the container of the tracer, if any
+	TracerContainerId string `protobuf:"bytes,6,opt,name=tracer_container_id,json=tracerContainerId" json:"tracer_container_id,omitempty"`
+	// The ID of the container of the tracee, if any
+	TraceeContainerId string `protobuf:"bytes,7,opt,name=tracee_container_id,json=traceeContainerId" json:"tracee_container_id,omitempty"`
+	// True if the tracer and the tracee are in different processes
+	CrossProcess bool `protobuf:"varint,8,opt,name=cross_process,json=crossProcess" json:"cross_process,omitempty"`
+	// True if the tracer and the tracee are in different containers or
+	// if only one of them is in a container
+	CrossContainer bool `protobuf:"varint,9,opt,name=cross_container,json=crossContainer" json:"cross_container,omitempty"`
+}
+
+func (m *ProcessAccessEvent) Reset()                    { *m = ProcessAccessEvent{} }
+func (m *ProcessAccessEvent) String() string            { return proto.CompactTextString(m) }
+func (*ProcessAccessEvent) ProtoMessage()               {}
+func (*ProcessAccessEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{21} }
+
+func (m *ProcessAccessEvent) GetType() ProcessAccessEventType {
+	if m != nil {
+		return m.Type
+	}
+	return ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_UNKNOWN
+}
+
+func (m *ProcessAccessEvent) GetTracerPid() int32 {
+	if m != nil {
+		return m.TracerPid
+	}
+	return 0
+}
+
+func (m *ProcessAccessEvent) GetTraceePid() int32 {
+	if m != nil {
+		return m.TraceePid
+	}
+	return 0
+}
+
+func (m *ProcessAccessEvent) GetRequest() int64 {
+	if m != nil {
+		return m.Request
+	}
+	return 0
+}
+
+func (m *ProcessAccessEvent) GetRequestName() string {
+	if m != nil {
+		return m.RequestName
+	}
+	return ""
+}
+
+func (m *ProcessAccessEvent) GetTracerContainerId() string {
+	if m != nil {
+		return m.TracerContainerId
+	}
+	return ""
+}
+
+func (m *ProcessAccessEvent) GetTraceeContainerId() string {
+	if m != nil {
+		return m.TraceeContainerId
+	}
+	return ""
+}
+
+func (m *ProcessAccessEvent) GetCrossProcess() bool {
+	if m != nil {
+		return m.CrossProcess
+	}
+	return false
+}
+
+func (m *ProcessAccessEvent) GetCrossContainer() bool {
+	if m != nil {
+		return m.CrossContainer
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*TelemetryEvent)(nil), "capsule8.api.v0.TelemetryEvent")
 	proto.RegisterType((*ChargenEvent)(nil), "capsule8.api.v0.ChargenEvent")
 	proto.RegisterType((*TickerEvent)(nil), "capsule8.api.v0.TickerEvent")
 	proto.RegisterType((*ContainerEvent)(nil), "capsule8.api.v0.ContainerEvent")
+	proto.RegisterType((*PressureStallAverages)(nil), "capsule8.api.v0.PressureStallAverages")
+	proto.RegisterType((*PressureStall)(nil), "capsule8.api.v0.PressureStall")
+	proto.RegisterType((*ContainerMetricsEvent)(nil), "capsule8.api.v0.ContainerMetricsEvent")
 	proto.RegisterType((*ProcessEvent)(nil), "capsule8.api.v0.ProcessEvent")
 	proto.RegisterType((*SyscallEvent)(nil), "capsule8.api.v0.SyscallEvent")
 	proto.RegisterType((*FileEvent)(nil), "capsule8.api.v0.FileEvent")
 	proto.RegisterType((*Process)(nil), "capsule8.api.v0.Process")
 	proto.RegisterType((*KernelFunctionCallEvent)(nil), "capsule8.api.v0.KernelFunctionCallEvent")
 	proto.RegisterType((*KernelFunctionCallEvent_FieldValue)(nil), "capsule8.api.v0.KernelFunctionCallEvent.FieldValue")
+	proto.RegisterType((*KernelFunctionCallEvent_FieldValueArray)(nil), "capsule8.api.v0.KernelFunctionCallEvent.FieldValueArray")
+	proto.RegisterType((*UserFunctionCallEvent)(nil), "capsule8.api.v0.UserFunctionCallEvent")
+	proto.RegisterType((*TracepointEvent)(nil), "capsule8.api.v0.TracepointEvent")
 	proto.RegisterType((*NetworkEvent)(nil), "capsule8.api.v0.NetworkEvent")
+	proto.RegisterType((*LostEventsEvent)(nil), "capsule8.api.v0.LostEventsEvent")
+	proto.RegisterType((*DroppedEventsEvent)(nil), "capsule8.api.v0.DroppedEventsEvent")
+	proto.RegisterType((*SignalEvent)(nil), "capsule8.api.v0.SignalEvent")
+	proto.RegisterType((*NamespaceEvent)(nil), "capsule8.api.v0.NamespaceEvent")
+	proto.RegisterType((*MountEvent)(nil), "capsule8.api.v0.MountEvent")
+	proto.RegisterType((*KernelModuleEvent)(nil), "capsule8.api.v0.KernelModuleEvent")
+	proto.RegisterType((*ProcessAccessEvent)(nil), "capsule8.api.v0.ProcessAccessEvent")
 	proto.RegisterEnum("capsule8.api.v0.ContainerEventType", ContainerEventType_name, ContainerEventType_value)
 	proto.RegisterEnum("capsule8.api.v0.ProcessEventType", ProcessEventType_name, ProcessEventType_value)
 	proto.RegisterEnum("capsule8.api.v0.SyscallEventType", SyscallEventType_name, SyscallEventType_value)
 	proto.RegisterEnum("capsule8.api.v0.FileEventType", FileEventType_name, FileEventType_value)
 	proto.RegisterEnum("capsule8.api.v0.KernelFunctionCallEventType", KernelFunctionCallEventType_name, KernelFunctionCallEventType_value)
+	proto.RegisterEnum("capsule8.api.v0.UserFunctionCallEventType", UserFunctionCallEventType_name, UserFunctionCallEventType_value)
 	proto.RegisterEnum("capsule8.api.v0.NetworkEventType", NetworkEventType_name, NetworkEventType_value)
+	proto.RegisterEnum("capsule8.api.v0.SignalEventType", SignalEventType_name, SignalEventType_value)
+	proto.RegisterEnum("capsule8.api.v0.NamespaceEventType", NamespaceEventType_name, NamespaceEventType_value)
+	proto.RegisterEnum("capsule8.api.v0.MountEventType", MountEventType_name, MountEventType_value)
+	proto.RegisterEnum("capsule8.api.v0.KernelModuleEventType", KernelModuleEventType_name, KernelModuleEventType_value)
+	proto.RegisterEnum("capsule8.api.v0.ProcessAccessEventType", ProcessAccessEventType_name, ProcessAccessEventType_value)
 	proto.RegisterEnum("capsule8.api.v0.KernelFunctionCallEvent_FieldType", KernelFunctionCallEvent_FieldType_name, KernelFunctionCallEvent_FieldType_value)
 }
 
 func init() { proto.RegisterFile("capsule8/api/v0/telemetry_event.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 1767 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x77, 0xdb, 0xc6,
-	0x11, 0x36, 0x48, 0x4a, 0x24, 0x87, 0x14, 0x05, 0x6d, 0x95, 0x04, 0x91, 0x1c, 0x89, 0xa2, 0xec,
-	0x98, 0x55, 0xfb, 0x64, 0x9b, 0x92, 0x1d, 0xb7, 0x97, 0x3c, 0x19, 0x02, 0x6b, 0x46, 0x32, 0xa8,
-	0x2e, 0x21, 0x27, 0x3e, 0xe1, 0xc1, 0xc0, 0x8a, 0x46, 0x45, 0x02, 0x0c, 0x00, 0xba, 0xd6, 0xb5,
-	0xb7, 0x1e, 0x7a, 0xe8, 0xa9, 0xc7, 0x5e, 0xfb, 0xa7, 0x34, 0xe9, 0x1f, 0xd1, 0xd7, 0x63, 0xef,
-	0x3d, 0xf7, 0xf5, 0xed, 0xec, 0x82, 0x3f, 0x24, 0xc2, 0x72, 0x6f, 0xb9, 0xed, 0x7e, 0xdf, 0x37,
-	0xb3, 0x3b, 0x33, 0xd8, 0xd9, 0x7d, 0x80, 0xfb, 0xae, 0x33, 0x8a, 0xc7, 0x03, 0xf6, 0xec, 0xa1,
-	0x33, 0xf2, 0x1f, 0xbe, 0x7b, 0xf4, 0x30, 0x61, 0x03, 0x36, 0x64, 0x49, 0x74, 0x65, 0xb3, 0x77,
-	0x2c, 0x48, 0xf6, 0x47, 0x51, 0x98, 0x84, 0x64, 0x35, 0x95, 0xed, 0x3b, 0x23, 0x7f, 0xff, 0xdd,
-	0xa3, 0x8d, 0xcd, 0x1b, 0x76, 0x57, 0x23, 0x16, 0x0b, 0x75, 0xe3, 0x6f, 0x25, 0xa8, 0x59, 0xa9,
-	0x1f, 0x83, 0xbb, 0x21, 0x35, 0xc8, 0xf9, 0x9e, 0xa6, 0xd4, 0x95, 0x66, 0x99, 0xe6, 0x7c, 0x8f,
-	0x7c, 0x01, 0x30, 0x8a, 0x42, 0x97, 0xc5, 0xb1, 0xed, 0x7b, 0x5a, 0x0e, 0xf1, 0xb2, 0x44, 0x3a,
-	0x1e, 0xd9, 0x86, 0x4a, 0x4a, 0x8f, 0x7c, 0x4f, 0xcb, 0xd7, 0x95, 0xe6, 0x12, 0x4d, 0x2d, 0xce,
-	0x7c, 0x8f, 0xec, 0x40, 0xd5, 0x0d, 0x83, 0xc4, 0xf1, 0x03, 0x16, 0x71, 0x0f, 0x05, 0xf4, 0x50,
-	0x99, 0x60, 0x1d, 0x8f, 0x6c, 0x42, 0x39, 0x66, 0x41, 0x1c, 0x22, 0xbf, 0x84, 0x7c, 0x49, 0x00,
-	0x1d, 0x8f, 0x1c, 0xc2, 0xa7, 0x92, 0x8c, 0xd9, 0xf7, 0x63, 0x16, 0xb8, 0xcc, 0x0e, 0xc6, 0xc3,
-	0x37, 0x2c, 0xd2, 0x96, 0xeb, 0x4a, 0xb3, 0x40, 0xd7, 0x05, 0xdb, 0x93, 0xa4, 0x89, 0x1c, 0x69,
-	0xc1, 0x27, 0xd2, 0x6a, 0x18, 0x06, 0x61, 0xe2, 0x0f, 0x99, 0x1d, 0x38, 0x41, 0x18, 0x6b, 0xc5,
-	0xba, 0xd2, 0xcc, 0xd3, 0x9f, 0x09, 0xf2, 0xa5, 0xe4, 0x4c, 0x4e, 0x91, 0x23, 0x58, 0x4d, 0x43,
-	0x19, 0xf8, 0x01, 0x73, 0xfa, 0x4c, 0x2b, 0xd5, 0xf3, 0xcd, 0x4a, 0x4b, 0xdb, 0xbf, 0x96, 0xd4,
-	0xfd, 0x33, 0xa1, 0xa3, 0x35, 0x69, 0x70, 0x2a, 0xf4, 0xe4, 0x3e, 0xd4, 0xa6, 0xc1, 0x06, 0xce,
-	0x90, 0x69, 0x5b, 0x18, 0xce, 0xca, 0x04, 0x35, 0x9d, 0x21, 0x23, 0x9f, 0x43, 0xc9, 0x1f, 0x3a,
-	0x7d, 0xc6, 0xe3, 0xdd, 0x46, 0x41, 0x11, 0xe7, 0x1d, 0x4c, 0xb7, 0xa0, 0xd0, 0xba, 0x2e, 0xd2,
-	0x8d, 0x08, 0x5a, 0xfe, 0x0a, 0x8a, 0xf1, 0x55, 0xec, 0x3a, 0x83, 0x81, 0x06, 0x75, 0xa5, 0x59,
-	0x69, 0x7d, 0x71, 0x63, 0x6f, 0x3d, 0xc1, 0x63, 0x35, 0x5f, 0xdc, 0xa1, 0xa9, 0x9e, 0x9b, 0xca,
-	0xdd, 0x6a, 0x95, 0x0c, 0x53, 0x19, 0xd6, 0xc4, 0x54, 0xea, 0xc9, 0x23, 0x28, 0x5c, 0xf8, 0x03,
-	0xa6, 0x55, 0xd1, 0x6e, 0xe3, 0x86, 0x5d, 0xdb, 0x1f, 0xb0, 0xd4, 0x08, 0x95, 0xe4, 0x04, 0x2a,
-	0x97, 0x2c, 0x0a, 0xd8, 0xc0, 0xc6, 0xbd, 0xae, 0xa0, 0x61, 0xf3, 0x86, 0xe1, 0x09, 0x6a, 0xda,
-	0xe3, 0xc0, 0x4d, 0xfc, 0x30, 0xd0, 0x67, 0xb6, 0x0d, 0xc2, 0x5c, 0x97, 0x3b, 0x0f, 0x58, 0xf2,
-	0xfb, 0x30, 0xba, 0xd4, 0x6a, 0x19, 0x3b, 0x37, 0x05, 0x3f, 0xd9, 0xb9, 0xd4, 0x93, 0xaf, 0xa1,
-	0x3c, 0x49, 0xbd, 0xb6, 0x8e, 0xc6, 0xdb, 0x37, 0x8c, 0xf5, 0x54, 0x91, 0x9a, 0x4f, 0x6d, 0xf8,
-	0xda, 0xee, 0x5b, 0x27, 0xea, 0xb3, 0x40, 0xf3, 0x32, 0xd6, 0xd6, 0x05, 0x3f, 0x59, 0x5b, 0xea,
-	0xc9, 0x53, 0x58, 0x4e, 0x7c, 0xf7, 0x92, 0x45, 0x1a, 0x43, 0xcb, 0xbb, 0x37, 0x2c, 0x2d, 0xa4,
-	0x53, 0x43, 0xa9, 0x26, 0x6b, 0x90, 0x77, 0x47, 0x63, 0xed, 0x07, 0x05, 0xcf, 0x12, 0x1f, 0x93,
-	0xaf, 0xa1, 0xe2, 0x46, 0xcc, 0x63, 0x41, 0xe2, 0x3b, 0x83, 0x58, 0xfb, 0x51, 0xc9, 0x70, 0xa8,
-	0x4f, 0x45, 0x74, 0xd6, 0x82, 0x34, 0xa0, 0x9a, 0x7e, 0xdb, 0x49, 0xdf, 0xf7, 0xb4, 0x7f, 0x08,
-	0xe7, 0xe9, 0xd9, 0xb5, 0xfa, 0xbe, 0xf7, 0xbc, 0x08, 0x4b, 0xd8, 0x49, 0xbe, 0x59, 0x2e, 0xfd,
-	0x5d, 0x51, 0x7f, 0x50, 0x26, 0xac, 0x9d, 0xf8, 0x5e, 0xe3, 0x18, 0xaa, 0xb3, 0x81, 0x92, 0x75,
-	0x58, 0xf2, 0x03, 0x8f, 0xbd, 0xc7, 0x56, 0x51, 0xa0, 0x62, 0x42, 0xb6, 0x00, 0x78, 0xf8, 0x8e,
-	0x9b, 0xb0, 0x28, 0x96, 0xdd, 0x62, 0x06, 0x69, 0x74, 0xa0, 0x32, 0x13, 0x34, 0xd1, 0xa0, 0x18,
-	0x33, 0x37, 0x0c, 0xbc, 0x18, 0xdd, 0xe4, 0x69, 0x3a, 0x25, 0x75, 0xa8, 0xe0, 0x81, 0x95, 0x6c,
-	0x0e, 0xd9, 0x59, 0xa8, 0xf1, 0xe7, 0x3c, 0xd4, 0xe6, 0x2b, 0x47, 0xbe, 0x82, 0x02, 0xef, 0x6e,
-	0xe8, 0xab, 0xd6, 0xda, 0xbd, 0xa5, 0xd0, 0xd6, 0xd5, 0x88, 0x51, 0x34, 0x20, 0x04, 0x0a, 0x78,
-	0xde, 0xc4, 0x86, 0x71, 0x3c, 0x77, 0x48, 0xe1, 0x43, 0x87, 0xb4, 0x72, 0xfd, 0x90, 0x7e, 0x0e,
-	0xa5, 0xb7, 0x61, 0x9c, 0x60, 0x43, 0xe4, 0xdf, 0xdc, 0x1a, 0x2d, 0xf2, 0x39, 0xef, 0x86, 0x9b,
-	0x50, 0x66, 0xef, 0xfd, 0xc4, 0x76, 0x43, 0x4f, 0xf4, 0x86, 0x35, 0x5a, 0xe2, 0x80, 0x1e, 0x7a,
-	0x8c, 0xf7, 0x52, 0x24, 0xe3, 0xc4, 0x49, 0xc6, 0x31, 0x76, 0x86, 0x15, 0x0a, 0x1c, 0xea, 0x21,
-	0x32, 0x15, 0xf8, 0xfd, 0xc0, 0x19, 0x60, 0x77, 0x48, 0x05, 0x88, 0x90, 0x26, 0xa8, 0xd2, 0x7d,
-	0xc4, 0x6c, 0x6f, 0x3c, 0x1c, 0x31, 0x4f, 0xdb, 0xa9, 0x2b, 0xcd, 0x12, 0xad, 0x89, 0x55, 0x22,
-	0x76, 0x8c, 0x28, 0xf9, 0x25, 0x10, 0x2f, 0xe4, 0x85, 0xb0, 0xdd, 0x30, 0xb8, 0xf0, 0xfb, 0xf6,
-	0xef, 0xe2, 0x50, 0x7c, 0xe2, 0x65, 0xaa, 0x0a, 0x46, 0x47, 0xe2, 0x9b, 0x38, 0x0c, 0xc8, 0x97,
-	0xb0, 0x1a, 0xba, 0xfe, 0x9c, 0x94, 0x89, 0xc6, 0x16, 0xba, 0xfe, 0x54, 0xd7, 0xf8, 0x77, 0x0e,
-	0xaa, 0xb3, 0x4d, 0x84, 0x3c, 0x99, 0xab, 0xc8, 0xce, 0x07, 0x3b, 0xce, 0x4c, 0x3d, 0xee, 0x41,
-	0xed, 0x22, 0x8c, 0x2e, 0x6d, 0xf7, 0xad, 0x3f, 0xf0, 0x30, 0x8f, 0x80, 0xb9, 0xaa, 0x72, 0x54,
-	0xe7, 0x20, 0x4f, 0x66, 0x03, 0x56, 0x66, 0x54, 0xbe, 0x27, 0x2b, 0x51, 0x99, 0x88, 0x3a, 0x1e,
-	0xd9, 0x85, 0x15, 0xf6, 0x9e, 0xb9, 0x36, 0xef, 0x4a, 0x58, 0xad, 0x75, 0xd4, 0x54, 0x39, 0xd8,
-	0x96, 0x18, 0xd9, 0x83, 0x35, 0x14, 0xb9, 0xe1, 0x70, 0xe8, 0x04, 0x1e, 0xb6, 0x7f, 0xed, 0x93,
-	0x7a, 0xbe, 0x59, 0xa6, 0xab, 0x9c, 0xd0, 0x05, 0xce, 0xbb, 0xfc, 0x4f, 0xa6, 0x82, 0x8d, 0x7f,
-	0x2a, 0x50, 0x9d, 0xed, 0xf5, 0xb7, 0xe6, 0x7a, 0x56, 0x3c, 0x93, 0x6b, 0x71, 0xe1, 0x8b, 0x03,
-	0xc6, 0x2f, 0x7c, 0x02, 0x05, 0x27, 0xea, 0x3f, 0xc2, 0x8c, 0x17, 0x28, 0x8e, 0x25, 0xf6, 0x18,
-	0x13, 0x2c, 0xb0, 0xc7, 0x12, 0x6b, 0xe1, 0xa5, 0x20, 0xb0, 0x96, 0xc4, 0x0e, 0xb0, 0xdf, 0x0b,
-	0xec, 0x40, 0x62, 0x87, 0xd8, 0xba, 0x05, 0x76, 0x28, 0xb1, 0x27, 0xda, 0xea, 0x04, 0x7b, 0x42,
-	0x54, 0xc8, 0x47, 0x2c, 0xc1, 0xfa, 0xe4, 0x29, 0x1f, 0x36, 0xfe, 0xa2, 0x40, 0x79, 0x72, 0xb5,
-	0x90, 0xd6, 0x5c, 0x78, 0x5b, 0xd9, 0x97, 0xd0, 0x4c, 0x6c, 0x1b, 0x50, 0x9a, 0x14, 0x5e, 0x9c,
-	0xe1, 0xc9, 0x9c, 0x1f, 0xe2, 0x70, 0xc4, 0x02, 0xfb, 0x62, 0xe0, 0xf4, 0xc5, 0x95, 0xb8, 0x46,
-	0xcb, 0x1c, 0x69, 0x73, 0x80, 0xd7, 0x19, 0xe9, 0x21, 0xaf, 0x73, 0x55, 0xd4, 0x99, 0x03, 0x2f,
-	0x43, 0x8f, 0x35, 0x9e, 0x40, 0x51, 0x7e, 0xb9, 0x7c, 0xdb, 0x23, 0xf9, 0x60, 0x5a, 0xa3, 0x7c,
-	0xc8, 0x9b, 0x9a, 0xfc, 0x90, 0x64, 0x3f, 0x49, 0xa7, 0x8d, 0xff, 0x14, 0xe0, 0xb3, 0x8c, 0x2b,
-	0x8f, 0x9c, 0x43, 0xd9, 0x89, 0xfa, 0xe3, 0x21, 0x0b, 0x12, 0xde, 0x0c, 0xf9, 0xbb, 0xe3, 0xab,
-	0x8f, 0xbd, 0x2f, 0xf7, 0x8f, 0x52, 0x4b, 0x23, 0x48, 0xa2, 0x2b, 0x3a, 0xf5, 0xb4, 0xf1, 0x5f,
-	0x05, 0xa0, 0xed, 0xb3, 0x81, 0xf7, 0xca, 0x19, 0x8c, 0x19, 0xf9, 0x2d, 0xc0, 0x05, 0x9f, 0xd9,
-	0x33, 0xa9, 0x6c, 0x7d, 0xf4, 0x32, 0xe8, 0x08, 0xd3, 0x5b, 0xbe, 0x48, 0x87, 0x64, 0x07, 0x2a,
-	0x6f, 0xae, 0x12, 0x16, 0xdb, 0xef, 0xf8, 0x0a, 0x18, 0x72, 0x95, 0x5f, 0xe0, 0x08, 0x8a, 0x55,
-	0x77, 0xa1, 0x1a, 0x27, 0x91, 0x1f, 0xf4, 0xa5, 0x86, 0xbf, 0x12, 0xcb, 0x2f, 0xee, 0xd0, 0x8a,
-	0x40, 0xa7, 0x22, 0xbf, 0x1f, 0x30, 0x4f, 0x8a, 0xf8, 0x43, 0x91, 0xa0, 0x08, 0x51, 0x21, 0x7a,
-	0x00, 0xb5, 0x71, 0x30, 0x27, 0xe3, 0xef, 0xc5, 0xc2, 0x8b, 0x3b, 0x74, 0x25, 0xc5, 0x51, 0xc8,
-	0x2f, 0x33, 0xe4, 0x37, 0xbe, 0x87, 0xda, 0x7c, 0x76, 0x78, 0xc5, 0x2e, 0xd9, 0x95, 0x7c, 0xe2,
-	0xf2, 0x21, 0xe9, 0x48, 0x31, 0x6e, 0xbe, 0xd2, 0x3a, 0xf8, 0xff, 0x12, 0x82, 0x0b, 0x52, 0xe1,
-	0xe1, 0xd7, 0xb9, 0x67, 0x4a, 0xe3, 0x4f, 0xf8, 0xdd, 0xa6, 0xf9, 0xa9, 0x40, 0xf1, 0xdc, 0x3c,
-	0x31, 0xbb, 0xdf, 0x9a, 0xea, 0x1d, 0x52, 0x86, 0xa5, 0xe7, 0xaf, 0x2d, 0xa3, 0xa7, 0x2a, 0x04,
-	0x60, 0xb9, 0x67, 0xd1, 0x8e, 0xf9, 0x1b, 0x35, 0xc7, 0xe1, 0x5e, 0xc7, 0xb4, 0x9e, 0xa9, 0x79,
-	0x84, 0x3b, 0xa6, 0xf5, 0xf8, 0xa9, 0x5a, 0x48, 0xc7, 0x07, 0x2d, 0x75, 0x29, 0x1d, 0x3f, 0x3d,
-	0x54, 0x97, 0xb9, 0xfc, 0x1c, 0xe5, 0x45, 0x0e, 0x9f, 0x0b, 0x79, 0x29, 0x1d, 0x1f, 0xb4, 0xd4,
-	0x72, 0x3a, 0x7e, 0x7a, 0xa8, 0x42, 0xe3, 0x47, 0x05, 0xaa, 0xb3, 0x0f, 0xa4, 0x5b, 0x3b, 0xc5,
-	0xac, 0x78, 0xe6, 0x34, 0x7d, 0x0a, 0xcb, 0x71, 0xe8, 0x5e, 0x5e, 0x78, 0xb2, 0x37, 0xc8, 0x19,
-	0x7f, 0x23, 0x39, 0x9e, 0x17, 0x4d, 0x5f, 0x96, 0xdb, 0x59, 0x1e, 0x8f, 0x84, 0x8c, 0xa6, 0x7a,
-	0xee, 0x32, 0x62, 0xf1, 0x78, 0x90, 0xe0, 0x11, 0x23, 0x54, 0xce, 0xf8, 0x19, 0x7a, 0xe3, 0xb8,
-	0x97, 0x83, 0xb0, 0x2f, 0x7b, 0x49, 0x3a, 0xdd, 0xfb, 0x97, 0x02, 0xe4, 0xe6, 0x3d, 0x4e, 0xea,
-	0x70, 0x57, 0xef, 0x9a, 0xd6, 0x51, 0xc7, 0x34, 0xa8, 0x6d, 0xbc, 0x32, 0x4c, 0xcb, 0xb6, 0x5e,
-	0x9f, 0x19, 0xf6, 0x34, 0xf5, 0x59, 0x0a, 0x9d, 0x1a, 0x47, 0x96, 0x71, 0xac, 0x2a, 0x99, 0x0a,
-	0x7a, 0x6e, 0x9a, 0xa2, 0x4e, 0xdb, 0xb0, 0xb9, 0x50, 0x61, 0x7c, 0xd7, 0xe1, 0x2e, 0xf2, 0xa4,
-	0x01, 0x5b, 0x0b, 0x05, 0xc7, 0x46, 0xcf, 0xa2, 0xdd, 0xd7, 0xc6, 0xb1, 0x5a, 0xc8, 0xde, 0xea,
-	0xd9, 0x31, 0x6e, 0x64, 0x69, 0xef, 0x8f, 0x0a, 0xa8, 0xd7, 0x6f, 0x46, 0xb2, 0x05, 0x1b, 0x67,
-	0xb4, 0xab, 0x1b, 0xbd, 0xde, 0xe2, 0xf8, 0x36, 0xe1, 0xb3, 0x05, 0x7c, 0xbb, 0x4b, 0x4f, 0x54,
-	0x25, 0x83, 0x34, 0xbe, 0x33, 0x74, 0x35, 0x97, 0x49, 0x76, 0x2c, 0x35, 0xbf, 0x37, 0x04, 0xf5,
-	0xfa, 0xc5, 0xc1, 0xb7, 0xd2, 0x7b, 0xdd, 0xd3, 0x8f, 0x4e, 0x4f, 0x17, 0x6f, 0xe5, 0x2e, 0x68,
-	0x0b, 0x78, 0xc3, 0xb4, 0x0c, 0x2a, 0xf6, 0xb2, 0x88, 0xe5, 0xcb, 0xe5, 0xf6, 0xda, 0xb0, 0x32,
-	0xd7, 0xc8, 0xb9, 0xba, 0xdd, 0x39, 0x35, 0x16, 0x2f, 0xa4, 0xc1, 0xfa, 0x75, 0xb2, 0x7b, 0x66,
-	0x98, 0xaa, 0xb2, 0xf7, 0x57, 0x05, 0x36, 0x33, 0x4e, 0x2d, 0xba, 0xfd, 0x05, 0x3c, 0x38, 0x31,
-	0xa8, 0x69, 0x9c, 0xda, 0xed, 0x73, 0x53, 0xb7, 0x3a, 0x5d, 0xd3, 0xce, 0x8e, 0xe7, 0xe7, 0x70,
-	0xff, 0x36, 0x71, 0x1a, 0x5c, 0x13, 0xee, 0xdd, 0x2a, 0x15, 0x91, 0xfe, 0xa1, 0x00, 0xea, 0xf5,
-	0x83, 0xc6, 0x33, 0x6b, 0x1a, 0xd6, 0xb7, 0x5d, 0x7a, 0xb2, 0x78, 0x27, 0x5f, 0x42, 0x63, 0x01,
-	0xaf, 0x77, 0x4d, 0xd3, 0xd0, 0x2d, 0xfb, 0xc8, 0xb2, 0x8c, 0x97, 0x67, 0x96, 0xaa, 0x90, 0xfb,
-	0xb0, 0xf3, 0x01, 0x1d, 0x35, 0x7a, 0xe7, 0xa7, 0x96, 0x9a, 0x23, 0xbb, 0xb0, 0xbd, 0x40, 0xf6,
-	0xbc, 0x63, 0x1e, 0x4f, 0x7c, 0xe1, 0x37, 0x9d, 0x25, 0x92, 0x8e, 0x0a, 0x19, 0xeb, 0x9d, 0x76,
-	0x7a, 0x96, 0x61, 0x4e, 0x5c, 0x2d, 0x91, 0x7b, 0x50, 0xcf, 0x96, 0x49, 0x67, 0xcb, 0x19, 0xce,
-	0x8e, 0x74, 0xdd, 0x38, 0x9b, 0xc6, 0x58, 0xcc, 0x70, 0x26, 0x65, 0xd2, 0x59, 0x29, 0xc3, 0x59,
-	0xcf, 0x30, 0x8f, 0xad, 0xee, 0xc4, 0x59, 0x39, 0xc3, 0x99, 0x94, 0x49, 0x67, 0x40, 0x1e, 0xc0,
-	0xee, 0x02, 0x15, 0x35, 0xf4, 0x57, 0x6d, 0xda, 0x7d, 0x39, 0x71, 0x57, 0xc9, 0xa8, 0xd3, 0x44,
-	0x28, 0x1d, 0x56, 0xdf, 0x2c, 0xe3, 0x7f, 0x98, 0x83, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xf4,
-	0x58, 0xe5, 0x1e, 0xde, 0x11, 0x00, 0x00,
+	// 3714 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x73, 0xdb, 0x48,
+	0x76, 0x1f, 0x90, 0x14, 0x25, 0x3e, 0x7e, 0x08, 0xea, 0xb1, 0x3d, 0xb0, 0x3c, 0xb6, 0x65, 0xda,
+	0x63, 0x6b, 0xb4, 0x2e, 0x8f, 0x46, 0xb2, 0xbd, 0x93, 0x4d, 0x55, 0xa6, 0x68, 0x0a, 0xb6, 0x39,
+	0x92, 0x48, 0x05, 0xa4, 0x3c, 0xeb, 0x4a, 0x25, 0x28, 0x18, 0x68, 0xd1, 0x88, 0x48, 0x80, 0x0b,
+	0x80, 0xb2, 0xb5, 0x87, 0x1c, 0x72, 0x49, 0x4e, 0x49, 0x25, 0x97, 0xe4, 0x96, 0x5b, 0x6e, 0xb9,
+	0xed, 0x31, 0x7f, 0x40, 0x76, 0xf3, 0x07, 0x24, 0x5b, 0xa9, 0x1c, 0x52, 0x95, 0x6b, 0x0e, 0x39,
+	0x26, 0xa7, 0xd4, 0x7b, 0xdd, 0x00, 0xc1, 0x0f, 0x48, 0xda, 0xad, 0x4a, 0xed, 0x9e, 0x84, 0x7e,
+	0xef, 0xf7, 0x1e, 0xbb, 0xfb, 0xf5, 0xfb, 0xe8, 0xd7, 0x82, 0x2f, 0x6c, 0x6b, 0x14, 0x8e, 0x07,
+	0xfc, 0x9b, 0xaf, 0xac, 0x91, 0xfb, 0xd5, 0xd9, 0xf6, 0x57, 0x11, 0x1f, 0xf0, 0x21, 0x8f, 0x82,
+	0x73, 0x93, 0x9f, 0x71, 0x2f, 0x7a, 0x32, 0x0a, 0xfc, 0xc8, 0x67, 0xab, 0x31, 0xec, 0x89, 0x35,
+	0x72, 0x9f, 0x9c, 0x6d, 0xaf, 0xdf, 0x9a, 0x93, 0x3b, 0x1f, 0xf1, 0x50, 0xa0, 0xeb, 0xff, 0x59,
+	0x85, 0x5a, 0x2f, 0xd6, 0xa3, 0xa3, 0x1a, 0x56, 0x83, 0x9c, 0xeb, 0x68, 0xca, 0x86, 0xb2, 0x59,
+	0x32, 0x72, 0xae, 0xc3, 0x6e, 0x03, 0x8c, 0x02, 0xdf, 0xe6, 0x61, 0x68, 0xba, 0x8e, 0x96, 0x23,
+	0x7a, 0x49, 0x52, 0x5a, 0x0e, 0xbb, 0x0b, 0xe5, 0x98, 0x3d, 0x72, 0x1d, 0x2d, 0xbf, 0xa1, 0x6c,
+	0x2e, 0x19, 0xb1, 0xc4, 0x91, 0xeb, 0xb0, 0x7b, 0x50, 0xb1, 0x7d, 0x2f, 0xb2, 0x5c, 0x8f, 0x07,
+	0xa8, 0xa1, 0x40, 0x1a, 0xca, 0x09, 0xad, 0xe5, 0xb0, 0x5b, 0x50, 0x0a, 0xb9, 0x17, 0xfa, 0xc4,
+	0x5f, 0x22, 0xfe, 0x8a, 0x20, 0xb4, 0x1c, 0xf6, 0x14, 0x6e, 0x48, 0x66, 0xc8, 0x7f, 0x32, 0xe6,
+	0x9e, 0xcd, 0x4d, 0x6f, 0x3c, 0x7c, 0xc7, 0x03, 0xad, 0xb8, 0xa1, 0x6c, 0x16, 0x8c, 0x6b, 0x82,
+	0xdb, 0x95, 0xcc, 0x36, 0xf1, 0xd8, 0x0e, 0x5c, 0x97, 0x52, 0x43, 0xdf, 0xf3, 0x23, 0x77, 0xc8,
+	0x4d, 0xcf, 0xf2, 0xfc, 0x50, 0x5b, 0xde, 0x50, 0x36, 0xf3, 0xc6, 0xa7, 0x82, 0x79, 0x28, 0x79,
+	0x6d, 0x64, 0xb1, 0x06, 0xac, 0xc6, 0x4b, 0x19, 0xb8, 0x1e, 0xb7, 0xfa, 0x5c, 0x5b, 0xd9, 0xc8,
+	0x6f, 0x96, 0x77, 0xb4, 0x27, 0x33, 0x9b, 0xfa, 0xe4, 0x48, 0xe0, 0x8c, 0x9a, 0x14, 0x38, 0x10,
+	0x78, 0xf6, 0x05, 0xd4, 0x26, 0x8b, 0xf5, 0xac, 0x21, 0xd7, 0xee, 0xd0, 0x72, 0xaa, 0x09, 0xb5,
+	0x6d, 0x0d, 0x39, 0xbb, 0x09, 0x2b, 0xee, 0xd0, 0xea, 0x73, 0x5c, 0xef, 0x5d, 0x02, 0x2c, 0xd3,
+	0xb8, 0x45, 0xdb, 0x2d, 0x58, 0x24, 0xbd, 0x21, 0xb6, 0x9b, 0x28, 0x24, 0xf9, 0x3b, 0xb0, 0x1c,
+	0x9e, 0x87, 0xb6, 0x35, 0x18, 0x68, 0xb0, 0xa1, 0x6c, 0x96, 0x77, 0x6e, 0xcf, 0xcd, 0xad, 0x2b,
+	0xf8, 0x64, 0xcd, 0xd7, 0x9f, 0x18, 0x31, 0x1e, 0x45, 0xe5, 0x6c, 0xb5, 0x72, 0x86, 0xa8, 0x5c,
+	0x56, 0x22, 0x2a, 0xf1, 0x6c, 0x1b, 0x0a, 0x27, 0xee, 0x80, 0x6b, 0x15, 0x92, 0x5b, 0x9f, 0x93,
+	0x7b, 0xe9, 0x0e, 0x78, 0x2c, 0x44, 0x48, 0xb6, 0x0f, 0xe5, 0x53, 0x1e, 0x78, 0x7c, 0x60, 0xd2,
+	0x5c, 0xab, 0x24, 0xb8, 0x39, 0x27, 0xb8, 0x4f, 0x98, 0x97, 0x63, 0xcf, 0x8e, 0x5c, 0xdf, 0x6b,
+	0xa6, 0xa6, 0x0d, 0x42, 0xbc, 0x29, 0x67, 0xee, 0xf1, 0xe8, 0x83, 0x1f, 0x9c, 0x6a, 0xb5, 0x8c,
+	0x99, 0xb7, 0x05, 0x3f, 0x99, 0xb9, 0xc4, 0xb3, 0xe7, 0x50, 0x0c, 0xdd, 0xbe, 0x67, 0x0d, 0xb4,
+	0x55, 0x92, 0xfc, 0x7c, 0x7e, 0xbb, 0x88, 0x1d, 0x0b, 0x4a, 0x34, 0xfb, 0x16, 0x4a, 0x68, 0x80,
+	0x70, 0x64, 0xd9, 0x5c, 0x53, 0x49, 0xf4, 0xee, 0xfc, 0x8f, 0xc6, 0x88, 0x58, 0x7a, 0x22, 0xc3,
+	0x76, 0x61, 0x69, 0xe8, 0x8f, 0xbd, 0x48, 0x5b, 0x23, 0xe1, 0x5b, 0x73, 0xc2, 0x87, 0xc8, 0x8d,
+	0x05, 0x05, 0x96, 0xb5, 0xa0, 0x2a, 0x77, 0x6d, 0xe8, 0x3b, 0xe3, 0x01, 0xd7, 0x18, 0x09, 0xd7,
+	0x33, 0xf6, 0xed, 0x90, 0x40, 0xb1, 0x8e, 0xca, 0x69, 0x8a, 0xc8, 0x0e, 0x20, 0x3e, 0x9b, 0xa6,
+	0x65, 0xe3, 0x1f, 0xed, 0x53, 0xd2, 0x75, 0x3f, 0xcb, 0xe8, 0x0d, 0x3b, 0x6d, 0xfa, 0xea, 0x28,
+	0x4d, 0x65, 0x3a, 0x94, 0xc6, 0x21, 0x0f, 0x84, 0x31, 0x6f, 0x90, 0xa2, 0x87, 0x73, 0x8a, 0x8e,
+	0x43, 0x1e, 0x2c, 0x32, 0xe5, 0x0a, 0x8a, 0x92, 0x21, 0x5f, 0x00, 0x44, 0x81, 0x65, 0xf3, 0x91,
+	0xef, 0x7a, 0x91, 0xf6, 0x19, 0xe9, 0xd9, 0x98, 0xd3, 0xd3, 0x4b, 0x20, 0xc9, 0x61, 0x98, 0x48,
+	0xa1, 0x65, 0x12, 0x67, 0xd2, 0xae, 0x65, 0x58, 0xa6, 0x19, 0x23, 0x12, 0xcb, 0x24, 0x32, 0xec,
+	0x18, 0xd6, 0x92, 0x81, 0x89, 0x81, 0xcf, 0xb5, 0x43, 0xed, 0x7a, 0xc6, 0x9a, 0x12, 0x45, 0x87,
+	0x02, 0x18, 0xeb, 0x53, 0xed, 0x19, 0x06, 0x6b, 0x42, 0x79, 0xe0, 0x87, 0x91, 0x08, 0xc6, 0xa1,
+	0xb6, 0x93, 0xb1, 0xb8, 0x03, 0x3f, 0x14, 0xcb, 0x4a, 0x54, 0xc1, 0x20, 0x21, 0xa1, 0xd5, 0x9c,
+	0xc0, 0x1f, 0x8d, 0xb8, 0x13, 0xeb, 0xd9, 0xcd, 0xb0, 0xda, 0x9e, 0x80, 0x4d, 0xab, 0xaa, 0x3a,
+	0x69, 0x2a, 0xfa, 0x8d, 0xfd, 0xde, 0x0a, 0xfa, 0xdc, 0xd3, 0x9c, 0x0c, 0xbf, 0x69, 0x0a, 0x7e,
+	0xe2, 0x37, 0x12, 0x8f, 0x7e, 0x13, 0xb9, 0xf6, 0x29, 0x0f, 0x34, 0x9e, 0xe1, 0x37, 0x3d, 0x62,
+	0x27, 0x7e, 0x23, 0xd0, 0x6c, 0x0d, 0xf2, 0xf6, 0x68, 0xac, 0xfd, 0x5c, 0xa1, 0x3c, 0x80, 0xdf,
+	0xec, 0x5b, 0x28, 0xdb, 0x01, 0x77, 0xb8, 0x17, 0xb9, 0xd6, 0x20, 0xd4, 0x7e, 0xa1, 0x64, 0x28,
+	0x6c, 0x4e, 0x40, 0x46, 0x5a, 0x82, 0xd5, 0xa1, 0x12, 0x1f, 0xe5, 0xa8, 0xef, 0x3a, 0xda, 0x3f,
+	0x0b, 0xe5, 0x71, 0xde, 0xe9, 0xf5, 0x5d, 0xe7, 0xc5, 0x32, 0x2c, 0xd1, 0x86, 0x7d, 0x57, 0x5c,
+	0xf9, 0x27, 0x45, 0xfd, 0xb9, 0x92, 0x70, 0xcd, 0xc8, 0x75, 0xea, 0x7b, 0x50, 0x49, 0x2f, 0x94,
+	0x5d, 0x83, 0x25, 0xd7, 0x73, 0xf8, 0x47, 0x4a, 0x73, 0x05, 0x43, 0x0c, 0xd8, 0x1d, 0x00, 0x5c,
+	0xbe, 0x65, 0x47, 0x3c, 0x08, 0x65, 0xa6, 0x4b, 0x51, 0xea, 0x2d, 0x28, 0xa7, 0x16, 0xcd, 0x34,
+	0x58, 0x0e, 0xb9, 0xed, 0x7b, 0x4e, 0x48, 0x6a, 0xf2, 0x46, 0x3c, 0x64, 0x1b, 0x50, 0xa6, 0x64,
+	0x23, 0xb9, 0x39, 0xe2, 0xa6, 0x49, 0xf5, 0xbf, 0xca, 0x43, 0x6d, 0xfa, 0x8c, 0xb2, 0x1f, 0x42,
+	0x01, 0x33, 0x33, 0xe9, 0xaa, 0x2d, 0x30, 0xf8, 0x34, 0xbc, 0x77, 0x3e, 0xe2, 0x06, 0x09, 0x30,
+	0x06, 0x05, 0xca, 0x15, 0x62, 0xc2, 0x05, 0x6f, 0x36, 0xc1, 0xc0, 0x45, 0x09, 0xa6, 0x3c, 0x9b,
+	0x60, 0x6e, 0xc2, 0xca, 0x7b, 0x3c, 0xc6, 0x98, 0xcc, 0xd1, 0xbb, 0xd6, 0x8c, 0x65, 0x1c, 0x63,
+	0x26, 0xbf, 0x05, 0x25, 0xfe, 0xd1, 0x8d, 0x4c, 0xdb, 0x77, 0x44, 0x5e, 0x5b, 0x33, 0x56, 0x90,
+	0xd0, 0xf4, 0x1d, 0x8e, 0x75, 0x00, 0x31, 0xc3, 0xc8, 0x8a, 0xc6, 0x21, 0x65, 0xb5, 0xaa, 0x01,
+	0x48, 0xea, 0x12, 0x65, 0x02, 0x10, 0xe1, 0x78, 0x23, 0x05, 0x20, 0x0a, 0xdb, 0x04, 0x55, 0xaa,
+	0x0f, 0xb8, 0xe9, 0x8c, 0x87, 0x23, 0xee, 0x68, 0xf7, 0x36, 0x94, 0xcd, 0x15, 0xa3, 0x26, 0x7e,
+	0x25, 0xe0, 0x7b, 0x44, 0x65, 0x8f, 0x81, 0x39, 0x3e, 0x1a, 0xc2, 0xb4, 0x7d, 0xef, 0xc4, 0xed,
+	0x9b, 0x7f, 0x1c, 0xfa, 0xe2, 0x88, 0x97, 0x0c, 0x55, 0x70, 0x9a, 0xc4, 0xf8, 0x2e, 0xf4, 0x3d,
+	0xf6, 0x10, 0x56, 0x7d, 0xdb, 0x9d, 0x82, 0x72, 0x91, 0x94, 0x7d, 0xdb, 0x9d, 0xe0, 0xea, 0x7f,
+	0x02, 0xd7, 0x8f, 0x02, 0x1e, 0x86, 0xe3, 0x80, 0x77, 0x23, 0x6b, 0x30, 0x68, 0x9c, 0xf1, 0xc0,
+	0xea, 0xf3, 0x10, 0x4f, 0x8b, 0x75, 0xd6, 0xff, 0x7a, 0x9b, 0x4c, 0xa3, 0x18, 0x62, 0x20, 0xa9,
+	0xcf, 0xb7, 0xb5, 0x5c, 0x42, 0x7d, 0xbe, 0xcd, 0x6e, 0x40, 0xd1, 0x3a, 0xeb, 0xef, 0x6e, 0x6f,
+	0x53, 0x25, 0xa4, 0x18, 0x72, 0x84, 0x55, 0x50, 0xe4, 0x47, 0xd6, 0xc0, 0x1c, 0xba, 0x76, 0xe0,
+	0x87, 0x54, 0x05, 0x15, 0x8c, 0x32, 0xd1, 0x0e, 0x89, 0x54, 0xff, 0x33, 0x05, 0xaa, 0x53, 0x13,
+	0x60, 0x3f, 0x82, 0x42, 0xe8, 0x0f, 0xc5, 0x91, 0x58, 0x14, 0x9c, 0x16, 0x4e, 0xd7, 0x20, 0x19,
+	0x94, 0x3d, 0x19, 0x0f, 0x06, 0x5a, 0xee, 0x57, 0x93, 0x45, 0x99, 0xfa, 0xdf, 0x2f, 0xc3, 0xf5,
+	0x85, 0x81, 0x2f, 0x39, 0x6b, 0x4a, 0xc6, 0x59, 0xcb, 0x5d, 0x74, 0xd6, 0xf2, 0xb3, 0x67, 0x0d,
+	0xab, 0xa5, 0x7e, 0xe0, 0x8f, 0x47, 0xe6, 0x19, 0x0f, 0x42, 0xd7, 0xf7, 0x68, 0x5b, 0xaa, 0x46,
+	0x55, 0x50, 0xdf, 0x08, 0x22, 0x1a, 0xd0, 0x1e, 0x8d, 0xcd, 0x71, 0x68, 0xf5, 0xe3, 0x2a, 0x0e,
+	0x68, 0xfb, 0xaa, 0xf6, 0x68, 0x7c, 0x1c, 0x5a, 0x7d, 0x59, 0xbf, 0x3d, 0x80, 0x9a, 0xc0, 0xf1,
+	0x40, 0xc2, 0xca, 0x04, 0xab, 0x10, 0x8c, 0x07, 0x02, 0xb5, 0x09, 0x2a, 0xa2, 0xc2, 0xf3, 0x30,
+	0xe2, 0x43, 0x89, 0xab, 0x10, 0x0e, 0xa5, 0xbb, 0x44, 0x16, 0xc8, 0xbb, 0x50, 0x46, 0xe4, 0x88,
+	0x07, 0xae, 0xef, 0x84, 0x54, 0xc3, 0x14, 0x0c, 0xb0, 0x47, 0xe3, 0x23, 0x41, 0xc1, 0x22, 0x13,
+	0x01, 0xd1, 0xfb, 0xc0, 0x8f, 0xa2, 0x01, 0x77, 0x12, 0x68, 0x8d, 0xa0, 0x9f, 0xda, 0xa3, 0x71,
+	0x2f, 0xe6, 0xc5, 0x32, 0x4f, 0xe0, 0xd3, 0x69, 0x19, 0x31, 0x83, 0x55, 0x92, 0x58, 0x4b, 0x4b,
+	0x88, 0x49, 0x3c, 0x06, 0x36, 0xe4, 0x43, 0x3f, 0x38, 0x97, 0xeb, 0x7f, 0x77, 0x1e, 0xf1, 0x90,
+	0x3c, 0xb3, 0x60, 0xa8, 0x82, 0x43, 0x5b, 0xf0, 0x02, 0xe9, 0x29, 0xf4, 0xc0, 0x1d, 0xba, 0x91,
+	0x44, 0x5f, 0x4f, 0xa3, 0x0f, 0x90, 0x21, 0xd0, 0x9b, 0x20, 0x69, 0xa6, 0xef, 0x0f, 0xcd, 0x53,
+	0x77, 0x30, 0x08, 0x29, 0xb9, 0x17, 0x8c, 0x9a, 0xa0, 0x77, 0xfc, 0xe1, 0x3e, 0x52, 0xf1, 0xf8,
+	0x8e, 0x5c, 0x27, 0x34, 0xed, 0x71, 0x10, 0x70, 0x2f, 0x22, 0xef, 0x2f, 0x18, 0x65, 0xa4, 0x35,
+	0x05, 0x09, 0x8f, 0x01, 0x41, 0x86, 0xd6, 0x47, 0xf2, 0xfe, 0x82, 0xb1, 0x8c, 0xe3, 0x43, 0xeb,
+	0x23, 0xab, 0x43, 0xd5, 0xf5, 0xcd, 0x80, 0x5b, 0x8e, 0x9c, 0xd0, 0xa6, 0x10, 0x77, 0x7d, 0x83,
+	0x5b, 0x8e, 0x98, 0xcb, 0x03, 0xa8, 0xb9, 0xbe, 0xf9, 0x21, 0x70, 0xa3, 0x78, 0x8d, 0x5f, 0x0a,
+	0xe3, 0xb9, 0xfe, 0xf7, 0x48, 0x14, 0xa8, 0x3b, 0x50, 0x8e, 0x35, 0xf9, 0xa3, 0x50, 0xdb, 0x22,
+	0x48, 0x49, 0xe8, 0xe9, 0x8c, 0x30, 0xf2, 0x56, 0x12, 0x2d, 0x08, 0xf8, 0x81, 0xb0, 0x99, 0xd4,
+	0x81, 0x88, 0x06, 0x54, 0xc8, 0xa8, 0xf2, 0xf8, 0xcb, 0x3c, 0x7d, 0xe7, 0x62, 0xff, 0x30, 0xf0,
+	0x20, 0xc4, 0x14, 0xf6, 0x0a, 0x56, 0xe5, 0xb6, 0x25, 0x5a, 0x76, 0xaf, 0xa4, 0x45, 0xee, 0x6a,
+	0xa2, 0xe8, 0x5b, 0x5a, 0x4d, 0xa2, 0xe4, 0xe9, 0x95, 0x94, 0x80, 0xeb, 0xc7, 0x84, 0xfa, 0x7f,
+	0x17, 0xa1, 0x92, 0xae, 0xd9, 0xd9, 0xb3, 0xa9, 0x24, 0x72, 0xef, 0xc2, 0x02, 0x3f, 0x95, 0x42,
+	0x1e, 0x40, 0xed, 0xc4, 0x0f, 0x4e, 0x4d, 0xfb, 0xbd, 0x3b, 0x70, 0x28, 0xf4, 0x03, 0x85, 0xf7,
+	0x0a, 0x52, 0x9b, 0x48, 0xc4, 0xf8, 0x5f, 0x87, 0x6a, 0x0a, 0xe5, 0x3a, 0x32, 0x79, 0x94, 0x13,
+	0x50, 0xcb, 0x61, 0xf7, 0xa1, 0xca, 0x3f, 0x72, 0xdb, 0xc4, 0x4b, 0x00, 0x39, 0xfd, 0x35, 0xc2,
+	0x54, 0x90, 0xf8, 0x52, 0xd2, 0xd8, 0x16, 0xac, 0x11, 0xc8, 0xf6, 0x87, 0x43, 0xcb, 0x73, 0xe8,
+	0xb6, 0xa5, 0x5d, 0xdf, 0xc8, 0x6f, 0x96, 0x8c, 0x55, 0x64, 0x34, 0x05, 0x1d, 0x2f, 0x55, 0xec,
+	0x0f, 0x31, 0x2b, 0x70, 0xdb, 0xe4, 0xde, 0x99, 0x1b, 0xf8, 0xde, 0x10, 0x4f, 0xdf, 0x0d, 0xba,
+	0x95, 0xed, 0x5c, 0xb8, 0xba, 0x27, 0xfa, 0x47, 0x6e, 0xeb, 0x13, 0x21, 0xdd, 0x8b, 0x82, 0x73,
+	0xa1, 0x3e, 0x45, 0x15, 0x59, 0x89, 0xdb, 0x66, 0xf8, 0xde, 0xda, 0x79, 0xf6, 0x9c, 0x4a, 0xd2,
+	0x12, 0x66, 0x25, 0x6e, 0x77, 0x89, 0x22, 0x92, 0x1e, 0x02, 0xdc, 0x9f, 0x72, 0x4d, 0xa3, 0xe3,
+	0xb4, 0x42, 0x6c, 0xf7, 0xa7, 0x1c, 0xe3, 0x1b, 0x31, 0x5d, 0x0f, 0x53, 0xe2, 0x4d, 0x71, 0x1a,
+	0x91, 0xd2, 0x42, 0x82, 0xc8, 0x68, 0xdc, 0x36, 0x87, 0xa9, 0xfb, 0xe7, 0x3a, 0x15, 0x03, 0x35,
+	0xa4, 0x1f, 0x4e, 0xae, 0x9e, 0xbf, 0x35, 0xa9, 0xb5, 0x0d, 0x9f, 0x12, 0x32, 0xe0, 0xa1, 0x3f,
+	0x0e, 0x6c, 0x2e, 0xa2, 0x8e, 0x56, 0xcf, 0x38, 0x9a, 0x86, 0x84, 0x51, 0x08, 0x32, 0xd6, 0x50,
+	0x74, 0x8a, 0xc4, 0x74, 0x58, 0xf5, 0x07, 0x8e, 0x99, 0x2e, 0x00, 0x37, 0xaf, 0x50, 0xff, 0xd5,
+	0xfc, 0x81, 0x93, 0x1a, 0xa3, 0x1a, 0x8f, 0x7f, 0x98, 0x52, 0xf3, 0xe5, 0x55, 0xd4, 0x78, 0xfc,
+	0x43, 0x6a, 0xbc, 0xfe, 0x02, 0xae, 0x2d, 0x3a, 0x16, 0x4c, 0x85, 0xfc, 0x29, 0x3f, 0x97, 0x59,
+	0x0d, 0x3f, 0x31, 0xbb, 0x9f, 0x59, 0x83, 0x71, 0x5c, 0x55, 0x89, 0xc1, 0x8f, 0x72, 0xdf, 0x28,
+	0xf5, 0x5f, 0xe6, 0xa1, 0x92, 0xbe, 0x62, 0x5f, 0xea, 0x73, 0x69, 0x70, 0xca, 0xe7, 0x44, 0x9f,
+	0x45, 0xd4, 0x86, 0xd8, 0x67, 0x89, 0x53, 0x6b, 0x3e, 0x95, 0x5a, 0x19, 0x14, 0xac, 0xa0, 0xbf,
+	0x2d, 0xd3, 0x1d, 0x7d, 0x4b, 0xda, 0xd7, 0x32, 0xb7, 0xd1, 0xb7, 0xa4, 0xed, 0xc8, 0x3c, 0x46,
+	0xdf, 0x92, 0xb6, 0x2b, 0xd3, 0x16, 0x7d, 0x4b, 0xda, 0x53, 0x99, 0x9f, 0xe8, 0x5b, 0xd2, 0x9e,
+	0xc9, 0x0c, 0x44, 0xdf, 0xec, 0x3b, 0x28, 0x59, 0x41, 0x7f, 0x3c, 0xa4, 0x1b, 0x88, 0x4a, 0xde,
+	0xf6, 0xf8, 0xc2, 0x75, 0x3d, 0x69, 0xc4, 0x70, 0xe1, 0x67, 0x13, 0x71, 0xdc, 0xdb, 0x80, 0x47,
+	0x14, 0x07, 0xf2, 0x06, 0x7e, 0x62, 0xda, 0x77, 0xc6, 0x81, 0x85, 0xd7, 0x44, 0xe9, 0x14, 0x22,
+	0x41, 0x55, 0x63, 0x2a, 0xf9, 0xc4, 0xfa, 0x4f, 0xa0, 0x36, 0xad, 0x75, 0x81, 0x99, 0x5a, 0x69,
+	0x33, 0x95, 0x77, 0x76, 0xaf, 0xda, 0x60, 0x78, 0xf2, 0xd2, 0xe5, 0x03, 0xe7, 0x0d, 0x8a, 0xa6,
+	0x6d, 0xfb, 0x0f, 0x39, 0x28, 0x25, 0xbd, 0x0c, 0xb6, 0x33, 0x65, 0xd8, 0x3b, 0xd9, 0x5d, 0x8f,
+	0x94, 0x55, 0xd7, 0x61, 0x25, 0x09, 0x7d, 0xa2, 0xf0, 0x4e, 0xc6, 0x18, 0x2d, 0xfc, 0x11, 0xf7,
+	0xcc, 0x93, 0x81, 0xd5, 0x17, 0xb5, 0xc9, 0x9a, 0x51, 0x42, 0xca, 0x4b, 0x24, 0x60, 0x0c, 0x20,
+	0xf6, 0x10, 0x63, 0x40, 0x45, 0xc4, 0x00, 0x24, 0x1c, 0x62, 0x0c, 0xb8, 0x07, 0x15, 0xf4, 0xa3,
+	0x44, 0x77, 0x55, 0x84, 0x5e, 0x7f, 0xe0, 0x24, 0x51, 0xf5, 0x1e, 0x54, 0xd0, 0x47, 0x12, 0x48,
+	0x4d, 0x40, 0x3c, 0xfe, 0x21, 0x81, 0x30, 0x28, 0x90, 0xf6, 0x55, 0xd2, 0x4e, 0xdf, 0xb8, 0xa9,
+	0x63, 0xd7, 0xa1, 0x1e, 0x47, 0xd5, 0xc0, 0x4f, 0xa4, 0xe0, 0x35, 0x6b, 0x4d, 0x50, 0xfa, 0xae,
+	0x83, 0x55, 0xed, 0x80, 0x7b, 0xfd, 0xe8, 0x3d, 0x35, 0x24, 0x98, 0x21, 0x47, 0xf5, 0x67, 0xb0,
+	0x2c, 0x63, 0x2e, 0x0a, 0x8d, 0x64, 0xdf, 0x70, 0xcd, 0xc0, 0x4f, 0xbc, 0x1f, 0xc9, 0x00, 0x1f,
+	0x97, 0x85, 0x72, 0x58, 0xff, 0xc7, 0x22, 0x7c, 0x96, 0x61, 0x18, 0x76, 0x9c, 0x3e, 0x7a, 0x0a,
+	0x1d, 0xbd, 0x1f, 0x5e, 0xd9, 0xaa, 0x99, 0xa7, 0x70, 0xfd, 0xdf, 0x72, 0x00, 0x13, 0x9b, 0xb3,
+	0xdf, 0x07, 0x38, 0xc1, 0x91, 0x99, 0x32, 0xf0, 0xce, 0xaf, 0x76, 0x78, 0xc8, 0xe8, 0xa5, 0x93,
+	0xf8, 0x93, 0xdd, 0x83, 0x32, 0xd5, 0x2d, 0xe6, 0xe4, 0x40, 0x56, 0xf0, 0x76, 0x4f, 0x44, 0xf1,
+	0xab, 0xf7, 0xa1, 0x12, 0x46, 0x81, 0xeb, 0xf5, 0x25, 0x86, 0x5c, 0xfd, 0xf5, 0x27, 0x46, 0x59,
+	0x50, 0x27, 0x20, 0xb7, 0xef, 0x71, 0x47, 0x82, 0xb0, 0x24, 0x66, 0x04, 0x22, 0xaa, 0x00, 0x3d,
+	0x82, 0xda, 0xd8, 0x9b, 0x82, 0x61, 0xdb, 0xb4, 0x80, 0x2d, 0x80, 0xb1, 0x97, 0x06, 0xfe, 0x01,
+	0x94, 0xad, 0x20, 0xb0, 0xce, 0x25, 0xaa, 0x48, 0x6e, 0xf2, 0xcd, 0xaf, 0xe1, 0x26, 0x0d, 0xd4,
+	0x82, 0xeb, 0x21, 0x75, 0x44, 0xc2, 0x4b, 0x37, 0xa9, 0x5d, 0xff, 0x23, 0x58, 0x9d, 0x41, 0xb2,
+	0x7d, 0x28, 0x12, 0x2f, 0x36, 0xe2, 0xaf, 0xe5, 0x9a, 0x52, 0xc5, 0x6f, 0x22, 0x14, 0xfc, 0xad,
+	0x82, 0xa1, 0x20, 0x36, 0x6e, 0x19, 0x96, 0x8f, 0xdb, 0xfb, 0xed, 0xce, 0xf7, 0x6d, 0xf5, 0x13,
+	0x56, 0x82, 0xa5, 0x17, 0x6f, 0x7b, 0x7a, 0x57, 0x55, 0x18, 0x40, 0xb1, 0xdb, 0x33, 0x5a, 0xed,
+	0x57, 0x6a, 0x0e, 0xc9, 0xdd, 0x56, 0xbb, 0xf7, 0x8d, 0x9a, 0x27, 0x72, 0xab, 0xdd, 0xfb, 0xfa,
+	0xb9, 0x5a, 0x88, 0xbf, 0x77, 0x77, 0xd4, 0xa5, 0xf8, 0xfb, 0xf9, 0x53, 0xb5, 0x88, 0xf0, 0x63,
+	0x82, 0x2f, 0x23, 0xf9, 0x58, 0xc0, 0x57, 0xe2, 0xef, 0xdd, 0x1d, 0xb5, 0x14, 0x7f, 0x3f, 0x7f,
+	0xaa, 0x02, 0xc2, 0x1b, 0x86, 0xd1, 0x78, 0xab, 0x96, 0xeb, 0x7f, 0x9d, 0x87, 0xeb, 0x0b, 0x7b,
+	0x6d, 0xec, 0xf7, 0xa6, 0x22, 0xd6, 0xd6, 0xd5, 0x3a, 0x74, 0xa9, 0xe8, 0x75, 0x47, 0xd4, 0x33,
+	0xe3, 0xc8, 0x7a, 0x37, 0x88, 0x53, 0x5f, 0x8a, 0x82, 0x71, 0x20, 0x3c, 0x1f, 0xbe, 0xf3, 0x07,
+	0x32, 0x4b, 0xc9, 0x11, 0xd2, 0xfd, 0x93, 0x93, 0x90, 0x47, 0xf2, 0x5e, 0x2b, 0x47, 0x73, 0xbd,
+	0xff, 0xa5, 0xf9, 0xde, 0x7f, 0x37, 0xed, 0xef, 0x40, 0x47, 0xe5, 0xd9, 0xd5, 0xe6, 0x7d, 0x81,
+	0xb7, 0xff, 0x06, 0xce, 0xcb, 0xff, 0x28, 0xb0, 0x3a, 0xd3, 0xb8, 0x64, 0x9f, 0x43, 0x29, 0x1c,
+	0xbf, 0x13, 0x37, 0x4d, 0xf9, 0xd3, 0x13, 0xc2, 0xc2, 0xbe, 0xcd, 0x1e, 0x14, 0x29, 0xa2, 0xc4,
+	0x5b, 0xf1, 0xf8, 0xb2, 0xe6, 0xa8, 0x98, 0x8d, 0xdc, 0x01, 0x29, 0xbb, 0xee, 0x41, 0x39, 0x45,
+	0xfe, 0xff, 0x5f, 0xfb, 0x2f, 0x14, 0xa8, 0xa4, 0x1b, 0xf0, 0x97, 0x96, 0x44, 0x69, 0x70, 0xea,
+	0xf8, 0xe1, 0xf1, 0xf2, 0xed, 0xd3, 0x13, 0x47, 0x16, 0x3c, 0x72, 0x84, 0x7d, 0x4c, 0xcb, 0x71,
+	0x82, 0xc9, 0xcb, 0xc5, 0xdd, 0x2c, 0x8d, 0x0d, 0x01, 0x33, 0x62, 0x3c, 0xaa, 0x0c, 0x78, 0x38,
+	0x1e, 0x44, 0x94, 0x51, 0x99, 0x21, 0x47, 0x98, 0x9c, 0xde, 0x59, 0xf6, 0xe9, 0xc0, 0xef, 0xcb,
+	0x02, 0x29, 0x1e, 0x62, 0x1b, 0x66, 0x75, 0xa6, 0x49, 0x8b, 0xd5, 0xa0, 0x4d, 0xcd, 0x7c, 0xd9,
+	0x2f, 0xa4, 0x01, 0xdb, 0x86, 0x6b, 0x61, 0x64, 0x05, 0xd1, 0xec, 0x13, 0x93, 0xa8, 0xe9, 0x18,
+	0xf1, 0xa6, 0x5f, 0x98, 0x1e, 0x03, 0xe3, 0x9e, 0x33, 0x8b, 0xcf, 0x13, 0x5e, 0xe5, 0x9e, 0x33,
+	0x85, 0xae, 0x6f, 0x01, 0x9b, 0xef, 0xf2, 0x2e, 0x9e, 0x4b, 0xfd, 0x67, 0x39, 0x28, 0xa7, 0x5e,
+	0x32, 0xd8, 0xd3, 0x29, 0x0b, 0x6c, 0x5c, 0xf4, 0xea, 0x31, 0x63, 0x00, 0x62, 0xd0, 0x1a, 0xaa,
+	0xc9, 0x6b, 0x08, 0x83, 0x02, 0xdd, 0x4c, 0xf2, 0xa2, 0x6e, 0xc0, 0x6f, 0xac, 0x66, 0x42, 0xee,
+	0x39, 0x3c, 0x48, 0xdd, 0x17, 0x4b, 0x82, 0x72, 0x24, 0x9e, 0x0d, 0x23, 0xec, 0xb8, 0x8a, 0x4e,
+	0xa2, 0x2c, 0x76, 0x04, 0xe5, 0x48, 0x54, 0x14, 0x29, 0xbb, 0xac, 0x25, 0x76, 0xb9, 0x06, 0x4b,
+	0xd4, 0xfb, 0x21, 0xab, 0xac, 0x18, 0x62, 0x80, 0x4d, 0x13, 0xa9, 0x6c, 0x2a, 0x9c, 0x88, 0x0a,
+	0x67, 0x4d, 0xb0, 0x9a, 0xa9, 0xa0, 0xf2, 0x08, 0x56, 0xb1, 0xa5, 0x16, 0x4e, 0xe0, 0x54, 0xf2,
+	0xac, 0x18, 0x35, 0x22, 0x27, 0xd0, 0xfa, 0x5f, 0x2a, 0x50, 0x9b, 0x7e, 0xc5, 0xb9, 0xb4, 0x0f,
+	0x3b, 0x0d, 0x4f, 0x6d, 0xde, 0x35, 0x58, 0x12, 0x95, 0x5d, 0x4e, 0x18, 0x86, 0x06, 0x18, 0x52,
+	0x93, 0x47, 0x21, 0x34, 0x35, 0x5e, 0x72, 0x53, 0x14, 0xbc, 0x06, 0x9c, 0x88, 0x47, 0xd1, 0x35,
+	0x23, 0x77, 0xe2, 0xd4, 0xff, 0x45, 0x01, 0x98, 0x3c, 0x0d, 0xb1, 0xdd, 0xa9, 0xd9, 0xdc, 0xbd,
+	0xe0, 0x15, 0x69, 0xd6, 0x8f, 0xf0, 0x0e, 0x26, 0x63, 0x8b, 0x1c, 0x21, 0x5d, 0xec, 0x55, 0x1c,
+	0xbe, 0xc5, 0x08, 0xe9, 0x27, 0x21, 0xfd, 0x8c, 0x78, 0x9c, 0x95, 0xa3, 0xc9, 0x8a, 0x96, 0xd2,
+	0x2b, 0xba, 0x0d, 0x80, 0x1f, 0xd4, 0xd3, 0x0b, 0xb5, 0x22, 0xad, 0xa8, 0x84, 0x14, 0xda, 0x19,
+	0xf6, 0x19, 0x2c, 0x8f, 0xc6, 0x91, 0xe9, 0x0f, 0x1c, 0x7a, 0x6b, 0x2d, 0x19, 0xc5, 0xd1, 0x38,
+	0xea, 0x0c, 0x9c, 0xfa, 0xbf, 0x2a, 0xb0, 0x36, 0xf7, 0x6e, 0x85, 0x7d, 0xca, 0xd4, 0x02, 0x1f,
+	0x5e, 0xfe, 0xd2, 0x75, 0x49, 0xe7, 0x3b, 0x99, 0x73, 0x3e, 0x7b, 0xce, 0x85, 0xd9, 0x39, 0xdf,
+	0x80, 0xe2, 0xc8, 0x0a, 0xac, 0x61, 0x28, 0x33, 0x94, 0x1c, 0x49, 0xe3, 0x14, 0x63, 0xe3, 0x88,
+	0x0d, 0x74, 0x31, 0x53, 0x2d, 0x0b, 0xff, 0x10, 0xa3, 0xfa, 0xff, 0xe6, 0x80, 0xcd, 0x3f, 0xa3,
+	0xb1, 0xdf, 0x9d, 0x5a, 0xdb, 0xa3, 0x2b, 0xbc, 0xbc, 0xa5, 0x16, 0x77, 0x5b, 0xbe, 0x95, 0x09,
+	0xff, 0xca, 0x49, 0x07, 0x22, 0x4a, 0xec, 0x5f, 0x38, 0xe0, 0xc9, 0xb3, 0x7b, 0xcc, 0xe6, 0x47,
+	0xa2, 0xf8, 0x0e, 0xf0, 0x45, 0x3c, 0x14, 0x29, 0x99, 0x19, 0xf1, 0x10, 0x73, 0xb2, 0xfc, 0x14,
+	0x5d, 0x59, 0x99, 0x93, 0x25, 0x8d, 0xfa, 0xb2, 0xe8, 0x6e, 0xe2, 0xa7, 0xa7, 0xdc, 0xad, 0x28,
+	0xdd, 0x8d, 0x58, 0x69, 0x77, 0x8b, 0xf1, 0x7c, 0x1a, 0xbf, 0x9c, 0xc2, 0xf3, 0x34, 0xfe, 0x3e,
+	0x54, 0x85, 0x7b, 0xc6, 0xef, 0xd1, 0x2b, 0xe4, 0x9c, 0x15, 0x22, 0xc6, 0x17, 0x8a, 0x05, 0x3e,
+	0x5c, 0x5a, 0xe4, 0xc3, 0x5b, 0xff, 0xa1, 0x00, 0x9b, 0x7f, 0x1c, 0x61, 0x1b, 0xf0, 0x79, 0xb3,
+	0xd3, 0xee, 0x35, 0x5a, 0x6d, 0xdd, 0x30, 0xf5, 0x37, 0x7a, 0xbb, 0x67, 0xf6, 0xde, 0x1e, 0xe9,
+	0xe6, 0xa4, 0x8e, 0xcb, 0x42, 0x34, 0x0d, 0xbd, 0xd1, 0xd3, 0xf7, 0x54, 0x25, 0x13, 0x61, 0x1c,
+	0xb7, 0xdb, 0xa2, 0xe8, 0xbb, 0x0b, 0xb7, 0x16, 0x22, 0xf4, 0x1f, 0xb7, 0x50, 0x45, 0x9e, 0xd5,
+	0xe1, 0xce, 0x42, 0xc0, 0x9e, 0xde, 0xed, 0x19, 0x9d, 0xb7, 0xfa, 0x9e, 0x5a, 0xc8, 0x9e, 0xea,
+	0xd1, 0x1e, 0x4d, 0x64, 0x69, 0xeb, 0x97, 0x0a, 0xa8, 0xb3, 0xbd, 0x3b, 0x76, 0x07, 0xd6, 0x8f,
+	0x8c, 0x4e, 0x53, 0xef, 0x76, 0x17, 0xaf, 0xef, 0x16, 0x7c, 0xb6, 0x80, 0xff, 0xb2, 0x63, 0xec,
+	0xab, 0x4a, 0x06, 0x53, 0xff, 0xb1, 0xde, 0x54, 0x73, 0x99, 0xcc, 0x56, 0x4f, 0xcd, 0xb3, 0x2d,
+	0x78, 0xb8, 0x80, 0xd9, 0x34, 0xf4, 0x3d, 0xbd, 0xdd, 0x6b, 0x35, 0x0e, 0xba, 0x66, 0xf3, 0x75,
+	0xa3, 0xfd, 0x8a, 0x56, 0x56, 0x87, 0x3b, 0x19, 0xbf, 0x62, 0xbe, 0x6e, 0x74, 0x5f, 0xd3, 0xda,
+	0xfe, 0x42, 0x01, 0x75, 0xb6, 0x47, 0x82, 0x6b, 0xeb, 0xbe, 0xed, 0x36, 0x1b, 0x07, 0x07, 0x8b,
+	0xd7, 0xf6, 0x39, 0x68, 0x0b, 0xf8, 0x7a, 0xbb, 0xa7, 0x1b, 0x62, 0x71, 0x8b, 0xb8, 0x38, 0x7f,
+	0x32, 0xd9, 0x02, 0x66, 0xb3, 0x73, 0x78, 0x74, 0xa0, 0xf7, 0x74, 0x35, 0xbf, 0xf5, 0x5f, 0x0a,
+	0x54, 0xa7, 0xee, 0xf6, 0xa8, 0xef, 0x65, 0xeb, 0x40, 0x5f, 0x3c, 0x15, 0x0d, 0xae, 0xcd, 0x32,
+	0x3b, 0x47, 0x7a, 0x5b, 0x55, 0xd8, 0x3a, 0xdc, 0x98, 0x17, 0x3b, 0x68, 0xb5, 0xf7, 0xd5, 0xdc,
+	0x22, 0x9e, 0xa1, 0xb7, 0x1b, 0x87, 0xba, 0x9a, 0x67, 0x37, 0xe1, 0xfa, 0x2c, 0xaf, 0xf9, 0xfa,
+	0xb0, 0x83, 0x1b, 0xba, 0x90, 0x85, 0xf3, 0x58, 0xc2, 0x2d, 0x99, 0x65, 0xf5, 0x8c, 0xe3, 0x76,
+	0xb3, 0xd1, 0xd3, 0xd5, 0xe2, 0x22, 0xc1, 0xc3, 0xfd, 0xbd, 0x96, 0xa1, 0x2e, 0x6f, 0xfd, 0x9d,
+	0x02, 0xb7, 0x32, 0x0a, 0x3e, 0x5a, 0xfd, 0x0f, 0xe0, 0xd1, 0xbe, 0x6e, 0xb4, 0xf5, 0x03, 0xf3,
+	0xe5, 0x71, 0xbb, 0xd9, 0x6b, 0x75, 0xda, 0x66, 0xb6, 0x61, 0xbe, 0x84, 0x2f, 0x2e, 0x03, 0xc7,
+	0x56, 0xda, 0x84, 0x07, 0x97, 0x42, 0xc9, 0x64, 0x5b, 0x7f, 0xa3, 0xc0, 0xcd, 0xcc, 0xbb, 0x0b,
+	0xfe, 0xe4, 0x71, 0x57, 0x37, 0xae, 0x32, 0xbb, 0x47, 0x70, 0xff, 0x62, 0x68, 0x3c, 0xb7, 0x87,
+	0x50, 0xbf, 0x04, 0x28, 0x66, 0xf6, 0xa7, 0x05, 0x50, 0x67, 0xab, 0x59, 0x3c, 0xbc, 0x6d, 0xbd,
+	0xf7, 0x7d, 0xc7, 0xd8, 0x5f, 0x3c, 0x8b, 0x87, 0x50, 0x5f, 0xc0, 0x6f, 0x76, 0xda, 0x6d, 0xbd,
+	0xd9, 0x33, 0x1b, 0xbd, 0x9e, 0x7e, 0x78, 0xd4, 0x53, 0x15, 0xf6, 0x05, 0xdc, 0xbb, 0x00, 0x67,
+	0xe8, 0xdd, 0xe3, 0x03, 0x3c, 0xd0, 0xf7, 0xe1, 0xee, 0x02, 0xd8, 0x8b, 0x56, 0x7b, 0x2f, 0xd1,
+	0x45, 0x71, 0x28, 0x0b, 0x24, 0x15, 0x15, 0x32, 0x7e, 0xef, 0xa0, 0xd5, 0xed, 0xe9, 0xed, 0x44,
+	0xd5, 0x12, 0x7b, 0x00, 0x1b, 0xd9, 0x30, 0xa9, 0xac, 0x98, 0xa1, 0xac, 0xd1, 0x6c, 0xea, 0x47,
+	0x93, 0x35, 0x2e, 0x67, 0x28, 0x93, 0x30, 0xa9, 0x6c, 0x25, 0x43, 0x59, 0x57, 0x6f, 0xef, 0xf5,
+	0x3a, 0x89, 0xb2, 0x52, 0x86, 0x32, 0x09, 0x93, 0xca, 0x00, 0x0f, 0xc1, 0x02, 0x94, 0xa1, 0x37,
+	0xdf, 0xbc, 0x34, 0x3a, 0x87, 0x89, 0xba, 0x72, 0x86, 0x9d, 0x12, 0xa0, 0x54, 0x58, 0xd9, 0xf2,
+	0x61, 0x75, 0xa6, 0x9e, 0x66, 0xb7, 0xe1, 0x66, 0xb7, 0xf5, 0xaa, 0xdd, 0xc8, 0x38, 0x87, 0x18,
+	0xde, 0xe6, 0xd8, 0xaf, 0xf4, 0xb6, 0x6e, 0xa0, 0xb7, 0x2a, 0x8b, 0xc5, 0xf7, 0xf4, 0x83, 0xd6,
+	0x1b, 0xdd, 0x50, 0x73, 0x5b, 0x1f, 0x81, 0xcd, 0x97, 0xa1, 0x98, 0x46, 0x30, 0x80, 0x74, 0x8f,
+	0x1a, 0x4d, 0x3d, 0xf3, 0x67, 0x17, 0x22, 0xba, 0x7a, 0xaf, 0xdd, 0x15, 0xf9, 0x2e, 0x43, 0x43,
+	0xf7, 0x75, 0xc3, 0xd0, 0xd5, 0xdc, 0xd6, 0x9f, 0x2b, 0x50, 0x9b, 0xae, 0x39, 0x31, 0xee, 0x1c,
+	0x76, 0x8e, 0xdb, 0xbd, 0xc5, 0x3f, 0xb9, 0x0e, 0x37, 0xe6, 0xb8, 0x44, 0x10, 0x61, 0x7a, 0x5e,
+	0x52, 0x30, 0x29, 0x4c, 0xcf, 0x31, 0x8f, 0x5a, 0x6f, 0x3a, 0x3d, 0xd3, 0xe8, 0x74, 0x7a, 0x6a,
+	0x7e, 0xeb, 0xdf, 0x15, 0xb8, 0xbe, 0xb0, 0x3a, 0xc4, 0x63, 0x20, 0x03, 0xcb, 0x61, 0x67, 0xef,
+	0xf8, 0x40, 0xbf, 0x2c, 0x52, 0xcd, 0xa3, 0x0e, 0x3a, 0x8d, 0xbd, 0x94, 0x23, 0xde, 0x83, 0xdb,
+	0x17, 0x42, 0xd5, 0x5c, 0x2a, 0x48, 0x2e, 0xfa, 0xcd, 0x29, 0x7d, 0x79, 0xf4, 0xd8, 0x4b, 0xc0,
+	0x6a, 0x61, 0xeb, 0x67, 0x0a, 0xdc, 0x58, 0x5c, 0x21, 0xa2, 0x3b, 0xc4, 0x69, 0xb5, 0xd1, 0x9c,
+	0xcd, 0xae, 0x93, 0x15, 0x3e, 0x80, 0x8d, 0x6c, 0xd8, 0x51, 0xcf, 0x68, 0x34, 0x75, 0x11, 0x65,
+	0xb2, 0x51, 0x6f, 0xf0, 0x98, 0xd3, 0x02, 0x1f, 0x42, 0xfd, 0x42, 0xd8, 0xf7, 0x46, 0x0b, 0xb3,
+	0xe7, 0xbb, 0x22, 0xfd, 0x67, 0xe9, 0xee, 0xff, 0x0d, 0x00, 0x57, 0x0d, 0xbd, 0xee, 0xb0, 0x2a,
+	0x00, 0x00,
 }
@@ -37,16 +37,61 @@ func (m *GetEventsRequest) GetSubscription() *Subscription {
 	return nil
 }
 
+// A request message sent on a GetEventsWithAck stream
+type GetEventsWithAckRequest struct {
+	// The Subscription message defines which events should be
+	// returned in the stream. Only used in the first request on a
+	// stream, and only when subscription_id is not set.
+	Subscription *Subscription `protobuf:"bytes,1,opt,name=subscription" json:"subscription,omitempty"`
+	// The ID of an existing subscription to resume. Only used in the
+	// first request on a stream. All events in the subscription that
+	// have not been acknowledged are re-transmitted.
+	SubscriptionId string `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId" json:"subscription_id,omitempty"`
+	// Zero or more acks from received events, acknowledging that the
+	// events have been processed.
+	Acks [][]byte `protobuf:"bytes,3,rep,name=acks,proto3" json:"acks,omitempty"`
+}
+
+func (m *GetEventsWithAckRequest) Reset()                    { *m = GetEventsWithAckRequest{} }
+func (m *GetEventsWithAckRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetEventsWithAckRequest) ProtoMessage()               {}
+func (*GetEventsWithAckRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{1} }
+
+func (m *GetEventsWithAckRequest) GetSubscription() *Subscription {
+	if m != nil {
+		return m.Subscription
+	}
+	return nil
+}
+
+func (m *GetEventsWithAckRequest) GetSubscriptionId() string {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return ""
+}
+
+func (m *GetEventsWithAckRequest) GetAcks() [][]byte {
+	if m != nil {
+		return m.Acks
+	}
+	return nil
+}
+
 // A response message containing telemetry events
 type GetEventsResponse struct {
 	// Can publish one or more message(s) at a time
 	Events []*ReceivedTelemetryEvent `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+	// The ID of the subscription on a GetEventsWithAck stream. This is
+	// set only in the first response on the stream, and may be used to
+	// resume the subscription after a disconnect.
+	SubscriptionId string `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId" json:"subscription_id,omitempty"`
 }
 
 func (m *GetEventsResponse) Reset()                    { *m = GetEventsResponse{} }
 func (m *GetEventsResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetEventsResponse) ProtoMessage()               {}
-func (*GetEventsResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{1} }
+func (*GetEventsResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{2} }
 
 func (m *GetEventsResponse) GetEvents() []*ReceivedTelemetryEvent {
 	if m != nil {
@@ -55,6 +100,13 @@ func (m *GetEventsResponse) GetEvents() []*ReceivedTelemetryEvent {
 	return nil
 }
 
+func (m *GetEventsResponse) GetSubscriptionId() string {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return ""
+}
+
 // A telemetry event received from a Sensor or Recorder.
 type ReceivedTelemetryEvent struct {
 	// The time that the event was received by the backplane (in micros
@@ -64,16 +116,16 @@ type ReceivedTelemetryEvent struct {
 	// event subscriptions, this event may be sent from the
 	// Recorder.
 	Event *TelemetryEvent `protobuf:"bytes,2,opt,name=event" json:"event,omitempty"`
-	// An opaque ack for the event. If present, this ack must be sent to
-	// the PubsubService's Acknowledge method or else the TelemetryService
-	// will re-transmit the event.
+	// An opaque ack for the event. If present, this ack must be sent in
+	// a GetEventsWithAckRequest or else the TelemetryService will
+	// re-transmit the event.
 	Ack []byte `protobuf:"bytes,3,opt,name=ack,proto3" json:"ack,omitempty"`
 }
 
 func (m *ReceivedTelemetryEvent) Reset()                    { *m = ReceivedTelemetryEvent{} }
 func (m *ReceivedTelemetryEvent) String() string            { return proto.CompactTextString(m) }
 func (*ReceivedTelemetryEvent) ProtoMessage()               {}
-func (*ReceivedTelemetryEvent) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{2} }
+func (*ReceivedTelemetryEvent) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{3} }
 
 func (m *ReceivedTelemetryEvent) GetPublishTimeMicros() int64 {
 	if m != nil {
@@ -96,10 +148,125 @@ func (m *ReceivedTelemetryEvent) GetAck() []byte {
 	return nil
 }
 
+// A request message to validate a subscription
+type ValidateSubscriptionRequest struct {
+	// The Subscription to validate
+	Subscription *Subscription `protobuf:"bytes,1,opt,name=subscription" json:"subscription,omitempty"`
+}
+
+func (m *ValidateSubscriptionRequest) Reset()                    { *m = ValidateSubscriptionRequest{} }
+func (m *ValidateSubscriptionRequest) String() string            { return proto.CompactTextString(m) }
+func (*ValidateSubscriptionRequest) ProtoMessage()               {}
+func (*ValidateSubscriptionRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{4} }
+
+func (m *ValidateSubscriptionRequest) GetSubscription() *Subscription {
+	if m != nil {
+		return m.Subscription
+	}
+	return nil
+}
+
+// A response message containing the results of validating a subscription
+type ValidateSubscriptionResponse struct {
+	// True if all of the filters in the subscription are valid
+	Valid bool `protobuf:"varint,1,opt,name=valid" json:"valid,omitempty"`
+	// The result of validating each filter in the subscription
+	Filters []*FilterValidation `protobuf:"bytes,2,rep,name=filters" json:"filters,omitempty"`
+}
+
+func (m *ValidateSubscriptionResponse) Reset()                    { *m = ValidateSubscriptionResponse{} }
+func (m *ValidateSubscriptionResponse) String() string            { return proto.CompactTextString(m) }
+func (*ValidateSubscriptionResponse) ProtoMessage()               {}
+func (*ValidateSubscriptionResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{5} }
+
+func (m *ValidateSubscriptionResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *ValidateSubscriptionResponse) GetFilters() []*FilterValidation {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+// The result of validating a single filter in a subscription
+type FilterValidation struct {
+	// The name of the EventFilter field containing the filter (e.g.
+	// "file_events")
+	FilterType string `protobuf:"bytes,1,opt,name=filter_type,json=filterType" json:"filter_type,omitempty"`
+	// The index of the filter in the EventFilter field
+	Index uint32 `protobuf:"varint,2,opt,name=index" json:"index,omitempty"`
+	// If not empty, the reason that the filter is invalid. When a
+	// subscription is created, an invalid filter is either ignored
+	// or does not match the expected events.
+	Error string `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+	// The kernel filter string for the filter's expression, if any
+	KernelFilter string `protobuf:"bytes,4,opt,name=kernel_filter,json=kernelFilter" json:"kernel_filter,omitempty"`
+	// The tracepoints that would be registered for the filter
+	Tracepoints []string `protobuf:"bytes,5,rep,name=tracepoints" json:"tracepoints,omitempty"`
+	// The kprobes that would be registered for the filter
+	Kprobes []string `protobuf:"bytes,6,rep,name=kprobes" json:"kprobes,omitempty"`
+}
+
+func (m *FilterValidation) Reset()                    { *m = FilterValidation{} }
+func (m *FilterValidation) String() string            { return proto.CompactTextString(m) }
+func (*FilterValidation) ProtoMessage()               {}
+func (*FilterValidation) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{6} }
+
+func (m *FilterValidation) GetFilterType() string {
+	if m != nil {
+		return m.FilterType
+	}
+	return ""
+}
+
+func (m *FilterValidation) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *FilterValidation) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FilterValidation) GetKernelFilter() string {
+	if m != nil {
+		return m.KernelFilter
+	}
+	return ""
+}
+
+func (m *FilterValidation) GetTracepoints() []string {
+	if m != nil {
+		return m.Tracepoints
+	}
+	return nil
+}
+
+func (m *FilterValidation) GetKprobes() []string {
+	if m != nil {
+		return m.Kprobes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetEventsRequest)(nil), "capsule8.api.v0.GetEventsRequest")
+	proto.RegisterType((*GetEventsWithAckRequest)(nil), "capsule8.api.v0.GetEventsWithAckRequest")
 	proto.RegisterType((*GetEventsResponse)(nil), "capsule8.api.v0.GetEventsResponse")
 	proto.RegisterType((*ReceivedTelemetryEvent)(nil), "capsule8.api.v0.ReceivedTelemetryEvent")
+	proto.RegisterType((*ValidateSubscriptionRequest)(nil), "capsule8.api.v0.ValidateSubscriptionRequest")
+	proto.RegisterType((*ValidateSubscriptionResponse)(nil), "capsule8.api.v0.ValidateSubscriptionResponse")
+	proto.RegisterType((*FilterValidation)(nil), "capsule8.api.v0.FilterValidation")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -115,6 +282,15 @@ const _ = grpc.SupportPackageIsVersion4
 type TelemetryServiceClient interface {
 	// Opens a new stream of telemetry events
 	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (TelemetryService_GetEventsClient, error)
+	// Opens a new stream of telemetry events with at-least-once
+	// delivery. Each event is sent with an ack that must be returned
+	// on the request stream, or else the event will be re-transmitted.
+	GetEventsWithAck(ctx context.Context, opts ...grpc.CallOption) (TelemetryService_GetEventsWithAckClient, error)
+	// Validates a subscription without creating it. Each filter in
+	// the subscription is checked, and the kernel filter and the
+	// tracepoints and kprobes that would be registered for it are
+	// returned.
+	ValidateSubscription(ctx context.Context, in *ValidateSubscriptionRequest, opts ...grpc.CallOption) (*ValidateSubscriptionResponse, error)
 }
 
 type telemetryServiceClient struct {
@@ -157,11 +333,60 @@ func (x *telemetryServiceGetEventsClient) Recv() (*GetEventsResponse, error) {
 	return m, nil
 }
 
+func (c *telemetryServiceClient) GetEventsWithAck(ctx context.Context, opts ...grpc.CallOption) (TelemetryService_GetEventsWithAckClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TelemetryService_serviceDesc.Streams[1], c.cc, "/capsule8.api.v0.TelemetryService/GetEventsWithAck", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &telemetryServiceGetEventsWithAckClient{stream}
+	return x, nil
+}
+
+type TelemetryService_GetEventsWithAckClient interface {
+	Send(*GetEventsWithAckRequest) error
+	Recv() (*GetEventsResponse, error)
+	grpc.ClientStream
+}
+
+type telemetryServiceGetEventsWithAckClient struct {
+	grpc.ClientStream
+}
+
+func (x *telemetryServiceGetEventsWithAckClient) Send(m *GetEventsWithAckRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *telemetryServiceGetEventsWithAckClient) Recv() (*GetEventsResponse, error) {
+	m := new(GetEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *telemetryServiceClient) ValidateSubscription(ctx context.Context, in *ValidateSubscriptionRequest, opts ...grpc.CallOption) (*ValidateSubscriptionResponse, error) {
+	out := new(ValidateSubscriptionResponse)
+	err := grpc.Invoke(ctx, "/capsule8.api.v0.TelemetryService/ValidateSubscription", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for TelemetryService service
 
 type TelemetryServiceServer interface {
 	// Opens a new stream of telemetry events
 	GetEvents(*GetEventsRequest, TelemetryService_GetEventsServer) error
+	// Opens a new stream of telemetry events with at-least-once
+	// delivery. Each event is sent with an ack that must be returned
+	// on the request stream, or else the event will be re-transmitted.
+	GetEventsWithAck(TelemetryService_GetEventsWithAckServer) error
+	// Validates a subscription without creating it. Each filter in
+	// the subscription is checked, and the kernel filter and the
+	// tracepoints and kprobes that would be registered for it are
+	// returned.
+	ValidateSubscription(context.Context, *ValidateSubscriptionRequest) (*ValidateSubscriptionResponse, error)
 }
 
 func RegisterTelemetryServiceServer(s *grpc.Server, srv TelemetryServiceServer) {
@@ -189,16 +414,71 @@ func (x *telemetryServiceGetEventsServer) Send(m *GetEventsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TelemetryService_GetEventsWithAck_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TelemetryServiceServer).GetEventsWithAck(&telemetryServiceGetEventsWithAckServer{stream})
+}
+
+type TelemetryService_GetEventsWithAckServer interface {
+	Send(*GetEventsResponse) error
+	Recv() (*GetEventsWithAckRequest, error)
+	grpc.ServerStream
+}
+
+type telemetryServiceGetEventsWithAckServer struct {
+	grpc.ServerStream
+}
+
+func (x *telemetryServiceGetEventsWithAckServer) Send(m *GetEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *telemetryServiceGetEventsWithAckServer) Recv() (*GetEventsWithAckRequest, error) {
+	m := new(GetEventsWithAckRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _TelemetryService_ValidateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServiceServer).ValidateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/capsule8.api.v0.TelemetryService/ValidateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServiceServer).ValidateSubscription(ctx, req.(*ValidateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TelemetryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "capsule8.api.v0.TelemetryService",
 	HandlerType: (*TelemetryServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValidateSubscription",
+			Handler:    _TelemetryService_ValidateSubscription_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "GetEvents",
 			Handler:       _TelemetryService_GetEvents_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetEventsWithAck",
+			Handler:       _TelemetryService_GetEventsWithAck_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "capsule8/api/v0/telemetry_service.proto",
 }
@@ -206,26 +486,43 @@ var _TelemetryService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("capsule8/api/v0/telemetry_service.proto", fileDescriptor2) }
 
 var fileDescriptor2 = []byte{
-	// 336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x86, 0xd9, 0x06, 0x0b, 0x6e, 0x0b, 0x6d, 0x57, 0x94, 0x50, 0x14, 0xeb, 0x82, 0xb4, 0x78,
-	0x48, 0x4b, 0x45, 0x10, 0x2f, 0xe2, 0x41, 0x3c, 0x79, 0x49, 0xeb, 0xb9, 0xa4, 0x71, 0xa8, 0x4b,
-	0x93, 0xec, 0x9a, 0xd9, 0x04, 0x3c, 0x09, 0xbe, 0x81, 0xf8, 0x68, 0xbe, 0x82, 0x0f, 0x22, 0xd9,
-	0x6d, 0x4b, 0x4d, 0xd4, 0xdb, 0xc2, 0x7c, 0xf3, 0xed, 0x3f, 0x33, 0xb4, 0x1f, 0x06, 0x0a, 0xb3,
-	0x08, 0x2e, 0x87, 0x81, 0x12, 0xc3, 0x7c, 0x34, 0xd4, 0x10, 0x41, 0x0c, 0x3a, 0x7d, 0x99, 0x21,
-	0xa4, 0xb9, 0x08, 0xc1, 0x53, 0xa9, 0xd4, 0x92, 0xb5, 0xd6, 0xa0, 0x17, 0x28, 0xe1, 0xe5, 0xa3,
-	0x2e, 0x2f, 0x77, 0x62, 0x36, 0xc7, 0x30, 0x15, 0x4a, 0x0b, 0x99, 0xd8, 0xa6, 0xee, 0xe9, 0xdf,
-	0x76, 0xc8, 0x21, 0xd1, 0x2b, 0xec, 0x70, 0x21, 0xe5, 0x22, 0x02, 0x03, 0x05, 0x49, 0x22, 0x75,
-	0x50, 0x38, 0xd0, 0x56, 0xf9, 0x03, 0x6d, 0xdf, 0x81, 0xbe, 0x2d, 0x78, 0xf4, 0xe1, 0x39, 0x03,
-	0xd4, 0xec, 0x86, 0x36, 0xb7, 0xbf, 0x73, 0x49, 0x8f, 0x0c, 0x1a, 0xe3, 0x23, 0xaf, 0x14, 0xd2,
-	0x9b, 0x6c, 0x41, 0xfe, 0x8f, 0x16, 0x3e, 0xa5, 0x9d, 0x2d, 0x2d, 0x2a, 0x99, 0x20, 0xb0, 0x6b,
-	0x5a, 0x37, 0xc1, 0xd0, 0x25, 0x3d, 0x67, 0xd0, 0x18, 0xf7, 0x2b, 0x46, 0x1f, 0x42, 0x10, 0x39,
-	0x3c, 0x4e, 0xd7, 0x93, 0x18, 0x83, 0xbf, 0x6a, 0xe3, 0xef, 0x84, 0x1e, 0xfc, 0x8e, 0x30, 0x8f,
-	0xee, 0xa9, 0x6c, 0x1e, 0x09, 0x7c, 0x9a, 0x69, 0x11, 0xc3, 0x2c, 0x16, 0x61, 0x2a, 0xd1, 0x44,
-	0x77, 0xfc, 0xce, 0xaa, 0x34, 0x15, 0x31, 0xdc, 0x9b, 0x02, 0xbb, 0xa0, 0x3b, 0x46, 0xea, 0xd6,
-	0xcc, 0x70, 0xc7, 0x95, 0x28, 0xa5, 0x08, 0x96, 0x66, 0x6d, 0xea, 0x04, 0xe1, 0xd2, 0x75, 0x7a,
-	0x64, 0xd0, 0xf4, 0x8b, 0xe7, 0xf8, 0x95, 0xb6, 0x37, 0xe8, 0xc4, 0x1e, 0x95, 0x2d, 0xe9, 0xee,
-	0x66, 0x7a, 0x76, 0x52, 0x51, 0x97, 0x17, 0xde, 0xe5, 0xff, 0x21, 0x76, 0x79, 0x7c, 0xff, 0xed,
-	0xf3, 0xeb, 0xa3, 0xd6, 0xe2, 0xb4, 0xb8, 0xb4, 0xdd, 0xc7, 0x15, 0x39, 0x1b, 0x91, 0x79, 0xdd,
-	0x1c, 0xf2, 0xfc, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xf7, 0x4e, 0x1e, 0x6d, 0x02, 0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xfe, 0x6d, 0xdd, 0x3f, 0xbf, 0x4c, 0x52, 0x9a, 0x2e, 0x05, 0xac, 0x50, 0x54, 0x63, 0x54,
+	0xd5, 0xaa, 0x20, 0x89, 0x82, 0x90, 0x50, 0x39, 0xa0, 0x1e, 0x00, 0x71, 0xe0, 0xb2, 0x2d, 0x70,
+	0x34, 0x8e, 0x33, 0x6d, 0x57, 0x76, 0x6c, 0x77, 0x77, 0x63, 0xd1, 0x03, 0x17, 0xc4, 0x0b, 0x20,
+	0x4e, 0xf0, 0x34, 0xbc, 0x03, 0xaf, 0xc0, 0x83, 0x20, 0xef, 0x3a, 0x95, 0xeb, 0xb4, 0x55, 0x0e,
+	0xbd, 0x79, 0xe6, 0x9b, 0xf9, 0xbe, 0xf1, 0xb7, 0xb3, 0x0b, 0x3b, 0x61, 0x90, 0xc9, 0x49, 0x8c,
+	0xcf, 0x7b, 0x41, 0xc6, 0x7b, 0x79, 0xbf, 0xa7, 0x30, 0xc6, 0x31, 0x2a, 0x71, 0xe6, 0x4b, 0x14,
+	0x39, 0x0f, 0xb1, 0x9b, 0x89, 0x54, 0xa5, 0x74, 0x6d, 0x5a, 0xd8, 0x0d, 0x32, 0xde, 0xcd, 0xfb,
+	0x1d, 0xb7, 0xde, 0x29, 0x27, 0x43, 0x19, 0x0a, 0x9e, 0x29, 0x9e, 0x26, 0xa6, 0xa9, 0xb3, 0x7d,
+	0x35, 0x3b, 0xe6, 0x98, 0xa8, 0xb2, 0x6c, 0xf3, 0x38, 0x4d, 0x8f, 0x63, 0xd4, 0x45, 0x41, 0x92,
+	0xa4, 0x2a, 0x28, 0x38, 0xa4, 0x41, 0xdd, 0xf7, 0xd0, 0x7e, 0x83, 0xea, 0x55, 0x51, 0x2f, 0x19,
+	0x9e, 0x4e, 0x50, 0x2a, 0xba, 0x0f, 0xad, 0xaa, 0x9c, 0x4d, 0x1c, 0xe2, 0x35, 0x07, 0x0f, 0xba,
+	0xb5, 0x21, 0xbb, 0x07, 0x95, 0x22, 0x76, 0xa1, 0xc5, 0xfd, 0x49, 0xe0, 0xde, 0x39, 0xef, 0x47,
+	0xae, 0x4e, 0xf6, 0xc3, 0xe8, 0xe6, 0xe8, 0xe9, 0x0e, 0xac, 0x55, 0x63, 0x9f, 0x8f, 0xec, 0x05,
+	0x87, 0x78, 0x0d, 0x76, 0xab, 0x9a, 0x7e, 0x3b, 0xa2, 0x14, 0x16, 0x83, 0x30, 0x92, 0xb6, 0xe5,
+	0x58, 0x5e, 0x8b, 0xe9, 0x6f, 0xf7, 0x0b, 0xac, 0x57, 0x7e, 0x59, 0x66, 0x69, 0x22, 0x91, 0xbe,
+	0x84, 0x65, 0x6d, 0x9a, 0xb4, 0x89, 0x63, 0x79, 0xcd, 0xc1, 0xce, 0xcc, 0x38, 0x0c, 0x43, 0xe4,
+	0x39, 0x8e, 0x0e, 0xa7, 0x2e, 0x6b, 0x06, 0x56, 0xb6, 0xcd, 0x3d, 0x92, 0xfb, 0x9d, 0xc0, 0xdd,
+	0xcb, 0xb9, 0x68, 0x17, 0x6e, 0x67, 0x93, 0x61, 0xcc, 0xe5, 0x89, 0xaf, 0xf8, 0x18, 0xfd, 0x31,
+	0x0f, 0x45, 0x2a, 0xb5, 0x41, 0x16, 0x5b, 0x2f, 0xa1, 0x43, 0x3e, 0xc6, 0x77, 0x1a, 0xa0, 0xcf,
+	0x60, 0x49, 0xab, 0x6b, 0xa5, 0xe6, 0x60, 0x6b, 0x66, 0xe6, 0xda, 0xac, 0xa6, 0x9a, 0xb6, 0xc1,
+	0x0a, 0xc2, 0xc8, 0xb6, 0x1c, 0xe2, 0xb5, 0x58, 0xf1, 0xe9, 0x7e, 0x82, 0xfb, 0x1f, 0x82, 0x98,
+	0x8f, 0x02, 0x85, 0x17, 0x5c, 0xbf, 0xb9, 0x85, 0x38, 0x85, 0xcd, 0xcb, 0x15, 0x4a, 0xff, 0x37,
+	0x60, 0x29, 0x2f, 0x70, 0xcd, 0xfd, 0x3f, 0x33, 0x01, 0x7d, 0x01, 0x2b, 0x47, 0x3c, 0x56, 0x28,
+	0xa4, 0xbd, 0xa0, 0x8f, 0xe5, 0xe1, 0x8c, 0xe6, 0x6b, 0x8d, 0x97, 0xdc, 0x05, 0xe3, 0xb4, 0xc3,
+	0xfd, 0x4d, 0xa0, 0x5d, 0x47, 0xe9, 0x16, 0x34, 0x0d, 0xee, 0xab, 0xb3, 0x0c, 0xb5, 0x5a, 0x83,
+	0x81, 0x49, 0x1d, 0x9e, 0x65, 0x7a, 0x10, 0x9e, 0x8c, 0xf0, 0xb3, 0xf6, 0x74, 0x95, 0x99, 0xa0,
+	0xc8, 0xa2, 0x10, 0xa9, 0xd0, 0xa6, 0x35, 0x98, 0x09, 0xe8, 0x23, 0x58, 0x8d, 0x50, 0x24, 0x18,
+	0xfb, 0x86, 0xc0, 0x5e, 0xd4, 0x68, 0xcb, 0x24, 0x8d, 0x36, 0x75, 0xa0, 0xa9, 0x44, 0x10, 0x62,
+	0x96, 0xf2, 0x62, 0xbd, 0x96, 0x1c, 0xcb, 0x6b, 0xb0, 0x6a, 0x8a, 0xda, 0xb0, 0x12, 0x65, 0x22,
+	0x1d, 0xa2, 0xb4, 0x97, 0x35, 0x3a, 0x0d, 0x07, 0xdf, 0x2c, 0x68, 0x9f, 0x9f, 0xe1, 0x81, 0x79,
+	0x32, 0x68, 0x04, 0x8d, 0xf3, 0xfd, 0xa5, 0xb3, 0x86, 0xd4, 0xaf, 0x73, 0xc7, 0xbd, 0xae, 0xc4,
+	0xd8, 0xef, 0xde, 0xf9, 0xfa, 0xe7, 0xef, 0x8f, 0x85, 0x35, 0x17, 0x8a, 0x77, 0xc4, 0x6c, 0xf4,
+	0x1e, 0xd9, 0xed, 0x13, 0x7a, 0x04, 0xed, 0xfa, 0x3d, 0xa6, 0xde, 0xd5, 0x84, 0x17, 0xaf, 0xfa,
+	0x5c, 0xd2, 0xff, 0x79, 0xa4, 0x4f, 0xe8, 0x2f, 0x02, 0x1b, 0x97, 0x2d, 0x08, 0x7d, 0x3c, 0x43,
+	0x71, 0xcd, 0xa6, 0x76, 0x9e, 0xcc, 0x59, 0x5d, 0x6a, 0x6f, 0xeb, 0xdf, 0xde, 0x72, 0x3b, 0xf5,
+	0x27, 0x56, 0xf6, 0xf2, 0xb2, 0x6f, 0x8f, 0xec, 0x0e, 0x97, 0xf5, 0x5b, 0xf9, 0xf4, 0xdf, 0x00,
+	0xe9, 0xa8, 0x1a, 0xa6, 0xd0, 0x05, 0x00, 0x00,
 }
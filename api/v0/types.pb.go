@@ -10,6 +10,7 @@ It is generated from these files:
 	capsule8/api/v0/telemetry_service.proto
 	capsule8/api/v0/subscription.proto
 	capsule8/api/v0/expression.proto
+	capsule8/api/v0/sensor_service.proto
 
 It has these top-level messages:
 	IPv4Address
@@ -18,28 +19,55 @@ It has these top-level messages:
 	IPv6AddressAndPort
 	NetworkAddress
 	Credentials
+	ResourceUsage
 	TelemetryEvent
 	ChargenEvent
 	TickerEvent
 	ContainerEvent
+	PressureStallAverages
+	PressureStall
+	ContainerMetricsEvent
 	ProcessEvent
 	SyscallEvent
 	FileEvent
 	Process
 	KernelFunctionCallEvent
+	UserFunctionCallEvent
+	TracepointEvent
 	NetworkEvent
+	LostEventsEvent
+	DroppedEventsEvent
+	SignalEvent
+	NamespaceEvent
+	MountEvent
+	KernelModuleEvent
+	ProcessAccessEvent
 	GetEventsRequest
+	GetEventsWithAckRequest
 	GetEventsResponse
 	ReceivedTelemetryEvent
+	ValidateSubscriptionRequest
+	ValidateSubscriptionResponse
+	FilterValidation
 	Subscription
+	BatchOptions
+	BackpressureOptions
 	ContainerFilter
 	EventFilter
 	SyscallEventFilter
 	ProcessEventFilter
 	FileEventFilter
 	KernelFunctionCallFilter
+	UserFunctionCallFilter
+	TracepointEventFilter
 	NetworkEventFilter
+	SignalEventFilter
+	NamespaceEventFilter
+	MountEventFilter
+	KernelModuleEventFilter
+	ProcessAccessEventFilter
 	ContainerEventFilter
+	ContainerMetricsEventFilter
 	ChargenEventFilter
 	TickerEventFilter
 	Modifier
@@ -48,6 +76,17 @@ It has these top-level messages:
 	Value
 	BinaryOp
 	Expression
+	GetSensorInfoRequest
+	GetSensorInfoResponse
+	ListSubscriptionsRequest
+	ListSubscriptionsResponse
+	SubscriptionInfo
+	ListContainersRequest
+	ListContainersResponse
+	ContainerInfo
+	ListProcessesRequest
+	ListProcessesResponse
+	ProcessInfo
 */
 package capsule8_api_v0
 
@@ -436,6 +475,87 @@ func (m *Credentials) GetFsgid() uint32 {
 	return 0
 }
 
+// Process resource usage
+type ResourceUsage struct {
+	// The time spent executing in user mode, in nanoseconds
+	UserTimeNanos uint64 `protobuf:"varint,1,opt,name=user_time_nanos,json=userTimeNanos" json:"user_time_nanos,omitempty"`
+	// The time spent executing in kernel mode, in nanoseconds
+	SystemTimeNanos uint64 `protobuf:"varint,2,opt,name=system_time_nanos,json=systemTimeNanos" json:"system_time_nanos,omitempty"`
+	// The maximum resident set size, in bytes
+	MaxRssBytes uint64 `protobuf:"varint,3,opt,name=max_rss_bytes,json=maxRssBytes" json:"max_rss_bytes,omitempty"`
+	// The number of voluntary context switches
+	VoluntaryContextSwitches uint64 `protobuf:"varint,4,opt,name=voluntary_context_switches,json=voluntaryContextSwitches" json:"voluntary_context_switches,omitempty"`
+	// The number of involuntary context switches
+	InvoluntaryContextSwitches uint64 `protobuf:"varint,5,opt,name=involuntary_context_switches,json=involuntaryContextSwitches" json:"involuntary_context_switches,omitempty"`
+	// The number of bytes read via read(2) and similar system calls
+	ReadBytes uint64 `protobuf:"varint,6,opt,name=read_bytes,json=readBytes" json:"read_bytes,omitempty"`
+	// The number of bytes written via write(2) and similar system calls
+	WriteBytes uint64 `protobuf:"varint,7,opt,name=write_bytes,json=writeBytes" json:"write_bytes,omitempty"`
+	// The time elapsed since the process was forked, in nanoseconds
+	LifetimeNanos uint64 `protobuf:"varint,8,opt,name=lifetime_nanos,json=lifetimeNanos" json:"lifetime_nanos,omitempty"`
+}
+
+func (m *ResourceUsage) Reset()                    { *m = ResourceUsage{} }
+func (m *ResourceUsage) String() string            { return proto.CompactTextString(m) }
+func (*ResourceUsage) ProtoMessage()               {}
+func (*ResourceUsage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *ResourceUsage) GetUserTimeNanos() uint64 {
+	if m != nil {
+		return m.UserTimeNanos
+	}
+	return 0
+}
+
+func (m *ResourceUsage) GetSystemTimeNanos() uint64 {
+	if m != nil {
+		return m.SystemTimeNanos
+	}
+	return 0
+}
+
+func (m *ResourceUsage) GetMaxRssBytes() uint64 {
+	if m != nil {
+		return m.MaxRssBytes
+	}
+	return 0
+}
+
+func (m *ResourceUsage) GetVoluntaryContextSwitches() uint64 {
+	if m != nil {
+		return m.VoluntaryContextSwitches
+	}
+	return 0
+}
+
+func (m *ResourceUsage) GetInvoluntaryContextSwitches() uint64 {
+	if m != nil {
+		return m.InvoluntaryContextSwitches
+	}
+	return 0
+}
+
+func (m *ResourceUsage) GetReadBytes() uint64 {
+	if m != nil {
+		return m.ReadBytes
+	}
+	return 0
+}
+
+func (m *ResourceUsage) GetWriteBytes() uint64 {
+	if m != nil {
+		return m.WriteBytes
+	}
+	return 0
+}
+
+func (m *ResourceUsage) GetLifetimeNanos() uint64 {
+	if m != nil {
+		return m.LifetimeNanos
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IPv4Address)(nil), "capsule8.api.v0.IPv4Address")
 	proto.RegisterType((*IPv4AddressAndPort)(nil), "capsule8.api.v0.IPv4AddressAndPort")
@@ -443,40 +563,51 @@ func init() {
 	proto.RegisterType((*IPv6AddressAndPort)(nil), "capsule8.api.v0.IPv6AddressAndPort")
 	proto.RegisterType((*NetworkAddress)(nil), "capsule8.api.v0.NetworkAddress")
 	proto.RegisterType((*Credentials)(nil), "capsule8.api.v0.Credentials")
+	proto.RegisterType((*ResourceUsage)(nil), "capsule8.api.v0.ResourceUsage")
 	proto.RegisterEnum("capsule8.api.v0.NetworkAddressFamily", NetworkAddressFamily_name, NetworkAddressFamily_value)
 }
 
 func init() { proto.RegisterFile("capsule8/api/v0/types.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x51, 0x6f, 0x9a, 0x50,
-	0x1c, 0xc5, 0x45, 0x5b, 0x5c, 0xff, 0xd4, 0x8e, 0xdc, 0xf8, 0x60, 0xd2, 0xa6, 0x23, 0x2c, 0xcd,
-	0xcc, 0x1e, 0xb0, 0x69, 0x1b, 0xb2, 0x97, 0x3d, 0xb0, 0xd6, 0x46, 0x53, 0x87, 0xe6, 0xea, 0x62,
-	0xf6, 0xc4, 0x98, 0xa0, 0xde, 0x8c, 0x09, 0xe1, 0x22, 0xc6, 0x0f, 0xb2, 0xe7, 0x7d, 0x82, 0x7d,
-	0xc7, 0xe5, 0x5e, 0x2e, 0x4c, 0x37, 0x35, 0x4b, 0xdf, 0xfe, 0xf7, 0xf0, 0xfb, 0x1f, 0x0e, 0xe7,
-	0x06, 0x38, 0x9f, 0xb8, 0x11, 0x5d, 0x06, 0xfe, 0xbb, 0x96, 0x1b, 0x91, 0x56, 0x7a, 0xdd, 0x4a,
-	0xd6, 0x91, 0x4f, 0x8d, 0x28, 0x0e, 0x93, 0x10, 0xbd, 0xcc, 0x1f, 0x1a, 0x6e, 0x44, 0x8c, 0xf4,
-	0x5a, 0x7f, 0x03, 0x4a, 0x77, 0x90, 0xde, 0x59, 0x9e, 0x17, 0xfb, 0x94, 0xa2, 0x06, 0x54, 0xdd,
-	0x6c, 0x6c, 0x48, 0x9a, 0xd4, 0xac, 0xe2, 0xfc, 0xa8, 0x7f, 0x01, 0xb4, 0x01, 0x5a, 0x0b, 0x6f,
-	0x10, 0xc6, 0x09, 0x32, 0xb7, 0x79, 0xe5, 0xe6, 0xc2, 0xf8, 0xeb, 0x0d, 0xc6, 0xc6, 0x56, 0xe1,
-	0x86, 0x10, 0x1c, 0x45, 0x61, 0x9c, 0x34, 0xca, 0x9a, 0xd4, 0xac, 0x61, 0x3e, 0xeb, 0xb7, 0x3c,
-	0x8a, 0x69, 0xfd, 0x41, 0xe6, 0x64, 0x36, 0xe7, 0xbe, 0x32, 0xe6, 0x33, 0x52, 0xa1, 0x12, 0x84,
-	0x2b, 0xbe, 0x25, 0x63, 0x36, 0x8a, 0x58, 0xe6, 0xb3, 0x62, 0x99, 0xff, 0x15, 0xeb, 0x47, 0x19,
-	0xce, 0x6c, 0x3f, 0x59, 0x85, 0xf1, 0xb7, 0x3c, 0xda, 0x7b, 0x90, 0xa7, 0xee, 0x77, 0x12, 0xac,
-	0xb9, 0xfb, 0xd9, 0xcd, 0xd5, 0x3f, 0xee, 0xdb, 0x0b, 0x8f, 0x1c, 0xc6, 0x62, 0x09, 0x75, 0xe0,
-	0x94, 0x44, 0xe9, 0x9d, 0x93, 0x47, 0x04, 0x1e, 0xf1, 0xf5, 0xa1, 0xe6, 0xc4, 0x87, 0x75, 0x4a,
-	0x58, 0x61, 0xab, 0x79, 0x90, 0xcc, 0xc9, 0x2c, 0x9c, 0xea, 0xfb, 0x9d, 0xcc, 0x9d, 0x4e, 0x45,
-	0xdb, 0x57, 0x50, 0x0b, 0xc2, 0x89, 0x1b, 0x14, 0x56, 0x97, 0x9a, 0xd4, 0x3c, 0xe9, 0x94, 0xf0,
-	0x29, 0x97, 0x05, 0xf6, 0xe1, 0xa4, 0x28, 0x56, 0xff, 0x25, 0x81, 0x72, 0x1f, 0xfb, 0x9e, 0xbf,
-	0x48, 0x88, 0x1b, 0x50, 0x76, 0x37, 0x4b, 0xe2, 0xf1, 0x46, 0x6a, 0x98, 0x8d, 0x4c, 0x99, 0x11,
-	0x4f, 0x94, 0xc9, 0x46, 0xd6, 0xaf, 0xcf, 0xa0, 0x4a, 0xd6, 0x2f, 0x9b, 0xb9, 0xc6, 0xb0, 0x23,
-	0xa1, 0x09, 0x8e, 0x32, 0xee, 0x38, 0xd3, 0xa8, 0xe0, 0x28, 0xe3, 0x64, 0xa1, 0x31, 0xae, 0x0e,
-	0xc7, 0x53, 0x0e, 0x56, 0xb9, 0x98, 0x1d, 0x32, 0x95, 0xa1, 0x2f, 0x72, 0x75, 0x46, 0xbc, 0xb7,
-	0x3f, 0x25, 0xa8, 0xef, 0xba, 0x16, 0xa4, 0xc3, 0xa5, 0xdd, 0x1e, 0x8d, 0xfb, 0xf8, 0xc9, 0xb1,
-	0x1e, 0x1e, 0x70, 0x7b, 0x38, 0x74, 0x1e, 0xad, 0x8f, 0xdd, 0xde, 0x67, 0xe7, 0x93, 0xfd, 0x64,
-	0xf7, 0xc7, 0xb6, 0x5a, 0x42, 0xaf, 0xe0, 0x7c, 0x0f, 0xd3, 0xb5, 0xdb, 0x23, 0x55, 0x42, 0x1a,
-	0x5c, 0x1c, 0x00, 0x4c, 0xb5, 0x7c, 0x80, 0xe8, 0xf5, 0xef, 0xad, 0x9e, 0x5a, 0xf9, 0x2a, 0xf3,
-	0x7f, 0xf4, 0xf6, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x05, 0xf4, 0xc6, 0xf8, 0xc2, 0x03, 0x00,
-	0x00,
+	// 615 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcd, 0x4e, 0xdb, 0x40,
+	0x14, 0x85, 0x71, 0x08, 0x09, 0xdc, 0x10, 0x48, 0x47, 0x2c, 0x22, 0xa0, 0x10, 0xb9, 0xa2, 0x45,
+	0x2c, 0x02, 0x02, 0x64, 0x75, 0xd1, 0x4a, 0x0d, 0x7f, 0x02, 0x41, 0x0d, 0x1a, 0x40, 0xa8, 0x2b,
+	0x77, 0x88, 0x87, 0x30, 0xaa, 0xff, 0x34, 0x33, 0x4e, 0xc8, 0x83, 0x74, 0xdd, 0x27, 0xe8, 0xab,
+	0xf5, 0x19, 0xaa, 0x19, 0x8f, 0x4d, 0x68, 0x21, 0xaa, 0xba, 0xbb, 0x3e, 0xf7, 0xbb, 0x87, 0xe3,
+	0x43, 0x12, 0x58, 0xea, 0x92, 0x44, 0xa4, 0x01, 0x7d, 0xbf, 0x49, 0x12, 0xb6, 0xd9, 0xdf, 0xda,
+	0x94, 0xc3, 0x84, 0x8a, 0x76, 0xc2, 0x63, 0x19, 0xa3, 0xf9, 0x7c, 0xd9, 0x26, 0x09, 0x6b, 0xf7,
+	0xb7, 0xec, 0x77, 0x50, 0x3b, 0xb9, 0xe8, 0xef, 0x76, 0x7c, 0x9f, 0x53, 0x21, 0x50, 0x13, 0xaa,
+	0x24, 0x1b, 0x9b, 0x56, 0xcb, 0x5a, 0xaf, 0xe2, 0xfc, 0xd1, 0xfe, 0x0a, 0x68, 0x04, 0xec, 0x44,
+	0xfe, 0x45, 0xcc, 0x25, 0x72, 0x9e, 0xf2, 0xb5, 0xed, 0xe5, 0xf6, 0x1f, 0x7f, 0xa1, 0x3d, 0x72,
+	0x55, 0xb8, 0x21, 0x04, 0xe5, 0x24, 0xe6, 0xb2, 0x59, 0x6a, 0x59, 0xeb, 0x75, 0xac, 0x67, 0x7b,
+	0x47, 0x47, 0x71, 0x3a, 0x8f, 0xc8, 0x3d, 0xeb, 0xdd, 0x6b, 0xdf, 0x0a, 0xd6, 0x33, 0x6a, 0xc0,
+	0x64, 0x10, 0x0f, 0xf4, 0x55, 0x05, 0xab, 0xd1, 0xc4, 0x72, 0xfe, 0x2b, 0x96, 0xf3, 0x4f, 0xb1,
+	0xbe, 0x97, 0x60, 0xce, 0xa5, 0x72, 0x10, 0xf3, 0x6f, 0x79, 0xb4, 0x8f, 0x50, 0xb9, 0x23, 0x21,
+	0x0b, 0x86, 0xda, 0x7d, 0x6e, 0x7b, 0xed, 0x2f, 0xf7, 0xa7, 0x07, 0x47, 0x1a, 0xc6, 0xe6, 0x08,
+	0x1d, 0xc3, 0x2c, 0x4b, 0xfa, 0xbb, 0x5e, 0x1e, 0x11, 0x74, 0xc4, 0x37, 0xe3, 0x9a, 0x33, 0x2f,
+	0x76, 0x3c, 0x81, 0x6b, 0x2c, 0x29, 0x54, 0xe3, 0xe4, 0x14, 0x4e, 0x0b, 0x2f, 0x3b, 0x39, 0xcf,
+	0x3a, 0x15, 0x6d, 0xaf, 0x41, 0x3d, 0x88, 0xbb, 0x24, 0x28, 0xac, 0x56, 0x5a, 0xd6, 0xfa, 0xcc,
+	0xf1, 0x04, 0x9e, 0xd5, 0xb2, 0xc1, 0xf6, 0x66, 0x8a, 0x62, 0xed, 0x9f, 0x16, 0xd4, 0xf6, 0x39,
+	0xf5, 0x69, 0x24, 0x19, 0x09, 0x84, 0xfa, 0xdf, 0xa4, 0xcc, 0xd7, 0x8d, 0xd4, 0xb1, 0x1a, 0x95,
+	0xd2, 0x63, 0xbe, 0x29, 0x53, 0x8d, 0xaa, 0x5f, 0xaa, 0xa0, 0xc9, 0xac, 0x5f, 0x9a, 0x1a, 0x4d,
+	0x61, 0x65, 0xa3, 0x19, 0x4e, 0x28, 0x6e, 0x2a, 0xd3, 0x84, 0xe1, 0x84, 0xe2, 0x2a, 0x46, 0x53,
+	0xdc, 0x02, 0x4c, 0xdd, 0x69, 0xb0, 0xaa, 0xc5, 0xec, 0x21, 0x53, 0x15, 0x3a, 0x9d, 0xab, 0x3d,
+	0xe6, 0xdb, 0xbf, 0x4a, 0x50, 0xc7, 0x54, 0xc4, 0x29, 0xef, 0xd2, 0x6b, 0x41, 0x7a, 0x14, 0xbd,
+	0x85, 0xf9, 0x54, 0x50, 0xee, 0x49, 0x16, 0x52, 0x2f, 0x22, 0x51, 0x9c, 0x7d, 0x5a, 0xca, 0xb8,
+	0xae, 0xe4, 0x2b, 0x16, 0x52, 0x57, 0x89, 0x68, 0x03, 0x5e, 0x89, 0xa1, 0x90, 0x34, 0x1c, 0x25,
+	0x4b, 0x9a, 0x9c, 0xcf, 0x16, 0x8f, 0xac, 0x0d, 0xf5, 0x90, 0x3c, 0x78, 0x5c, 0x08, 0xef, 0x76,
+	0x28, 0xa9, 0xd0, 0xaf, 0x5a, 0xc6, 0xb5, 0x90, 0x3c, 0x60, 0x21, 0xf6, 0x94, 0x84, 0x3e, 0xc0,
+	0x62, 0x3f, 0x0e, 0xd2, 0x48, 0x12, 0x3e, 0xf4, 0xba, 0x71, 0x24, 0xe9, 0x83, 0xf4, 0xc4, 0x80,
+	0xc9, 0xee, 0x3d, 0x15, 0xba, 0x87, 0x32, 0x6e, 0x16, 0xc4, 0x7e, 0x06, 0x5c, 0x9a, 0x3d, 0xfa,
+	0x04, 0xcb, 0x2c, 0x1a, 0x73, 0x3f, 0xa5, 0xef, 0x17, 0x59, 0xf4, 0xa2, 0xc3, 0x6b, 0x00, 0x4e,
+	0x89, 0x6f, 0x02, 0x56, 0x34, 0x3f, 0xa3, 0x94, 0x2c, 0xde, 0x2a, 0xd4, 0x06, 0x9c, 0x49, 0x6a,
+	0xf6, 0x55, 0xbd, 0x07, 0x2d, 0x65, 0xc0, 0x1a, 0xcc, 0x05, 0xec, 0x8e, 0x8e, 0x94, 0x31, 0x9d,
+	0xd5, 0x96, 0xab, 0xba, 0x8a, 0x8d, 0x1f, 0x16, 0x2c, 0x3c, 0xf7, 0x3d, 0x40, 0x36, 0xac, 0xb8,
+	0x87, 0x57, 0x37, 0xe7, 0xf8, 0xd4, 0xeb, 0x1c, 0x1c, 0xe0, 0xc3, 0xcb, 0x4b, 0xef, 0xa8, 0xf3,
+	0xf9, 0xe4, 0xec, 0x8b, 0x77, 0xed, 0x9e, 0xba, 0xe7, 0x37, 0x6e, 0x63, 0x02, 0xad, 0xc2, 0xd2,
+	0x0b, 0xcc, 0x89, 0x7b, 0x78, 0xd5, 0xb0, 0x50, 0x0b, 0x96, 0xc7, 0x00, 0x4e, 0xa3, 0x34, 0x86,
+	0x38, 0x3b, 0xdf, 0xef, 0x9c, 0x35, 0x26, 0x6f, 0x2b, 0xfa, 0x47, 0x71, 0xe7, 0xf7, 0x00, 0x74,
+	0x67, 0x33, 0xd2, 0x33, 0x05, 0x00, 0x00,
 }
@@ -15,6 +15,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/golang/glog"
 	"github.com/kelseyhightower/envconfig"
 )
@@ -45,6 +47,15 @@ var Sensor struct {
 	//   :8484
 	ListenAddr string `split_words:"true" default:"unix:/var/run/capsule8/sensor.sock"`
 
+	// Sensor HTTP API listen address (e.g. 127.0.0.1:8485). If set, the
+	// gRPC API is also served as REST/JSON over HTTP on this address.
+	// Event streams are sent as newline-delimited JSON, or as Server-Sent
+	// Events if the client accepts text/event-stream. Event streams may
+	// also be requested with GET /v0/events, e.g. by a browser's
+	// EventSource, with the subscription as JSON in the "subscription"
+	// query parameter. The same TLS settings as the gRPC API are used.
+	HTTPListenAddr string `split_words:"true"`
+
 	// UseTLS is the boolean switch to enable TLS use. By default it
 	// is false. If UseTLS is true, TLSCACertPath, TLSServerCertPath
 	// and TLSServerKeyPath will need to be set.
@@ -72,12 +83,41 @@ var Sensor struct {
 	// the entire system, use "" or "/" as the cgroup name.
 	CgroupName []string `split_words:"true"`
 
+	// Names of environment variables to capture from the environment of
+	// exec'd processes, e.g. LD_PRELOAD. No environment variables are
+	// captured if this is empty. Only the first ExecEnvironmentCount
+	// environment strings are searched.
+	ExecEnvironment []string `split_words:"true"`
+
+	// The number of envp elements fetched by the kernel when a process
+	// is exec'd if ExecEnvironment is set, at most 256. A warning is
+	// logged if a process has a longer environment.
+	ExecEnvironmentCount int `split_words:"true" default:"64"`
+
+	// If true, executed files are hashed in the background and exec
+	// hashed process events report the SHA-256, size, inode, and
+	// modification time of each executed file.
+	ExecHash bool `split_words:"true"`
+
 	// Ignore missing debugfs/tracefs mount (useful for automated testing)
 	DontMountTracing bool `split_words:"true"`
 
 	// Ignore missing perf_event cgroup filesystem mount
 	DontMountPerfEvent bool `split_words:"true"`
 
+	// The length of time to wait for an event sent on a GetEventsWithAck
+	// stream to be acknowledged before re-transmitting it.
+	AckTimeout time.Duration `split_words:"true" default:"30s"`
+
+	// The length of time to keep a GetEventsWithAck subscription after its
+	// client disconnects, so that it may be resumed.
+	AckResumeTimeout time.Duration `split_words:"true" default:"1m"`
+
+	// The maximum number of unacknowledged events held for re-transmission
+	// for each GetEventsWithAck subscription. No new events are taken
+	// from the subscription while this many are unacknowledged.
+	AckBufferLength int `split_words:"true" default:"1024"`
+
 	//
 	// Performance knobs below here
 	//
@@ -86,12 +126,33 @@ var Sensor struct {
 	// monitors. The size is defined in units of pages.
 	RingBufferPages int `split_words:"true" default:"8"`
 
+	// The number of argv elements captured by the kernel when a process
+	// is exec'd, at most 256. Longer command-lines are truncated. Every
+	// 14 elements are fetched by a separate kprobe.
+	ExecArgvCount int `split_words:"true" default:"6"`
+
 	// The default buffer length for Go channels used internally
 	ChannelBufferLength int `split_words:"true" default:"1024"`
 
 	// The size of the process info cache. If the system pid_max is greater
 	// than this size, a less performant method of caching will be used.
 	ProcessInfoCacheSize uint `split_words:"true" default:"131072"`
+
+	// The length of time that dispatched telemetry events are retained
+	// for replay to subscriptions that specify a since_duration, e.g.
+	// CAPSULE8_SENSOR_EVENT_HISTORY_DURATION=5m. The event history is disabled
+	// by default, in which case since_duration is ignored and only live
+	// events are returned.
+	EventHistoryDuration time.Duration `split_words:"true" default:"0"`
+
+	// The maximum number of telemetry events retained in memory for the
+	// event history.
+	EventHistoryLength int `split_words:"true" default:"65536"`
+
+	// If true, events that no longer fit in memory are spilled to disk
+	// under RunDir until they expire from the event history. Otherwise
+	// they are discarded.
+	EventHistorySpill bool `split_words:"true"`
 }
 
 func init() {
@@ -16,6 +16,8 @@ package expression
 
 import (
 	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/golang/protobuf/proto"
 )
 
 // FieldTypeMap is a mapping of types for field names/identifiers
@@ -74,6 +76,20 @@ func (expr *Expression) Validate(types FieldTypeMap) error {
 	return err
 }
 
+// ConvertIntegerValues returns a copy of an expression in which integer
+// values that are compared with or masked against an integer of a different
+// type are converted to that type, if the value can be represented by it.
+// Clients often don't know the exact width and signedness of a field, e.g.
+// a syscall return value is SINT64, but is naturally compared with an int32.
+// The types map is the same as for Validate.
+func (expr *Expression) ConvertIntegerValues(types FieldTypeMap) *Expression {
+	tree := proto.Clone(expr.tree).(*api.Expression)
+	convertIntegerValues(tree, types)
+	return &Expression{
+		tree: tree,
+	}
+}
+
 // ValidateKernelFilter determins whether an expression can be represented as
 // a kernel filter string. If the result is nil, the kernel will most likely
 // accept the expression as a filter. No check is done on the number of
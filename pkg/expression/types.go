@@ -15,6 +15,8 @@
 package expression
 
 import (
+	"math"
+
 	api "github.com/capsule8/capsule8/api/v0"
 )
 
@@ -51,3 +53,96 @@ func isValueTypeString(t api.ValueType) bool {
 	}
 	return false
 }
+
+// Range of values that can be represented by each integer type
+var integerTypeRanges = map[api.ValueType]struct {
+	min int64
+	max uint64
+}{
+	api.ValueType_SINT8:  {math.MinInt8, math.MaxInt8},
+	api.ValueType_SINT16: {math.MinInt16, math.MaxInt16},
+	api.ValueType_SINT32: {math.MinInt32, math.MaxInt32},
+	api.ValueType_SINT64: {math.MinInt64, math.MaxInt64},
+	api.ValueType_UINT8:  {0, math.MaxUint8},
+	api.ValueType_UINT16: {0, math.MaxUint16},
+	api.ValueType_UINT32: {0, math.MaxUint32},
+	api.ValueType_UINT64: {0, math.MaxUint64},
+}
+
+// convertIntegerValue converts an integer value to another integer type in
+// place. The value is left unchanged if it can't be represented by the type.
+func convertIntegerValue(value *api.Value, t api.ValueType) {
+	r, ok := integerTypeRanges[t]
+	if !ok || !isValueTypeInteger(value.GetType()) {
+		return
+	}
+
+	switch value.GetType() {
+	case api.ValueType_SINT8, api.ValueType_SINT16,
+		api.ValueType_SINT32, api.ValueType_SINT64:
+
+		v := value.GetSignedValue()
+		if v < r.min || (v > 0 && uint64(v) > r.max) {
+			return
+		}
+		if r.min == 0 {
+			value.Value = &api.Value_UnsignedValue{
+				UnsignedValue: uint64(v),
+			}
+		}
+	default:
+		v := value.GetUnsignedValue()
+		if v > r.max {
+			return
+		}
+		if r.min < 0 {
+			value.Value = &api.Value_SignedValue{
+				SignedValue: int64(v),
+			}
+		}
+	}
+	value.Type = t
+}
+
+// convertIntegerValues converts integer values in an expression tree to the
+// types of the integers that they are compared with or masked against. The
+// tree is modified in place. The type of the expression is returned, or 0 if
+// it can't be determined.
+func convertIntegerValues(expr *api.Expression, types FieldTypeMap) api.ValueType {
+	switch op := expr.GetType(); op {
+	case api.Expression_IDENTIFIER:
+		return api.ValueType(types[expr.GetIdentifier()])
+	case api.Expression_VALUE:
+		return expr.GetValue().GetType()
+	case api.Expression_LOGICAL_AND, api.Expression_LOGICAL_OR:
+		operands := expr.GetBinaryOp()
+		convertIntegerValues(operands.Lhs, types)
+		convertIntegerValues(operands.Rhs, types)
+		return api.ValueType_BOOL
+	case api.Expression_IS_NULL, api.Expression_IS_NOT_NULL:
+		convertIntegerValues(expr.GetUnaryOp(), types)
+		return api.ValueType_BOOL
+	case api.Expression_EQ, api.Expression_NE,
+		api.Expression_LT, api.Expression_LE,
+		api.Expression_GT, api.Expression_GE,
+		api.Expression_BITWISE_AND:
+
+		operands := expr.GetBinaryOp()
+		lhs := convertIntegerValues(operands.Lhs, types)
+		rhs := convertIntegerValues(operands.Rhs, types)
+		if lhs != rhs && isValueTypeInteger(lhs) && isValueTypeInteger(rhs) {
+			if operands.Rhs.GetType() == api.Expression_VALUE {
+				convertIntegerValue(operands.Rhs.GetValue(), lhs)
+			} else if operands.Lhs.GetType() == api.Expression_VALUE {
+				convertIntegerValue(operands.Lhs.GetValue(), rhs)
+				lhs = operands.Lhs.GetValue().GetType()
+			}
+		}
+		if op == api.Expression_BITWISE_AND {
+			return lhs
+		}
+		return api.ValueType_BOOL
+	}
+
+	return 0
+}
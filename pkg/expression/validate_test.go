@@ -187,3 +187,60 @@ func TestExpressionValidation(t *testing.T) {
 			Equal(Identifier("address"), Value("127.0.0.1"))))
 	testValidateExpr(t, expr, true, true, true, types)
 }
+
+func TestConvertIntegerValues(t *testing.T) {
+	types := FieldTypeMap{
+		"id":      int32(api.ValueType_SINT64),
+		"backlog": int32(api.ValueType_UINT64),
+		"flags":   int32(api.ValueType_UINT32),
+		"name":    int32(api.ValueType_STRING),
+	}
+
+	valid := []*api.Expression{
+		Equal(Identifier("id"), Value(uint64(37))),
+		Equal(Value(int32(37)), Identifier("id")),
+		GreaterThan(Identifier("backlog"), Value(int32(5))),
+		NotEqual(
+			BitwiseAnd(Identifier("flags"), Value(int64(4))),
+			Value(uint8(0))),
+		LogicalAnd(
+			Equal(Identifier("id"), Value(uint32(1))),
+			Equal(Identifier("backlog"), Value(int16(0)))),
+	}
+	for _, tree := range valid {
+		expr, err := NewExpression(tree)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = expr.Validate(types); err == nil {
+			t.Errorf("%s: expected type mismatch before conversion", expr)
+		}
+		converted := expr.ConvertIntegerValues(types)
+		if err = converted.Validate(types); err != nil {
+			t.Errorf("%s: %s", converted, err)
+		}
+		if converted.KernelFilterString() != expr.KernelFilterString() {
+			t.Errorf("Kernel filter changed from %q to %q",
+				expr.KernelFilterString(),
+				converted.KernelFilterString())
+		}
+	}
+
+	// Values that can't be represented by the field's type are left alone
+	invalid := []*api.Expression{
+		Equal(Identifier("backlog"), Value(int32(-1))),
+		Equal(Identifier("id"), Value(uint64(1<<63))),
+		Equal(Identifier("flags"), Value(uint64(1<<32))),
+		Equal(Identifier("name"), Value(int32(1))),
+	}
+	for _, tree := range invalid {
+		expr, err := NewExpression(tree)
+		if err != nil {
+			t.Fatal(err)
+		}
+		converted := expr.ConvertIntegerValues(types)
+		if err = converted.Validate(types); err == nil {
+			t.Errorf("%s: expected type mismatch", converted)
+		}
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/config"
+	"github.com/capsule8/capsule8/pkg/stream"
+	"github.com/golang/glog"
+)
+
+// Number of random bytes to generate for acked subscription IDs
+const ackSubscriptionIDLengthBytes = 16
+
+// ackEntry is an event that has not yet been acknowledged by the client.
+type ackEntry struct {
+	ack   uint64
+	event *api.TelemetryEvent
+	sent  time.Time // zero if the event needs to be (re-)sent
+	acked bool
+}
+
+// ackConn is a client connection attached to an ackSubscription. Responses
+// for the connection are sent on out; out is closed when the connection is
+// detached by the subscription.
+type ackConn struct {
+	out chan *api.GetEventsResponse
+}
+
+// ackSubscription is a subscription with at-least-once delivery. Events from
+// the subscription's event stream are held in a bounded retransmit buffer
+// until the client acknowledges them. Unacknowledged events are re-sent after
+// a timeout or when a client reconnects to the subscription. A subscription
+// with no attached client is closed after a timeout.
+type ackSubscription struct {
+	id          string
+	eventStream *stream.Stream
+
+	attachCh chan *ackConn
+	detachCh chan *ackConn
+	ackCh    chan [][]byte
+	done     chan struct{}
+
+	// Owned by the run goroutine
+	conn     *ackConn
+	detached time.Time
+	nextAck  uint64
+	entries  []*ackEntry
+	unacked  map[uint64]*ackEntry
+}
+
+func newAckSubscription(eventStream *stream.Stream) *ackSubscription {
+	randomBytes := make([]byte, ackSubscriptionIDLengthBytes)
+	rand.Read(randomBytes)
+
+	return &ackSubscription{
+		id:          hex.EncodeToString(randomBytes),
+		eventStream: eventStream,
+		attachCh:    make(chan *ackConn),
+		detachCh:    make(chan *ackConn),
+		ackCh:       make(chan [][]byte),
+		done:        make(chan struct{}),
+		detached:    time.Now(),
+		unacked:     make(map[uint64]*ackEntry),
+	}
+}
+
+func encodeAck(ack uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, ack)
+	return b
+}
+
+func decodeAck(b []byte) (uint64, bool) {
+	if len(b) != 8 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(b), true
+}
+
+// attach attaches a new client connection to the subscription, replacing
+// any currently attached connection. It returns nil if the subscription has
+// ended.
+func (as *ackSubscription) attach() *ackConn {
+	c := &ackConn{
+		out: make(chan *api.GetEventsResponse,
+			config.Sensor.AckBufferLength),
+	}
+	select {
+	case as.attachCh <- c:
+		return c
+	case <-as.done:
+		return nil
+	}
+}
+
+// detach detaches a client connection from the subscription.
+func (as *ackSubscription) detach(c *ackConn) {
+	select {
+	case as.detachCh <- c:
+	case <-as.done:
+	}
+}
+
+// acknowledge releases the events with the given acks from the retransmit
+// buffer.
+func (as *ackSubscription) acknowledge(acks [][]byte) {
+	if len(acks) == 0 {
+		return
+	}
+	select {
+	case as.ackCh <- acks:
+	case <-as.done:
+	}
+}
+
+// send queues an event for sending on the attached connection, if any. It
+// does not block; events that cannot be queued will be retried later.
+func (as *ackSubscription) send(e *ackEntry, now time.Time) {
+	if as.conn == nil {
+		return
+	}
+
+	r := &api.GetEventsResponse{
+		Events: []*api.ReceivedTelemetryEvent{
+			&api.ReceivedTelemetryEvent{
+				Event: e.event,
+				Ack:   encodeAck(e.ack),
+			},
+		},
+	}
+	select {
+	case as.conn.out <- r:
+		e.sent = now
+	default:
+	}
+}
+
+func (as *ackSubscription) retransmit(now time.Time) {
+	// Prune acknowledged events while walking the buffer
+	entries := as.entries[:0]
+	for _, e := range as.entries {
+		if e.acked {
+			continue
+		}
+		entries = append(entries, e)
+		if now.Sub(e.sent) >= config.Sensor.AckTimeout {
+			as.send(e, now)
+		}
+	}
+	for i := len(entries); i < len(as.entries); i++ {
+		as.entries[i] = nil
+	}
+	as.entries = entries
+}
+
+func (as *ackSubscription) setConn(c *ackConn) {
+	if as.conn != nil {
+		close(as.conn.out)
+	}
+	as.conn = c
+	if c == nil {
+		as.detached = time.Now()
+	}
+}
+
+func (as *ackSubscription) run(unregister func(*ackSubscription)) {
+	data := as.eventStream.Data
+	defer func() {
+		unregister(as)
+		close(as.done)
+		as.setConn(nil)
+		as.eventStream.Close()
+
+		// Drain whatever is left so that the stream can shut down
+		if data != nil {
+			go func() {
+				for range data {
+				}
+			}()
+		}
+	}()
+
+	interval := config.Sensor.AckTimeout / 4
+	if interval <= 0 {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		in := data
+		if len(as.unacked) >= config.Sensor.AckBufferLength {
+			// The retransmit buffer is full. Stop taking new
+			// events until some are acknowledged.
+			in = nil
+		}
+
+		select {
+		case ev, ok := <-in:
+			if ok {
+				as.nextAck++
+				e := &ackEntry{
+					ack:   as.nextAck,
+					event: ev.(*api.TelemetryEvent),
+				}
+				as.entries = append(as.entries, e)
+				as.unacked[e.ack] = e
+				as.send(e, time.Now())
+			} else {
+				glog.V(2).Infof("Acked subscription %s event stream closed",
+					as.id)
+				data = nil
+			}
+
+		case acks := <-as.ackCh:
+			for _, b := range acks {
+				ack, ok := decodeAck(b)
+				if !ok {
+					continue
+				}
+				if e, ok := as.unacked[ack]; ok {
+					e.acked = true
+					delete(as.unacked, ack)
+				}
+			}
+
+		case c := <-as.attachCh:
+			as.setConn(c)
+
+			// Everything outstanding is redelivered on the new
+			// connection.
+			now := time.Now()
+			for _, e := range as.entries {
+				e.sent = time.Time{}
+			}
+			as.retransmit(now)
+
+		case c := <-as.detachCh:
+			if c == as.conn {
+				as.setConn(nil)
+			}
+
+		case now := <-ticker.C:
+			if as.conn == nil &&
+				now.Sub(as.detached) >= config.Sensor.AckResumeTimeout {
+				glog.V(1).Infof("Acked subscription %s expired",
+					as.id)
+				return
+			}
+			as.retransmit(now)
+		}
+
+		if data == nil && len(as.unacked) == 0 {
+			// Everything in the subscription has been delivered
+			return
+		}
+	}
+}
+
+// ackSubscriptionMap is the set of active acked subscriptions, indexed by ID.
+type ackSubscriptionMap struct {
+	sync.Mutex
+	subscriptions map[string]*ackSubscription
+}
+
+func newAckSubscriptionMap() *ackSubscriptionMap {
+	return &ackSubscriptionMap{
+		subscriptions: make(map[string]*ackSubscription),
+	}
+}
+
+// start registers a new acked subscription and starts it running.
+func (m *ackSubscriptionMap) start(as *ackSubscription) {
+	m.Lock()
+	m.subscriptions[as.id] = as
+	m.Unlock()
+
+	go as.run(m.remove)
+}
+
+func (m *ackSubscriptionMap) lookup(id string) *ackSubscription {
+	m.Lock()
+	defer m.Unlock()
+	return m.subscriptions[id]
+}
+
+func (m *ackSubscriptionMap) remove(as *ackSubscription) {
+	m.Lock()
+	delete(m.subscriptions, as.id)
+	m.Unlock()
+}
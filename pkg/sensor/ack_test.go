@@ -0,0 +1,87 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/stream"
+)
+
+func receiveAckResponse(t *testing.T, c *ackConn) *api.ReceivedTelemetryEvent {
+	select {
+	case r, ok := <-c.out:
+		if !ok {
+			t.Fatal("Connection closed unexpectedly")
+		}
+		if len(r.Events) != 1 {
+			t.Fatalf("Expected 1 event, got %d", len(r.Events))
+		}
+		return r.Events[0]
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for event")
+	}
+	return nil
+}
+
+func TestAckSubscription(t *testing.T) {
+	ctrl := make(chan interface{})
+	data := make(chan interface{})
+	m := newAckSubscriptionMap()
+	as := newAckSubscription(&stream.Stream{Ctrl: ctrl, Data: data})
+	m.start(as)
+
+	if m.lookup(as.id) != as {
+		t.Fatal("Subscription not found")
+	}
+
+	c := as.attach()
+	data <- &api.TelemetryEvent{Id: "one"}
+	data <- &api.TelemetryEvent{Id: "two"}
+
+	one := receiveAckResponse(t, c)
+	two := receiveAckResponse(t, c)
+	if one.Event.Id != "one" || two.Event.Id != "two" {
+		t.Fatalf("Unexpected events %s, %s", one.Event.Id, two.Event.Id)
+	}
+	as.acknowledge([][]byte{one.Ack})
+
+	// Reconnecting redelivers only unacknowledged events
+	as.detach(c)
+	c = as.attach()
+	if r := receiveAckResponse(t, c); r.Event.Id != "two" {
+		t.Fatalf("Expected redelivery of two, got %s", r.Event.Id)
+	}
+
+	// The subscription ends once the stream is closed and every event
+	// has been acknowledged.
+	close(data)
+	as.acknowledge([][]byte{two.Ack})
+
+	select {
+	case <-as.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Subscription did not end")
+	}
+	if _, ok := <-c.out; ok {
+		t.Error("Expected connection to be closed")
+	}
+	if m.lookup(as.id) != nil {
+		t.Error("Subscription was not removed")
+	}
+}
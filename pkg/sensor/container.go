@@ -15,6 +15,7 @@
 package sensor
 
 import (
+	"fmt"
 	"reflect"
 	"sync"
 	"unicode"
@@ -233,6 +234,17 @@ func (cc *ContainerCache) LookupContainer(containerID string, create bool) *Cont
 	return info
 }
 
+// ForEachContainer calls f for each container in the cache. The cache is
+// locked while f is called, so f must not call back into the cache.
+func (cc *ContainerCache) ForEachContainer(f func(*ContainerInfo)) {
+	cc.Lock()
+	defer cc.Unlock()
+
+	for _, info := range cc.cache {
+		f(info)
+	}
+}
+
 func (cc *ContainerCache) enqueueContainerEvent(
 	eventID uint64,
 	sampleID perf.SampleID,
@@ -402,21 +414,36 @@ func (info *ContainerInfo) Update(
 	}
 }
 
+// parseContainerEventFilter checks a container event filter.
+func parseContainerEventFilter(cef *api.ContainerEventFilter) (*parsedFilter, error) {
+	_, ok := api.ContainerEventType_name[int32(cef.Type)]
+	if !ok || cef.Type == api.ContainerEventType_CONTAINER_EVENT_TYPE_UNKNOWN {
+		return nil, fmt.Errorf("Invalid container event type %s", cef.Type)
+	}
+
+	// Container events are not kernel events, so they are only filtered
+	// by the sensor.
+	return newParsedFilter(nil, nil, cef.FilterExpression, false,
+		containerEventTypes)
+}
+
 func registerContainerEvents(
 	sensor *Sensor,
 	eventMap subscriptionMap,
 	events []*api.ContainerEventFilter,
 ) {
 	var (
-		filters       [6]*api.Expression
-		subscriptions [6]*subscription
+		filters       = make(map[api.ContainerEventType]*api.Expression)
+		subscriptions = make(map[api.ContainerEventType]*subscription)
 	)
 
 	for _, cef := range events {
-		t := cef.Type
-		if t < 1 || t > 5 {
+		if _, err := parseContainerEventFilter(cef); err != nil {
+			glog.V(1).Infof("Invalid container event filter: %s", err)
 			continue
 		}
+
+		t := cef.Type
 		if subscriptions[t] == nil {
 			var eventID uint64
 			switch t {
@@ -430,27 +457,22 @@ func registerContainerEvents(
 				eventID = sensor.ContainerCache.ContainerDestroyedEventID
 			case api.ContainerEventType_CONTAINER_EVENT_TYPE_UPDATED:
 				eventID = sensor.ContainerCache.ContainerUpdatedEventID
+			default:
+				continue
 			}
 			subscriptions[t] = eventMap.subscribe(eventID)
 		}
 		filters[t] = expression.LogicalOr(filters[t], cef.FilterExpression)
 	}
 
-	for i, s := range subscriptions {
-		if filters[i] == nil {
+	for t, s := range subscriptions {
+		if filters[t] == nil {
 			// No filter, no problem
 			continue
 		}
 
-		expr, err := expression.NewExpression(filters[i])
-		if err != nil {
-			// Bad filter. Remove subscription
-			glog.V(1).Infof("Invalid container filter expression: %s", err)
-			eventMap.unsubscribe(s.eventID)
-			continue
-		}
-
-		err = expr.Validate(containerEventTypes)
+		expr, err := parseFilterExpression(filters[t], false,
+			containerEventTypes)
 		if err != nil {
 			// Bad filter. Remove subscription
 			glog.V(1).Infof("Invalid container filter expression: %s", err)
@@ -553,31 +575,68 @@ func (c *containerFilter) FilterFunc(i interface{}) bool {
 	}
 
 	switch e.Event.(type) {
+	case *api.TelemetryEvent_LostEvents, *api.TelemetryEvent_DroppedEvents:
+		// Lost and dropped events cannot be attributed to a
+		// container, so they are always delivered.
+		return true
+
 	case *api.TelemetryEvent_Container:
 		cev := e.GetContainer()
+		return c.filterIdentifiers(e.ContainerId, cev.Name,
+			cev.ImageId, cev.ImageName)
 
-		//
-		// Slow path: Check if other identifiers are in maps. If they
-		// are, add the containerId to containerIds map to take fast
-		// path next time.
-		//
+	case *api.TelemetryEvent_ContainerMetrics:
+		cme := e.GetContainerMetrics()
+		return c.filterIdentifiers(e.ContainerId, cme.Name,
+			cme.ImageId, cme.ImageName)
+	}
 
-		if c.containerNames[cev.Name] {
-			c.addContainerID(e.ContainerId)
-			return true
-		}
+	return false
+}
+
+func (c *containerFilter) filterIdentifiers(
+	containerID, name, imageID, imageName string,
+) bool {
+	//
+	// Slow path: Check if other identifiers are in maps. If they
+	// are, add the containerId to containerIds map to take fast
+	// path next time.
+	//
+
+	if c.containerNames[name] {
+		c.addContainerID(containerID)
+		return true
+	}
 
-		if c.imageIds[cev.ImageId] {
-			c.addContainerID(e.ContainerId)
-			return true
+	if c.imageIds[imageID] {
+		c.addContainerID(containerID)
+		return true
+	}
+
+	if c.imageGlobs != nil && imageName != "" {
+		for _, g := range c.imageGlobs {
+			if g.Match(imageName) {
+				c.addContainerID(containerID)
+				return true
+			}
 		}
+	}
+
+	return false
+}
+
+// matchContainer returns true if the container is matched by the filter.
+// Unlike FilterFunc, it does not update the filter.
+func (c *containerFilter) matchContainer(info *ContainerInfo) bool {
+	if c.containerIds[info.ID] || c.containerNames[info.Name] ||
+		c.imageIds[info.ImageID] {
+		return true
+	}
 
-		if c.imageGlobs != nil && cev.ImageName != "" {
-			for _, g := range c.imageGlobs {
-				if g.Match(cev.ImageName) {
-					c.addContainerID(e.ContainerId)
-					return true
-				}
+	if info.ImageName != "" {
+		for _, g := range c.imageGlobs {
+			if g.Match(info.ImageName) {
+				return true
 			}
 		}
 	}
@@ -0,0 +1,224 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"fmt"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/stream"
+	"github.com/capsule8/capsule8/pkg/sys"
+	"github.com/golang/glog"
+)
+
+type containerMetrics struct {
+	ctrl     chan interface{}
+	data     chan interface{}
+	sensor   *Sensor
+	filter   *containerFilter
+	interval time.Duration
+}
+
+// containerMetricsTarget is a snapshot of the information needed to read
+// the metrics for a container, taken while the container cache is locked.
+type containerMetricsTarget struct {
+	id        string
+	name      string
+	imageID   string
+	imageName string
+	pid       int
+}
+
+func newPressureStall(ps *sys.PressureStall) *api.PressureStall {
+	if ps == nil {
+		return nil
+	}
+
+	newAverages := func(a sys.PressureStallAverages) *api.PressureStallAverages {
+		return &api.PressureStallAverages{
+			Avg10:       a.Avg10,
+			Avg60:       a.Avg60,
+			Avg300:      a.Avg300,
+			TotalMicros: a.Total,
+		}
+	}
+	return &api.PressureStall{
+		Some: newAverages(ps.Some),
+		Full: newAverages(ps.Full),
+	}
+}
+
+func (m *containerMetrics) newContainerMetricsEvent(
+	t containerMetricsTarget,
+	stats *sys.CgroupStats,
+) *api.TelemetryEvent {
+	e := m.sensor.NewEventFromContainer(t.id)
+	e.Event = &api.TelemetryEvent_ContainerMetrics{
+		ContainerMetrics: &api.ContainerMetricsEvent{
+			Name:                t.name,
+			ImageId:             t.imageID,
+			ImageName:           t.imageName,
+			CgroupVersion:       uint32(stats.Version),
+			CpuUsageNanos:       stats.CPUUsage,
+			CpuUserNanos:        stats.CPUUser,
+			CpuSystemNanos:      stats.CPUSystem,
+			CpuPeriods:          stats.CPUPeriods,
+			CpuThrottledPeriods: stats.CPUThrottledPeriods,
+			CpuThrottledNanos:   stats.CPUThrottledTime,
+			MemoryUsageBytes:    stats.MemoryUsage,
+			MemoryLimitBytes:    stats.MemoryLimit,
+			MemoryOomKills:      stats.MemoryOOMKills,
+			PidsCurrent:         stats.PidsCurrent,
+			PidsMax:             stats.PidsMax,
+			IoReadBytes:         stats.IOReadBytes,
+			IoWriteBytes:        stats.IOWriteBytes,
+			IoReadOps:           stats.IOReadOps,
+			IoWriteOps:          stats.IOWriteOps,
+			CpuPressure:         newPressureStall(stats.CPUPressure),
+			MemoryPressure:      newPressureStall(stats.MemoryPressure),
+			IoPressure:          newPressureStall(stats.IOPressure),
+		},
+	}
+
+	return e
+}
+
+// targets returns the running containers matching the subscription's
+// container filter. Containers for which the runtime did not report an init
+// process are found via the tasks in the process info cache.
+func (m *containerMetrics) targets() []containerMetricsTarget {
+	var targets []containerMetricsTarget
+	missingPids := false
+
+	m.sensor.ContainerCache.ForEachContainer(func(info *ContainerInfo) {
+		if info.State != ContainerStateRunning &&
+			info.State != ContainerStateUnknown {
+			return
+		}
+		if m.filter != nil && !m.filter.matchContainer(info) {
+			return
+		}
+		targets = append(targets, containerMetricsTarget{
+			id:        info.ID,
+			name:      info.Name,
+			imageID:   info.ImageID,
+			imageName: info.ImageName,
+			pid:       info.Pid,
+		})
+		if info.Pid == 0 {
+			missingPids = true
+		}
+	})
+
+	if missingPids {
+		pids := make(map[string]int)
+		m.sensor.ProcessCache.ForEachTask(func(t *Task) {
+			if len(t.ContainerID) > 0 {
+				pids[t.ContainerID] = t.PID
+			}
+		})
+		for i := range targets {
+			if targets[i].pid == 0 {
+				targets[i].pid = pids[targets[i].id]
+			}
+		}
+	}
+
+	return targets
+}
+
+func (m *containerMetrics) collect() []*api.TelemetryEvent {
+	procFS := sys.HostProcFS()
+
+	var events []*api.TelemetryEvent
+	for _, t := range m.targets() {
+		if t.pid == 0 {
+			continue
+		}
+
+		cgroups, err := procFS.Cgroups(t.pid)
+		if err != nil {
+			glog.V(2).Infof("Couldn't get cgroups for container %s: %s",
+				t.id, err)
+			continue
+		}
+		stats, err := sys.ReadCgroupStats(cgroups)
+		if err != nil {
+			glog.V(2).Infof("Couldn't read cgroup stats for container %s: %s",
+				t.id, err)
+			continue
+		}
+
+		events = append(events, m.newContainerMetricsEvent(t, stats))
+	}
+
+	return events
+}
+
+// parseContainerMetricsEventFilter checks a container metrics event filter.
+func parseContainerMetricsEventFilter(filter *api.ContainerMetricsEventFilter) (*parsedFilter, error) {
+	if filter.Interval <= 0 {
+		return nil, fmt.Errorf("Invalid container metrics interval %d",
+			filter.Interval)
+	}
+	return &parsedFilter{}, nil
+}
+
+func newContainerMetricsSource(
+	sensor *Sensor,
+	filter *api.ContainerMetricsEventFilter,
+	cf *api.ContainerFilter,
+) (*stream.Stream, error) {
+	if _, err := parseContainerMetricsEventFilter(filter); err != nil {
+		return nil, err
+	}
+
+	m := &containerMetrics{
+		ctrl:     make(chan interface{}),
+		data:     make(chan interface{}),
+		sensor:   sensor,
+		interval: time.Duration(filter.Interval),
+	}
+	if cf != nil {
+		m.filter = newContainerFilter(cf)
+	}
+
+	go func() {
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case _, ok := <-m.ctrl:
+				if !ok {
+					close(m.data)
+					return
+				}
+
+			case <-ticker.C:
+				for _, ev := range m.collect() {
+					m.data <- ev
+				}
+			}
+		}
+	}()
+
+	return &stream.Stream{
+		Ctrl: m.ctrl,
+		Data: m.data,
+	}, nil
+}
@@ -133,3 +133,77 @@ func TestFilterContainerNames(t *testing.T) {
 		t.Error("Unexpected matching container name found for bill")
 	}
 }
+
+func TestMatchContainer(t *testing.T) {
+	cf := newContainerFilter(&api.ContainerFilter{
+		Ids:        []string{"alice"},
+		Names:      []string{"bob"},
+		ImageNames: []string{"busy*"},
+	})
+
+	matches := []*ContainerInfo{
+		&ContainerInfo{ID: "alice"},
+		&ContainerInfo{ID: "pass", Name: "bob"},
+		&ContainerInfo{ID: "pass", ImageName: "busybox"},
+	}
+	for _, info := range matches {
+		if !cf.matchContainer(info) {
+			t.Errorf("No match found for %+v", info)
+		}
+	}
+
+	if cf.matchContainer(&ContainerInfo{ID: "fail", ImageName: "alpine"}) {
+		t.Error("Unexpected match found for fail")
+	}
+	if cf.containerIds["pass"] {
+		t.Error("Unexpected update of container filter")
+	}
+}
+
+func TestFilterLostEvents(t *testing.T) {
+	cf := newContainerFilter(&api.ContainerFilter{
+		Ids: []string{
+			"alice",
+		},
+	})
+
+	if match := cf.FilterFunc(&api.TelemetryEvent{
+		Event: &api.TelemetryEvent_LostEvents{
+			LostEvents: &api.LostEventsEvent{
+				Count: 8,
+			},
+		},
+	}); !match {
+		t.Error("Expected lost events to pass the container filter")
+	}
+}
+
+func TestFilterContainerMetrics(t *testing.T) {
+	cf := newContainerFilter(&api.ContainerFilter{
+		Names: []string{
+			"alice",
+		},
+	})
+
+	if match := cf.FilterFunc(&api.TelemetryEvent{
+		ContainerId: "pass",
+		Event: &api.TelemetryEvent_ContainerMetrics{
+			ContainerMetrics: &api.ContainerMetricsEvent{
+				Name: "alice",
+			},
+		},
+	}); !match {
+		t.Error("No matching container metrics found for alice")
+	}
+
+	if match := cf.FilterFunc(&api.TelemetryEvent{
+		ContainerId: "fail",
+		Event: &api.TelemetryEvent_ContainerMetrics{
+			ContainerMetrics: &api.ContainerMetricsEvent{
+				Name: "bill",
+			},
+		},
+	}); match {
+		t.Error("Unexpected matching container metrics found for bill")
+	}
+}
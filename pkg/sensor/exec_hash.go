@@ -0,0 +1,284 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// The maximum number of executable hashes retained
+	maxExecutableHashes = 4096
+
+	// The maximum number of execs waiting for their executables to be
+	// opened, and of executables waiting to be hashed
+	executableHashQueueLength = 256
+
+	// The maximum number of execs waiting for the same executable to be
+	// hashed
+	maxExecutableHashWaiters = 64
+)
+
+// executableKey identifies a version of an executable file. If any of these
+// change, the file must be hashed again.
+type executableKey struct {
+	dev   uint64
+	ino   uint64
+	mtime int64
+}
+
+// executableHash is the information about the executable of an exec. It is
+// reported once the executable has been hashed.
+type executableHash struct {
+	pid       int
+	processID string
+	filename  string
+
+	size   uint64
+	inode  uint64
+	mtime  int64
+	sha256 string
+}
+
+type executableHashRequest struct {
+	key  executableKey
+	file *os.File
+}
+
+// executableHasher computes the SHA-256 of executed files in the background
+// and caches the results by device, inode, and modification time. The
+// executable of each exec is opened via /proc/<pid>/exe, so it is the file
+// that was actually executed rather than whatever the exec'd path names by
+// the time it is opened. All file access is done by the hasher's goroutines;
+// nothing is done on the sample dispatch path other than queuing the exec.
+type executableHasher struct {
+	sync.Mutex
+	hashes  map[executableKey]string
+	waiters map[executableKey][]executableHash
+
+	procRoot string
+	report   func(executableHash)
+
+	execs    chan executableHash
+	requests chan executableHashRequest
+}
+
+// newExecutableHasher creates a new executableHasher that reports the hashes
+// of executables with report. report is called from the hasher's goroutines.
+func newExecutableHasher(procRoot string, report func(executableHash)) *executableHasher {
+	h := &executableHasher{
+		hashes:   make(map[executableKey]string),
+		waiters:  make(map[executableKey][]executableHash),
+		procRoot: procRoot,
+		report:   report,
+		execs:    make(chan executableHash, executableHashQueueLength),
+		requests: make(chan executableHashRequest, executableHashQueueLength),
+	}
+	go h.openExecutables()
+	go h.hashExecutables()
+	return h
+}
+
+// stop stops the background goroutines.
+func (h *executableHasher) stop() {
+	close(h.execs)
+}
+
+// exec queues the executable of a task that has exec'd to be hashed. It never
+// blocks; if the queue is full, the exec is not reported.
+func (h *executableHasher) exec(pid int, processID, filename string) {
+	select {
+	case h.execs <- executableHash{
+		pid:       pid,
+		processID: processID,
+		filename:  filename,
+	}:
+	default:
+		glog.V(2).Infof("Executable hash queue full; dropping exec of %s",
+			filename)
+	}
+}
+
+func (h *executableHasher) openExecutables() {
+	defer close(h.requests)
+
+	for e := range h.execs {
+		// The executable must be opened before the task exits or
+		// execs again, so this goroutine never waits for hashing.
+		path := filepath.Join(h.procRoot, fmt.Sprintf("%d/exe", e.pid))
+		f, err := os.Open(path)
+		if err != nil {
+			glog.V(2).Infof("Couldn't open executable %s: %s", path, err)
+			continue
+		}
+
+		fi, err := f.Stat()
+		if err != nil {
+			glog.V(2).Infof("Couldn't stat executable %s: %s", path, err)
+			f.Close()
+			continue
+		}
+		st, ok := fi.Sys().(*syscall.Stat_t)
+		if !ok {
+			f.Close()
+			continue
+		}
+
+		key := executableKey{
+			dev:   uint64(st.Dev),
+			ino:   uint64(st.Ino),
+			mtime: fi.ModTime().UnixNano(),
+		}
+		e.size = uint64(fi.Size())
+		e.inode = key.ino
+		e.mtime = key.mtime
+
+		h.Lock()
+		if hash, ok := h.hashes[key]; ok {
+			h.Unlock()
+			f.Close()
+			e.sha256 = hash
+			h.report(e)
+			continue
+		}
+		waiters, pending := h.waiters[key]
+		if len(waiters) < maxExecutableHashWaiters {
+			h.waiters[key] = append(waiters, e)
+		}
+		h.Unlock()
+
+		if pending {
+			f.Close()
+			continue
+		}
+
+		select {
+		case h.requests <- executableHashRequest{key: key, file: f}:
+		default:
+			// The queue is full. Try again on the next exec.
+			h.Lock()
+			delete(h.waiters, key)
+			h.Unlock()
+			f.Close()
+		}
+	}
+}
+
+func (h *executableHasher) hashExecutables() {
+	for r := range h.requests {
+		hash := sha256.New()
+		_, err := io.Copy(hash, r.file)
+		r.file.Close()
+
+		h.Lock()
+		waiters := h.waiters[r.key]
+		delete(h.waiters, r.key)
+		if err != nil {
+			h.Unlock()
+			glog.V(2).Infof("Couldn't hash executable: %s", err)
+			continue
+		}
+		if len(h.hashes) >= maxExecutableHashes {
+			for k := range h.hashes {
+				delete(h.hashes, k)
+				break
+			}
+		}
+		sum := hex.EncodeToString(hash.Sum(nil))
+		h.hashes[r.key] = sum
+		h.Unlock()
+
+		for _, e := range waiters {
+			e.sha256 = sum
+			h.report(e)
+		}
+	}
+}
+
+var execHashedEventTypes = expression.FieldTypeMap{
+	"filename":    int32(api.ValueType_STRING),
+	"sha256":      int32(api.ValueType_STRING),
+	"size":        int32(api.ValueType_UINT64),
+	"inode":       int32(api.ValueType_UINT64),
+	"mtime_nanos": int32(api.ValueType_SINT64),
+}
+
+func execHashedData(e executableHash) map[string]interface{} {
+	return map[string]interface{}{
+		"common_pid":  int32(e.pid),
+		"process_id":  e.processID,
+		"filename":    e.filename,
+		"sha256":      e.sha256,
+		"size":        e.size,
+		"inode":       e.inode,
+		"mtime_nanos": e.mtime,
+	}
+}
+
+// reportExecutableHash enqueues an exec hashed event for an executable that
+// has been hashed.
+func (s *Sensor) reportExecutableHash(e executableHash) {
+	sampleID := perf.SampleID{
+		Time: uint64(s.currentMonotimeNanos() + s.bootMonotimeNanos),
+		PID:  uint32(e.pid),
+		TID:  uint32(e.pid),
+	}
+	err := s.monitor.EnqueueExternalSample(s.execHashedEventID, sampleID,
+		execHashedData(e))
+	if err != nil {
+		glog.V(2).Infof("Couldn't enqueue executable hash for %d: %s",
+			e.pid, err)
+	}
+}
+
+func (s *Sensor) decodeExecHashed(
+	sample *perf.SampleRecord,
+	data perf.TraceEventSampleData,
+) (interface{}, error) {
+	ev := s.NewEventFromSample(sample, data)
+
+	// The task may have exited or its pid may have been reused, so use
+	// the process ID from the time of the exec.
+	if processID := data["process_id"].(string); len(processID) > 0 {
+		ev.ProcessId = processID
+	}
+
+	ev.Event = &api.TelemetryEvent_Process{
+		Process: &api.ProcessEvent{
+			Type:           api.ProcessEventType_PROCESS_EVENT_TYPE_EXEC_HASHED,
+			ExecFilename:   data["filename"].(string),
+			ExecSha256:     data["sha256"].(string),
+			ExecSize:       data["size"].(uint64),
+			ExecInode:      data["inode"].(uint64),
+			ExecMtimeNanos: data["mtime_nanos"].(int64),
+		},
+	}
+
+	return ev, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestExecutableHasher(t *testing.T) {
+	procRoot, err := ioutil.TempDir("", "exec_hash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(procRoot)
+
+	executable := filepath.Join(procRoot, "executable")
+	err = ioutil.WriteFile(executable, []byte("hello\n"), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pid := range []string{"42", "43"} {
+		err = os.Mkdir(filepath.Join(procRoot, pid), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.Symlink(executable, filepath.Join(procRoot, pid, "exe"))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	reports := make(chan executableHash, 2)
+	h := newExecutableHasher(procRoot, func(e executableHash) {
+		reports <- e
+	})
+	defer h.stop()
+
+	const expected = "5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03"
+	check := func(pid int, processID string) {
+		select {
+		case e := <-reports:
+			if e.pid != pid || e.processID != processID ||
+				e.filename != "/bin/hello" {
+				t.Errorf("Unexpected exec %+v", e)
+			}
+			if e.sha256 != expected {
+				t.Errorf("Expected hash %s, got %s", expected, e.sha256)
+			}
+			if e.size != 6 || e.inode == 0 || e.mtime == 0 {
+				t.Errorf("Unexpected file information %+v", e)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for hash of pid %d", pid)
+		}
+	}
+
+	// The first exec is reported once the executable has been hashed
+	h.exec(42, "process-42", "/bin/hello")
+	check(42, "process-42")
+
+	// Later execs of the same executable are reported from the cache
+	h.exec(43, "process-43", "/bin/hello")
+	check(43, "process-43")
+
+	// Execs whose executable can't be opened are not reported
+	h.exec(44, "process-44", "/bin/hello")
+	select {
+	case e := <-reports:
+		t.Errorf("Unexpected report %+v", e)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
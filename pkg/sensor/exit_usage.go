@@ -0,0 +1,186 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+	"github.com/capsule8/capsule8/pkg/sys/proc"
+
+	"github.com/golang/glog"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// The maximum number of exits waiting for their resource usage to
+	// be read
+	exitUsageQueueLength = 1024
+
+	// Maximum difference between the start time of a task reported by
+	// /proc and the time of its fork event for them to be considered the
+	// same task. /proc reports start times in clock ticks.
+	procStartTimeTolerance = 50 * time.Millisecond
+)
+
+// pendingExit is an exit event waiting for the resource usage of the exiting
+// task to be read.
+type pendingExit struct {
+	// The external event that the exit event is reported as
+	eventID uint64
+
+	event *api.TelemetryEvent
+	code  int64
+
+	tgid, tid int
+
+	// The time that the task was forked, or 0 if it's not known
+	forkTime int64
+}
+
+// exitUsageReader reads the resource usage of exiting tasks from /proc in
+// the background, so that exit events don't block the dispatch of samples.
+// Exit events are reported once their usage has been read, or immediately
+// with only their lifetime if the queue is full.
+type exitUsageReader struct {
+	fs     *proc.FileSystem
+	report func(pendingExit)
+	exits  chan pendingExit
+}
+
+// newExitUsageReader creates a new exitUsageReader that reports exit events
+// with report. report is called from the reader's goroutine, or from exit
+// when the queue is full.
+func newExitUsageReader(fs *proc.FileSystem, report func(pendingExit)) *exitUsageReader {
+	r := &exitUsageReader{
+		fs:     fs,
+		report: report,
+		exits:  make(chan pendingExit, exitUsageQueueLength),
+	}
+	go r.readExits()
+	return r
+}
+
+// stop stops the background goroutine.
+func (r *exitUsageReader) stop() {
+	close(r.exits)
+}
+
+// exit queues an exit event to have its resource usage read. It never
+// blocks.
+func (r *exitUsageReader) exit(e pendingExit) {
+	select {
+	case r.exits <- e:
+	default:
+		glog.V(2).Infof("Exit usage queue full; reporting exit of %d without usage",
+			e.tid)
+		r.report(e)
+	}
+}
+
+func (r *exitUsageReader) readExits() {
+	offset := bootTimeOffset()
+	for e := range r.exits {
+		r.readUsage(e, offset)
+		r.report(e)
+	}
+}
+
+// readUsage reads the resource usage of an exiting task into its exit event.
+// The read races with the task being reaped and its PID being reused, so the
+// usage is only filled in if the start time in /proc matches the time that
+// the task was forked. It is omitted for tasks that have already been reaped
+// and for tasks that were not forked while the sensor was running.
+func (r *exitUsageReader) readUsage(e pendingExit, offset int64) {
+	if e.forkTime == 0 {
+		return
+	}
+
+	ru, err := r.fs.ReadTaskResourceUsage(e.tgid, e.tid)
+	if err != nil {
+		glog.V(2).Infof("Couldn't read resource usage for task %d: %s",
+			e.tid, err)
+		return
+	}
+	if !sameTaskStartTime(ru.StartTime, e.forkTime, offset) {
+		glog.V(2).Infof("Ignoring resource usage for reused pid %d",
+			e.tid)
+		return
+	}
+
+	usage := e.event.GetProcess().ExitResourceUsage
+	usage.UserTimeNanos = uint64(ru.UserTime)
+	usage.SystemTimeNanos = uint64(ru.SystemTime)
+	usage.MaxRssBytes = ru.MaxRSS
+	usage.VoluntaryContextSwitches = ru.VoluntaryContextSwitches
+	usage.InvoluntaryContextSwitches = ru.InvoluntaryContextSwitches
+	usage.ReadBytes = ru.ReadBytes
+	usage.WriteBytes = ru.WriteBytes
+}
+
+// bootTimeOffset returns the difference between CLOCK_BOOTTIME, which /proc
+// uses for task start times, and CLOCK_MONOTONIC_RAW, which perf uses for
+// sample times.
+func bootTimeOffset() int64 {
+	var boot, raw unix.Timespec
+	unix.ClockGettime(unix.CLOCK_BOOTTIME, &boot)
+	unix.ClockGettime(unix.CLOCK_MONOTONIC_RAW, &raw)
+	return (boot.Sec-raw.Sec)*int64(time.Second) + (boot.Nsec - raw.Nsec)
+}
+
+// sameTaskStartTime returns true if a start time read from /proc is that of
+// a task forked at forkTime, given the offset between the two clocks.
+func sameTaskStartTime(procStartTime time.Duration, forkTime, offset int64) bool {
+	d := int64(procStartTime) - (forkTime + offset)
+	if d < 0 {
+		d = -d
+	}
+	return d <= int64(procStartTimeTolerance)
+}
+
+func exitData(e pendingExit) map[string]interface{} {
+	return map[string]interface{}{
+		"common_pid": int32(e.tid),
+		"code":       e.code,
+		"event":      e.event,
+	}
+}
+
+// reportExit enqueues the exit event of a task whose resource usage has been
+// read.
+func (s *Sensor) reportExit(e pendingExit) {
+	sampleID := perf.SampleID{
+		Time: uint64(s.currentMonotimeNanos() + s.bootMonotimeNanos),
+		PID:  uint32(e.tgid),
+		TID:  uint32(e.tid),
+	}
+	err := s.monitor.EnqueueExternalSample(e.eventID, sampleID, exitData(e))
+	if err != nil {
+		glog.V(2).Infof("Couldn't enqueue exit of %d: %s", e.tid, err)
+	}
+}
+
+// decodeExit returns the exit event reported by reportExit. The event was
+// created when the exit was sampled, so its time and process information
+// are those of the exit.
+func decodeExit(
+	sample *perf.SampleRecord,
+	data perf.TraceEventSampleData,
+) (interface{}, error) {
+	return data["event"].(*api.TelemetryEvent), nil
+}
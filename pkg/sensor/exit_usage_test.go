@@ -0,0 +1,156 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+	"github.com/capsule8/capsule8/pkg/sys/proc"
+)
+
+func TestDecodeDoExit(t *testing.T) {
+	s := &Sensor{}
+	s.ProcessCache.cache = newArrayTaskCache(32)
+	s.ProcessCache.cache.InsertTask(7, &Task{
+		PID:       7,
+		TGID:      5,
+		StartTime: 1000,
+	})
+	s.exitUsage = &exitUsageReader{
+		exits: make(chan pendingExit, 1),
+	}
+	f := processFilter{sensor: s, exitEventID: 3}
+
+	// The exit event isn't dispatched until its usage has been read
+	ev, err := f.decodeDoExit(&perf.SampleRecord{Time: 5000},
+		map[string]interface{}{"common_pid": int32(7), "code": int64(0)})
+	if ev != nil || err != nil {
+		t.Fatalf("Unexpected decode result %v, %v", ev, err)
+	}
+	e := <-s.exitUsage.exits
+	if e.eventID != 3 || e.tgid != 5 || e.tid != 7 || e.forkTime != 1000 {
+		t.Errorf("Unexpected pending exit %+v", e)
+	}
+	usage := e.event.GetProcess().ExitResourceUsage
+	if usage.LifetimeNanos != 4000 {
+		t.Errorf("Expected lifetime 4000, got %d", usage.LifetimeNanos)
+	}
+
+	// If the queue is full, the exit is reported with only its lifetime
+	var reported []pendingExit
+	s.exitUsage = &exitUsageReader{
+		exits: make(chan pendingExit),
+		report: func(e pendingExit) {
+			reported = append(reported, e)
+		},
+	}
+	f.decodeDoExit(&perf.SampleRecord{Time: 5000},
+		map[string]interface{}{"common_pid": int32(8), "code": int64(0)})
+	if len(reported) != 1 || reported[0].forkTime != 0 ||
+		reported[0].event.GetProcess().ExitResourceUsage.LifetimeNanos != 0 {
+		t.Errorf("Unexpected reported exits %+v", reported)
+	}
+}
+
+func TestExitUsageReader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "proc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// utime, stime, and starttime are fields 14, 15, and 22
+	fields := make([]string, 44)
+	for i := range fields {
+		fields[i] = "0"
+	}
+	fields[0], fields[1], fields[2] = "7", "(sh)", "S"
+	fields[13], fields[14], fields[21] = "33", "7", "8810"
+	taskDir := filepath.Join(dir, "5/task/7")
+	if err = os.MkdirAll(taskDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(taskDir, "stat"),
+		[]byte(strings.Join(fields, " ")+"\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reported := make(chan pendingExit, 3)
+	r := newExitUsageReader(&proc.FileSystem{MountPoint: dir},
+		func(e pendingExit) {
+			reported <- e
+		})
+	defer r.stop()
+
+	newExit := func(tid int, forkTime int64) pendingExit {
+		return pendingExit{
+			event: &api.TelemetryEvent{
+				Event: &api.TelemetryEvent_Process{
+					Process: &api.ProcessEvent{
+						ExitResourceUsage: &api.ResourceUsage{},
+					},
+				},
+			},
+			tgid:     5,
+			tid:      tid,
+			forkTime: forkTime,
+		}
+	}
+
+	// /proc start times are relative to CLOCK_BOOTTIME
+	forkTime := int64(88100*time.Millisecond) - bootTimeOffset()
+	r.exit(newExit(7, forkTime))
+	r.exit(newExit(7, forkTime+int64(time.Second)))
+	r.exit(newExit(8, forkTime))
+
+	usage := (<-reported).event.GetProcess().ExitResourceUsage
+	if usage.UserTimeNanos != uint64(330*time.Millisecond) ||
+		usage.SystemTimeNanos != uint64(70*time.Millisecond) {
+		t.Errorf("Unexpected usage %+v", usage)
+	}
+
+	// Usage is omitted for reused pids and tasks that have been reaped
+	for i := 0; i < 2; i++ {
+		usage = (<-reported).event.GetProcess().ExitResourceUsage
+		if usage.UserTimeNanos != 0 {
+			t.Errorf("Unexpected usage %+v", usage)
+		}
+	}
+}
+
+func TestSameTaskStartTime(t *testing.T) {
+	offset := int64(3 * time.Second)
+	forkTime := int64(10*time.Second + 123*time.Millisecond)
+
+	// /proc reports start times in clock ticks
+	if !sameTaskStartTime(13120*time.Millisecond, forkTime, offset) {
+		t.Error("Expected start times to match")
+	}
+	if sameTaskStartTime(14*time.Second, forkTime, offset) {
+		t.Error("Unexpected match for reused pid")
+	}
+	if sameTaskStartTime(10120*time.Millisecond, forkTime, offset) {
+		t.Error("Unexpected match without clock offset")
+	}
+}
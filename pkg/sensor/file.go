@@ -30,11 +30,50 @@ const (
 	fsDoSysOpenKprobeFetchargs = "filename=+0(%si):string flags=%dx:s32 mode=%cx:s32"
 )
 
-type fileOpenFilter struct {
+// fileKprobe is a kprobe used to monitor a file event type. All of the
+// kprobes for a single event type use the same field names and types so
+// that the same kernel filter can be used for all of them.
+type fileKprobe struct {
+	symbol    string
+	fetchargs string
+}
+
+var fileEventKprobes = map[api.FileEventType][]fileKprobe{
+	api.FileEventType_FILE_EVENT_TYPE_OPEN: {
+		{fsDoSysOpenKprobeAddress, fsDoSysOpenKprobeFetchargs},
+	},
+	api.FileEventType_FILE_EVENT_TYPE_UNLINK: {
+		{"sys_unlink", "filename=+0(%di):string"},
+		{"sys_unlinkat", "filename=+0(%si):string"},
+	},
+	api.FileEventType_FILE_EVENT_TYPE_RENAME: {
+		{"sys_rename", "old_filename=+0(%di):string new_filename=+0(%si):string"},
+		{"sys_renameat", "old_filename=+0(%si):string new_filename=+0(%cx):string"},
+		{"sys_renameat2", "old_filename=+0(%si):string new_filename=+0(%cx):string"},
+	},
+	api.FileEventType_FILE_EVENT_TYPE_CHMOD: {
+		{"sys_chmod", "filename=+0(%di):string mode=%si:s32"},
+		{"sys_fchmodat", "filename=+0(%si):string mode=%dx:s32"},
+	},
+	api.FileEventType_FILE_EVENT_TYPE_CHOWN: {
+		{"sys_chown", "filename=+0(%di):string uid=%si:u32 gid=%dx:u32"},
+		{"sys_lchown", "filename=+0(%di):string uid=%si:u32 gid=%dx:u32"},
+		{"sys_fchownat", "filename=+0(%si):string uid=%dx:u32 gid=%cx:u32"},
+	},
+	api.FileEventType_FILE_EVENT_TYPE_TRUNCATE: {
+		{"sys_truncate", "filename=+0(%di):string length=%si:s64"},
+	},
+	api.FileEventType_FILE_EVENT_TYPE_MKDIR: {
+		{"sys_mkdir", "filename=+0(%di):string mode=%si:s32"},
+		{"sys_mkdirat", "filename=+0(%si):string mode=%dx:s32"},
+	},
+}
+
+type fileEventFilter struct {
 	sensor *Sensor
 }
 
-func (f *fileOpenFilter) decodeDoSysOpen(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+func (f *fileEventFilter) decodeDoSysOpen(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
 	ev := f.sensor.NewEventFromSample(sample, data)
 	ev.Event = &api.TelemetryEvent_File{
 		File: &api.FileEvent{
@@ -48,24 +87,97 @@ func (f *fileOpenFilter) decodeDoSysOpen(sample *perf.SampleRecord, data perf.Tr
 	return ev, nil
 }
 
+// decoder returns a decoder for the kprobes used to monitor file events
+// other than open. Fields that are not fetched by the kprobes for the event
+// type are left unset.
+func (f *fileEventFilter) decoder(eventType api.FileEventType) perf.TraceEventDecoderFn {
+	return func(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+		fev := &api.FileEvent{
+			Type: eventType,
+		}
+		fev.Filename, _ = data["filename"].(string)
+		fev.OldFilename, _ = data["old_filename"].(string)
+		fev.NewFilename, _ = data["new_filename"].(string)
+		fev.Mode, _ = data["mode"].(int32)
+		fev.Uid, _ = data["uid"].(uint32)
+		fev.Gid, _ = data["gid"].(uint32)
+		fev.Length, _ = data["length"].(int64)
+
+		ev := f.sensor.NewEventFromSample(sample, data)
+		ev.Event = &api.TelemetryEvent_File{
+			File: fev,
+		}
+
+		return ev, nil
+	}
+}
+
+func fileFilenameExpression(
+	fef *api.FileEventFilter,
+	newExpr func(lhs, rhs *api.Expression) *api.Expression,
+	value string,
+) *api.Expression {
+	if fef.Type == api.FileEventType_FILE_EVENT_TYPE_RENAME {
+		return expression.LogicalOr(
+			newExpr(expression.Identifier("old_filename"),
+				expression.Value(value)),
+			newExpr(expression.Identifier("new_filename"),
+				expression.Value(value)))
+	}
+	return newExpr(expression.Identifier("filename"),
+		expression.Value(value))
+}
+
 func rewriteFileEventFilter(fef *api.FileEventFilter) {
 	if fef.Filename != nil {
-		newExpr := expression.Equal(
-			expression.Identifier("filename"),
-			expression.Value(fef.Filename.Value))
+		newExpr := fileFilenameExpression(fef, expression.Equal,
+			fef.Filename.Value)
 		fef.FilterExpression = expression.LogicalAnd(
 			newExpr, fef.FilterExpression)
 		fef.Filename = nil
 		fef.FilenamePattern = nil
 	} else if fef.FilenamePattern != nil {
-		newExpr := expression.Like(
-			expression.Identifier("filename"),
-			expression.Value(fef.FilenamePattern.Value))
+		newExpr := fileFilenameExpression(fef, expression.Like,
+			fef.FilenamePattern.Value)
 		fef.FilterExpression = expression.LogicalAnd(
 			newExpr, fef.FilterExpression)
 		fef.FilenamePattern = nil
 	}
 
+	if fef.OldFilename != nil {
+		newExpr := expression.Equal(
+			expression.Identifier("old_filename"),
+			expression.Value(fef.OldFilename.Value))
+		fef.FilterExpression = expression.LogicalAnd(
+			newExpr, fef.FilterExpression)
+		fef.OldFilename = nil
+		fef.OldFilenamePattern = nil
+	} else if fef.OldFilenamePattern != nil {
+		newExpr := expression.Like(
+			expression.Identifier("old_filename"),
+			expression.Value(fef.OldFilenamePattern.Value))
+		fef.FilterExpression = expression.LogicalAnd(
+			newExpr, fef.FilterExpression)
+		fef.OldFilenamePattern = nil
+	}
+
+	if fef.NewFilename != nil {
+		newExpr := expression.Equal(
+			expression.Identifier("new_filename"),
+			expression.Value(fef.NewFilename.Value))
+		fef.FilterExpression = expression.LogicalAnd(
+			newExpr, fef.FilterExpression)
+		fef.NewFilename = nil
+		fef.NewFilenamePattern = nil
+	} else if fef.NewFilenamePattern != nil {
+		newExpr := expression.Like(
+			expression.Identifier("new_filename"),
+			expression.Value(fef.NewFilenamePattern.Value))
+		fef.FilterExpression = expression.LogicalAnd(
+			newExpr, fef.FilterExpression)
+		fef.NewFilenamePattern = nil
+	}
+
 	if fef.OpenFlagsMask != nil {
 		newExpr := expression.BitwiseAnd(
 			expression.Identifier("flags"),
@@ -83,66 +195,110 @@ func rewriteFileEventFilter(fef *api.FileEventFilter) {
 			newExpr, fef.FilterExpression)
 		fef.CreateModeMask = nil
 	}
+
+	if fef.ModeMask != nil {
+		newExpr := expression.BitwiseAnd(
+			expression.Identifier("mode"),
+			expression.Value(fef.ModeMask.Value))
+		fef.FilterExpression = expression.LogicalAnd(
+			newExpr, fef.FilterExpression)
+		fef.ModeMask = nil
+	}
+
+	if fef.Uid != nil {
+		newExpr := expression.Equal(
+			expression.Identifier("uid"),
+			expression.Value(fef.Uid.Value))
+		fef.FilterExpression = expression.LogicalAnd(
+			newExpr, fef.FilterExpression)
+		fef.Uid = nil
+	}
+
+	if fef.Gid != nil {
+		newExpr := expression.Equal(
+			expression.Identifier("gid"),
+			expression.Value(fef.Gid.Value))
+		fef.FilterExpression = expression.LogicalAnd(
+			newExpr, fef.FilterExpression)
+		fef.Gid = nil
+	}
 }
 
-func registerFileEvents(sensor *Sensor, eventMap subscriptionMap, events []*api.FileEventFilter) {
-	var filterString string
+// parseFileEventFilter checks a file event filter. Deprecated fields must
+// already have been translated into the filter expression.
+func parseFileEventFilter(fef *api.FileEventFilter) (*parsedFilter, error) {
+	kprobes, ok := fileEventKprobes[fef.Type]
+	if !ok {
+		return nil, fmt.Errorf("Invalid file event type %s", fef.Type)
+	}
 
-	wildcard := false
-	filters := make(map[string]bool, len(events))
-	for _, fef := range events {
-		if fef.Type != api.FileEventType_FILE_EVENT_TYPE_OPEN {
-			continue
-		}
+	symbols := make([]string, len(kprobes))
+	for i, kprobe := range kprobes {
+		symbols[i] = kprobe.symbol
+	}
+	return newParsedFilter(nil, symbols, fef.FilterExpression, true,
+		kprobeFieldTypes(kprobes[0].fetchargs))
+}
 
+func registerFileEvents(sensor *Sensor, eventMap subscriptionMap, events []*api.FileEventFilter) {
+	wildcards := make(map[api.FileEventType]bool)
+	filters := make(map[api.FileEventType]map[string]bool)
+	for _, fef := range events {
 		// Translate deprecated fields into an expression
 		rewriteFileEventFilter(fef)
 
-		if fef.FilterExpression == nil {
-			wildcard = true
+		pf, err := parseFileEventFilter(fef)
+		if err != nil {
+			glog.V(1).Infof("Invalid file event filter: %s", err)
+			continue
+		}
+
+		if pf.expr == nil {
+			wildcards[fef.Type] = true
 		} else {
-			expr, err := expression.NewExpression(fef.FilterExpression)
-			if err != nil {
-				glog.V(1).Infof("Invalid file event filter: %s", err)
-				continue
+			if filters[fef.Type] == nil {
+				filters[fef.Type] = make(map[string]bool)
 			}
-			err = expr.ValidateKernelFilter()
-			if err != nil {
-				glog.V(1).Infof("Invalid file event filter as kernel filter: %s", err)
-				continue
-			}
-			s := expr.KernelFilterString()
-			filters[s] = true
+			filters[fef.Type][pf.kernelFilterString()] = true
 		}
 	}
 
-	if !wildcard {
-		if len(filters) == 0 {
-			return
+	f := fileEventFilter{
+		sensor: sensor,
+	}
+
+	for eventType, kprobes := range fileEventKprobes {
+		var filterString string
+
+		if !wildcards[eventType] {
+			if len(filters[eventType]) == 0 {
+				continue
+			}
+
+			parts := make([]string, 0, len(filters[eventType]))
+			for k := range filters[eventType] {
+				parts = append(parts, fmt.Sprintf("(%s)", k))
+			}
+			filterString = strings.Join(parts, " || ")
 		}
 
-		parts := make([]string, 0, len(filters))
-		for k := range filters {
-			parts = append(parts, fmt.Sprintf("(%s)", k))
+		decoder := f.decodeDoSysOpen
+		if eventType != api.FileEventType_FILE_EVENT_TYPE_OPEN {
+			decoder = f.decoder(eventType)
 		}
-		filterString = strings.Join(parts, " || ")
-	} else if len(events) == 0 {
-		return
-	}
 
-	f := fileOpenFilter{
-		sensor: sensor,
-	}
+		for _, kprobe := range kprobes {
+			eventID, err := sensor.monitor.RegisterKprobe(
+				kprobe.symbol, false,
+				kprobe.fetchargs, decoder,
+				perf.WithFilter(filterString))
+			if err != nil {
+				glog.Warningf("Couldn't register kprobe %s: %s",
+					kprobe.symbol, err)
+				continue
+			}
 
-	eventID, err := sensor.monitor.RegisterKprobe(
-		fsDoSysOpenKprobeAddress, false,
-		fsDoSysOpenKprobeFetchargs, f.decodeDoSysOpen,
-		perf.WithFilter(filterString))
-	if err != nil {
-		glog.Warning("Couldn't register kprobe %s: %s",
-			fsDoSysOpenKprobeAddress, err)
-		return
+			eventMap.subscribe(eventID)
+		}
 	}
-
-	eventMap.subscribe(eventID)
 }
@@ -0,0 +1,79 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"reflect"
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/golang/protobuf/ptypes/wrappers"
+)
+
+func TestFileEventKprobeFields(t *testing.T) {
+	// The same kernel filter is used for every kprobe for an event type,
+	// so their fields must all be the same.
+	for eventType, kprobes := range fileEventKprobes {
+		types := kprobeFieldTypes(kprobes[0].fetchargs)
+		for _, kprobe := range kprobes[1:] {
+			if !reflect.DeepEqual(kprobeFieldTypes(kprobe.fetchargs), types) {
+				t.Errorf("%s: fields for %s differ from %s",
+					eventType, kprobe.symbol, kprobes[0].symbol)
+			}
+		}
+	}
+}
+
+func TestRewriteFileEventFilter(t *testing.T) {
+	fef := &api.FileEventFilter{
+		Type:     api.FileEventType_FILE_EVENT_TYPE_RENAME,
+		Filename: &wrappers.StringValue{Value: "/etc/passwd"},
+	}
+	rewriteFileEventFilter(fef)
+
+	expr, err := expression.NewExpression(fef.FilterExpression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `old_filename == "/etc/passwd" || new_filename == "/etc/passwd"`
+	if s := expr.KernelFilterString(); s != expected {
+		t.Errorf("Expected %q, got %q", expected, s)
+	}
+
+	fef = &api.FileEventFilter{
+		Type:            api.FileEventType_FILE_EVENT_TYPE_CHOWN,
+		FilenamePattern: &wrappers.StringValue{Value: "/etc/*"},
+		Uid:             &wrappers.UInt32Value{Value: 0},
+	}
+	rewriteFileEventFilter(fef)
+	if fef.FilenamePattern != nil || fef.Uid != nil {
+		t.Error("Expected deprecated fields to be cleared")
+	}
+
+	expr, err = expression.NewExpression(fef.FilterExpression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = expr.Validate(kprobeFieldTypes(
+		fileEventKprobes[api.FileEventType_FILE_EVENT_TYPE_CHOWN][0].fetchargs))
+	if err != nil {
+		t.Error(err)
+	}
+	if err = expr.ValidateKernelFilter(); err != nil {
+		t.Error(err)
+	}
+}
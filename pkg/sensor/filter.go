@@ -0,0 +1,186 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"fmt"
+	"strings"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+)
+
+// Fields common to all trace events, including kprobes
+var commonTraceEventFieldTypes = map[string]int32{
+	"common_type":          perf.TraceEventFieldTypeUnsignedInt16,
+	"common_flags":         perf.TraceEventFieldTypeUnsignedInt8,
+	"common_preempt_count": perf.TraceEventFieldTypeUnsignedInt8,
+	"common_pid":           perf.TraceEventFieldTypeSignedInt32,
+}
+
+// Map of kprobe fetcharg types to trace event field types
+var kprobeFetchargTypes = map[string]int32{
+	"string": perf.TraceEventFieldTypeString,
+	"s8":     perf.TraceEventFieldTypeSignedInt8,
+	"s16":    perf.TraceEventFieldTypeSignedInt16,
+	"s32":    perf.TraceEventFieldTypeSignedInt32,
+	"s64":    perf.TraceEventFieldTypeSignedInt64,
+	"u8":     perf.TraceEventFieldTypeUnsignedInt8,
+	"u16":    perf.TraceEventFieldTypeUnsignedInt16,
+	"u32":    perf.TraceEventFieldTypeUnsignedInt32,
+	"u64":    perf.TraceEventFieldTypeUnsignedInt64,
+}
+
+// kprobeFieldTypes returns the types of the fields that a kprobe registered
+// with the given fetchargs would have. Fetchargs without an explicit type
+// are unsigned 64-bit integers.
+func kprobeFieldTypes(fetchargs string) expression.FieldTypeMap {
+	types := make(expression.FieldTypeMap, len(commonTraceEventFieldTypes))
+	for k, v := range commonTraceEventFieldTypes {
+		types[k] = v
+	}
+
+	for _, arg := range strings.Fields(fetchargs) {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		t := perf.TraceEventFieldTypeUnsignedInt64
+		if i := strings.LastIndex(parts[1], ":"); i >= 0 {
+			var ok bool
+			if t, ok = kprobeFetchargTypes[parts[1][i+1:]]; !ok {
+				continue
+			}
+		}
+		types[parts[0]] = t
+	}
+
+	return types
+}
+
+// tracepointFieldTypes returns the types of the fields defined for a
+// tracepoint.
+func (s *Sensor) tracepointFieldTypes(name string) (expression.FieldTypeMap, error) {
+	tracingDir := s.tracingDir()
+	if len(tracingDir) == 0 {
+		return nil, fmt.Errorf("Couldn't read format for %s: no tracing directory",
+			name)
+	}
+
+	types, err := perf.TraceEventFields(tracingDir, name)
+	if err != nil {
+		return nil, fmt.Errorf("Couldn't read format for %s: %s", name, err)
+	}
+	return expression.FieldTypeMap(types), nil
+}
+
+// parsedFilter is a subscription filter that has been checked by one of the
+// parse*Filter functions. Both registering a subscription and validating it
+// parse each of its filters in the same way.
+type parsedFilter struct {
+	// The events that the filter uses
+	tracepoints []string
+	kprobes     []string
+
+	// The filter expression, or nil if all events match
+	expr *expression.Expression
+
+	// If true, the filter expression is evaluated by the kernel
+	kernel bool
+}
+
+// kernelFilterString returns the kernel filter for the filter expression, or
+// an empty string if there is none.
+func (f *parsedFilter) kernelFilterString() string {
+	if f.expr == nil || !f.kernel {
+		return ""
+	}
+	return f.expr.KernelFilterString()
+}
+
+// parseFilterExpression parses a filter expression and checks the types used
+// in it against each of the types maps. Integer values are converted to the
+// types of the fields that they're compared with first, so that e.g. an int32
+// may be compared with a 64-bit syscall return value. The returned expression
+// uses the values converted for the first types map; the kernel filter string
+// is the same for any of them. If kernel is true, the expression must be
+// usable as a kernel filter. A nil tree parses to a nil expression.
+func parseFilterExpression(
+	tree *api.Expression,
+	kernel bool,
+	types ...expression.FieldTypeMap,
+) (*expression.Expression, error) {
+	if tree == nil {
+		return nil, nil
+	}
+
+	expr, err := expression.NewExpression(tree)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid filter expression: %s", err)
+	}
+	result := expr
+	for i, t := range types {
+		converted := expr.ConvertIntegerValues(t)
+		if err = converted.Validate(t); err != nil {
+			return nil, fmt.Errorf("Invalid filter expression: %s", err)
+		}
+		if i == 0 {
+			result = converted
+		}
+	}
+	if kernel {
+		if err = result.ValidateKernelFilter(); err != nil {
+			return nil, fmt.Errorf("Invalid filter expression as kernel filter: %s", err)
+		}
+	}
+	return result, nil
+}
+
+// newParsedFilter returns a parsedFilter for the given events with the
+// filter expression parsed by parseFilterExpression.
+func newParsedFilter(
+	tracepoints, kprobes []string,
+	tree *api.Expression,
+	kernel bool,
+	types ...expression.FieldTypeMap,
+) (*parsedFilter, error) {
+	expr, err := parseFilterExpression(tree, kernel, types...)
+	if err != nil {
+		return nil, err
+	}
+	return &parsedFilter{
+		tracepoints: tracepoints,
+		kprobes:     kprobes,
+		expr:        expr,
+		kernel:      kernel,
+	}, nil
+}
+
+// tracepointsFieldTypes returns the types of the fields of each of the
+// named tracepoints.
+func (s *Sensor) tracepointsFieldTypes(names []string) ([]expression.FieldTypeMap, error) {
+	typeMaps := make([]expression.FieldTypeMap, 0, len(names))
+	for _, name := range names {
+		types, err := s.tracepointFieldTypes(name)
+		if err != nil {
+			return nil, err
+		}
+		typeMaps = append(typeMaps, types)
+	}
+	return typeMaps, nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+)
+
+// newTestTracingDir creates a tracing directory containing formats for the
+// given trace events. Each event has the given fields, which are signed
+// 32-bit integers unless prefixed with "long" or "unsigned long".
+func newTestTracingDir(t *testing.T, events map[string][]string) string {
+	dir, err := ioutil.TempDir("", "tracing")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, fields := range events {
+		lines := []string{
+			fmt.Sprintf("name: %s", filepath.Base(name)),
+			"ID: 1",
+			"format:",
+		}
+		offset := 8
+		for _, field := range fields {
+			typeName, size, signed := "int", 4, 1
+			if i := strings.LastIndex(field, " "); i >= 0 {
+				typeName, field = field[:i], field[i+1:]
+				size = 8
+				if strings.HasPrefix(typeName, "unsigned") {
+					signed = 0
+				}
+			}
+			lines = append(lines, fmt.Sprintf(
+				"\tfield:%s %s;\toffset:%d;\tsize:%d;\tsigned:%d;",
+				typeName, field, offset, size, signed))
+			offset += size
+		}
+
+		eventDir := filepath.Join(dir, "events", name)
+		if err = os.MkdirAll(eventDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(eventDir, "format"),
+			[]byte(strings.Join(lines, "\n")+"\n"), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestTracepointFieldTypes(t *testing.T) {
+	dir := newTestTracingDir(t, map[string][]string{
+		"signal/signal_deliver": {"sig", "code"},
+	})
+	defer os.RemoveAll(dir)
+
+	s := &Sensor{traceFSMountPoint: dir}
+	types, err := s.tracepointFieldTypes("signal/signal_deliver")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if types["sig"] != perf.TraceEventFieldTypeSignedInt32 {
+		t.Errorf("Unexpected types %v", types)
+	}
+
+	if _, err = s.tracepointFieldTypes("signal/no_such_event"); err == nil {
+		t.Error("Expected error for missing format")
+	}
+
+	// A filter for an event without a format is invalid
+	_, err = parseSignalEventFilter(s, &api.SignalEventFilter{
+		Type: api.SignalEventType_SIGNAL_EVENT_TYPE_GENERATE,
+	})
+	if err == nil {
+		t.Error("Expected error for signal generate filter")
+	}
+
+	pf, err := parseSignalEventFilter(s, &api.SignalEventFilter{
+		Type: api.SignalEventType_SIGNAL_EVENT_TYPE_DELIVER,
+		FilterExpression: expression.Equal(
+			expression.Identifier("sig"),
+			expression.Value(int32(9))),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pf.kernelFilterString() != "sig == 9" ||
+		len(pf.tracepoints) != 1 || pf.tracepoints[0] != signalDeliverTracepoint {
+		t.Errorf("Unexpected parsed filter %+v", pf)
+	}
+
+	_, err = parseSignalEventFilter(s, &api.SignalEventFilter{
+		Type: api.SignalEventType_SIGNAL_EVENT_TYPE_DELIVER,
+		FilterExpression: expression.Equal(
+			expression.Identifier("no_such_field"),
+			expression.Value(int32(9))),
+	})
+	if err == nil {
+		t.Error("Expected error for unknown field")
+	}
+}
+
+func TestParseFilterIntegerConversion(t *testing.T) {
+	dir := newTestTracingDir(t, map[string][]string{
+		"raw_syscalls/sys_exit":     {"long id", "long ret"},
+		"syscalls/sys_enter_listen": {"fd", "unsigned long backlog"},
+		"syscalls/sys_exit_listen":  {"long ret"},
+	})
+	defer os.RemoveAll(dir)
+
+	s := &Sensor{traceFSMountPoint: dir}
+
+	// Integer values of any width register against 64-bit fields
+	pf, err := parseSyscallEventFilter(s, &api.SyscallEventFilter{
+		Type: api.SyscallEventType_SYSCALL_EVENT_TYPE_ENTER,
+		FilterExpression: expression.Equal(
+			expression.Identifier("id"),
+			expression.Value(uint64(37))),
+	})
+	if err != nil {
+		t.Error(err)
+	} else if pf.kernelFilterString() != "id == 37" {
+		t.Errorf("Unexpected kernel filter %q", pf.kernelFilterString())
+	}
+
+	pf, err = parseSyscallEventFilter(s, &api.SyscallEventFilter{
+		Type: api.SyscallEventType_SYSCALL_EVENT_TYPE_EXIT,
+		FilterExpression: expression.LogicalAnd(
+			expression.Equal(
+				expression.Identifier("id"),
+				expression.Value(uint64(37))),
+			expression.Equal(
+				expression.Identifier("ret"),
+				expression.Value(uint64(0)))),
+	})
+	if err != nil {
+		t.Error(err)
+	} else if pf.kernelFilterString() != "id == 37 && ret == 0" {
+		t.Errorf("Unexpected kernel filter %q", pf.kernelFilterString())
+	}
+
+	_, err = parseNetworkEventFilter(s, &api.NetworkEventFilter{
+		Type: api.NetworkEventType_NETWORK_EVENT_TYPE_LISTEN_ATTEMPT,
+		FilterExpression: expression.Equal(
+			expression.Identifier("backlog"),
+			expression.Value(int32(5))),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = parseNetworkEventFilter(s, &api.NetworkEventFilter{
+		Type: api.NetworkEventType_NETWORK_EVENT_TYPE_LISTEN_RESULT,
+		FilterExpression: expression.Equal(
+			expression.Identifier("ret"),
+			expression.Value(int32(0))),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	// Values that don't fit in the field's type are still mismatches
+	_, err = parseNetworkEventFilter(s, &api.NetworkEventFilter{
+		Type: api.NetworkEventType_NETWORK_EVENT_TYPE_LISTEN_ATTEMPT,
+		FilterExpression: expression.Equal(
+			expression.Identifier("backlog"),
+			expression.Value(int32(-1))),
+	})
+	if err == nil {
+		t.Error("Expected type mismatch for negative backlog")
+	}
+}
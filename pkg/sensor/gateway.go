@@ -0,0 +1,272 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+	"github.com/capsule8/capsule8/pkg/config"
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	"golang.org/x/net/context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// MIME type for newline-delimited JSON event streams
+	mimeNDJSON = "application/x-ndjson"
+
+	// MIME type for Server-Sent Events event streams
+	mimeEventStream = "text/event-stream"
+
+	// HTTP path of the TelemetryService.GetEvents binding
+	eventsPath = "/v0/events"
+)
+
+// ndjsonMarshaler is the JSON marshaler for clients that request
+// newline-delimited JSON. Each streamed response is written as a single line.
+type ndjsonMarshaler struct {
+	runtime.JSONPb
+}
+
+func (m *ndjsonMarshaler) ContentType() string {
+	return mimeNDJSON
+}
+
+// sseMarshaler is the JSON marshaler for clients that request Server-Sent
+// Events. Each streamed response is written as an SSE data field; the stream
+// delimiter terminates the event.
+type sseMarshaler struct {
+	runtime.JSONPb
+}
+
+func (m *sseMarshaler) ContentType() string {
+	return mimeEventStream
+}
+
+func (m *sseMarshaler) Marshal(v interface{}) ([]byte, error) {
+	b, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	event := make([]byte, 0, len(b)+7)
+	event = append(event, "data: "...)
+	event = append(event, b...)
+	return append(event, '\n'), nil
+}
+
+// eventSourceHandler adapts GET requests for the event stream to the POST
+// binding of TelemetryService.GetEvents. Browsers' EventSource can only
+// issue GET requests, so the subscription is passed as JSON in the
+// "subscription" query parameter instead of in the request body.
+func eventSourceHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != eventsPath {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		query := r.URL.Query().Get("subscription")
+		if len(query) == 0 {
+			http.Error(w, "Missing subscription query parameter",
+				http.StatusBadRequest)
+			return
+		}
+
+		sub := &api.Subscription{}
+		err := jsonpb.UnmarshalString(query, sub)
+		if err != nil {
+			http.Error(w, "Invalid subscription: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		m := jsonpb.Marshaler{OrigName: true}
+		body, err := m.MarshalToString(&api.GetEventsRequest{
+			Subscription: sub,
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		req := r.WithContext(r.Context())
+		req.Method = http.MethodPost
+		req.Body = ioutil.NopCloser(bytes.NewBufferString(body))
+		req.ContentLength = int64(len(body))
+		req.Header = make(http.Header, len(r.Header))
+		for k, v := range r.Header {
+			if k != "Content-Type" {
+				req.Header[k] = v
+			}
+		}
+
+		next.ServeHTTP(w, req)
+	})
+}
+
+// GatewayService is a service that can be used with the ServiceManager to
+// serve the sensor's gRPC API as REST/JSON over HTTP. Requests are forwarded
+// to the sensor's gRPC API listening on the configured address.
+type GatewayService struct {
+	server *http.Server
+	cancel context.CancelFunc
+
+	address     string
+	grpcAddress string
+}
+
+// NewGatewayService creates a new GatewayService instance that serves HTTP
+// on address and forwards requests to the gRPC API at grpcAddress.
+func NewGatewayService(address, grpcAddress string) *GatewayService {
+	return &GatewayService{
+		address:     address,
+		grpcAddress: grpcAddress,
+	}
+}
+
+// Name returns the human-readable name of the GatewayService.
+func (gs *GatewayService) Name() string {
+	return "HTTP API Gateway"
+}
+
+// Serve is the main entrypoint for the GatewayService. It is normally called
+// by the ServiceManager. It will service requests indefinitely from the
+// calling Goroutine.
+func (gs *GatewayService) Serve() error {
+	var (
+		ctx       context.Context
+		tlsConfig *tls.Config
+		err       error
+	)
+
+	glog.V(1).Info("Serving HTTP API on ", gs.address)
+
+	ctx, gs.cancel = context.WithCancel(context.Background())
+
+	opts := gatewayDialOptions(gs.grpcAddress)
+	if config.Sensor.UseTLS {
+		tlsConfig, err = serverTLSConfig()
+		if err != nil {
+			return err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(
+			credentials.NewTLS(gatewayClientTLSConfig(tlsConfig))))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard,
+			&runtime.JSONPb{OrigName: true}),
+		runtime.WithMarshalerOption(mimeNDJSON,
+			&ndjsonMarshaler{runtime.JSONPb{OrigName: true}}),
+		runtime.WithMarshalerOption(mimeEventStream,
+			&sseMarshaler{runtime.JSONPb{OrigName: true}}),
+	)
+
+	err = api.RegisterTelemetryServiceHandlerFromEndpoint(ctx, mux,
+		gs.grpcAddress, opts)
+	if err != nil {
+		return err
+	}
+	err = api.RegisterSensorServiceHandlerFromEndpoint(ctx, mux,
+		gs.grpcAddress, opts)
+	if err != nil {
+		return err
+	}
+
+	gs.server = &http.Server{
+		Addr:      gs.address,
+		Handler:   eventSourceHandler(mux),
+		TLSConfig: tlsConfig,
+	}
+
+	if tlsConfig != nil {
+		glog.V(1).Infoln("Starting HTTP API server with TLS credentials")
+		err = gs.server.ListenAndServeTLS("", "")
+	} else {
+		glog.V(1).Infoln("Starting HTTP API server")
+		err = gs.server.ListenAndServe()
+	}
+	if err != nil && err != http.ErrServerClosed {
+		glog.Errorf("HTTP API error: %s", err)
+		return err
+	}
+
+	return nil
+}
+
+// Stop will stop a running GatewayService.
+func (gs *GatewayService) Stop() {
+	// Event streams are long-lived, so don't wait for them to finish
+	gs.server.Close()
+	gs.cancel()
+}
+
+// gatewayDialOptions returns the options needed to dial the sensor's gRPC API
+// at the given address, which may be a unix socket.
+func gatewayDialOptions(address string) []grpc.DialOption {
+	parts := strings.Split(address, ":")
+	if len(parts) > 1 && parts[0] == "unix" {
+		socketPath := parts[1]
+		return []grpc.DialOption{
+			grpc.WithDialer(func(_ string, timeout time.Duration) (net.Conn, error) {
+				return net.DialTimeout("unix", socketPath, timeout)
+			}),
+		}
+	}
+
+	return nil
+}
+
+// gatewayClientTLSConfig returns the TLS configuration used by the gateway to
+// connect to the sensor's gRPC API. The gateway presents the sensor's own
+// certificate and expects the server certificate to be signed by the
+// configured certificate authority.
+func gatewayClientTLSConfig(serverConfig *tls.Config) *tls.Config {
+	c := &tls.Config{
+		Certificates: serverConfig.Certificates,
+		RootCAs:      serverConfig.ClientCAs,
+	}
+
+	// The gRPC API may be listening on any address, so verify the server
+	// certificate against the name in the certificate itself.
+	if len(serverConfig.Certificates) > 0 {
+		leaf := serverConfig.Certificates[0].Certificate[0]
+		if cert, err := x509.ParseCertificate(leaf); err == nil {
+			if len(cert.DNSNames) > 0 {
+				c.ServerName = cert.DNSNames[0]
+			} else {
+				c.ServerName = cert.Subject.CommonName
+			}
+		}
+	}
+
+	return c
+}
@@ -0,0 +1,107 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+func TestSSEMarshaler(t *testing.T) {
+	m := &sseMarshaler{runtime.JSONPb{OrigName: true}}
+
+	b, err := m.Marshal(&api.TelemetryEvent{
+		Id:       "abc",
+		SensorId: "def",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "data: {\"id\":\"abc\",\"sensor_id\":\"def\"}\n"
+	if string(b) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(b))
+	}
+	if m.ContentType() != mimeEventStream {
+		t.Errorf("Unexpected content type %s", m.ContentType())
+	}
+}
+
+func TestEventSourceHandler(t *testing.T) {
+	var (
+		method, contentType, accept string
+		body                        []byte
+	)
+	h := eventSourceHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		contentType = r.Header.Get("Content-Type")
+		accept = r.Header.Get("Accept")
+		body, _ = ioutil.ReadAll(r.Body)
+	}))
+
+	sub := `{"event_filter":{"process_events":[{"type":"PROCESS_EVENT_TYPE_EXEC"}]}}`
+	r := httptest.NewRequest(http.MethodGet,
+		eventsPath+"?subscription="+url.QueryEscape(sub), nil)
+	r.Header.Set("Accept", mimeEventStream)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if method != http.MethodPost {
+		t.Errorf("Expected POST, got %s", method)
+	}
+	if contentType != "" {
+		t.Errorf("Unexpected content type %s", contentType)
+	}
+	if accept != mimeEventStream {
+		t.Errorf("Expected Accept %s, got %s", mimeEventStream, accept)
+	}
+	expected := `{"subscription":{"event_filter":{"process_events":[{"type":"PROCESS_EVENT_TYPE_EXEC"}]}}}`
+	if string(body) != expected {
+		t.Errorf("Expected body %s, got %s", expected, string(body))
+	}
+
+	// Other requests are passed through unchanged
+	method = ""
+	r = httptest.NewRequest(http.MethodGet, "/v0/subscriptions", nil)
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	if method != http.MethodGet {
+		t.Errorf("Expected GET, got %s", method)
+	}
+
+	badQueries := []string{
+		"",
+		"?subscription=",
+		"?subscription=" + url.QueryEscape(`{"event_filter":`),
+	}
+	for _, q := range badQueries {
+		method = ""
+		r = httptest.NewRequest(http.MethodGet, eventsPath+q, nil)
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%q: expected status %d, got %d", q,
+				http.StatusBadRequest, w.Code)
+		}
+		if method != "" {
+			t.Errorf("%q: request unexpectedly forwarded", q)
+		}
+	}
+}
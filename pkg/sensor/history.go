@@ -0,0 +1,620 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/config"
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/stream"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+)
+
+// historyRecord is a telemetry event retained in the event history along
+// with the sample data that it was decoded from. The sample data is kept so
+// that subscription filter expressions can be evaluated again on replay.
+type historyRecord struct {
+	event  *api.TelemetryEvent
+	fields map[string]int32
+	data   perf.TraceEventSampleData
+}
+
+// spilledHistoryRecord is the on-disk encoding of a historyRecord.
+type spilledHistoryRecord struct {
+	Event  []byte
+	Fields map[string]int32
+	Data   map[string]interface{}
+}
+
+// historySegment is a file of history records that have been spilled to
+// disk. first and last are the oldest and newest monotimes in the segment.
+type historySegment struct {
+	path  string
+	first int64
+	last  int64
+}
+
+// historyEventKey identifies a single kernel sample. Each subscription that
+// registers for an event decodes its own copy of the sample, so the event
+// ID and sequence number cannot be used to identify duplicates.
+type historyEventKey struct {
+	monotime int64
+	cpu      int32
+	pid      int32
+	kind     historyEventKind
+}
+
+func newHistoryEventKey(e *api.TelemetryEvent) historyEventKey {
+	kind, _ := historyEventKindOf(e)
+	return historyEventKey{
+		monotime: e.SensorMonotimeNanos,
+		cpu:      e.Cpu,
+		pid:      e.ProcessPid,
+		kind:     kind,
+	}
+}
+
+// historySpillQueueLength is the number of batches of records that may be
+// waiting to be spilled to disk. When the queue is full, the oldest records
+// are discarded instead of spilled.
+const historySpillQueueLength = 4
+
+// eventHistory is a bounded, time-indexed record of the telemetry events that
+// the sensor has recently dispatched to subscriptions. Records are kept in
+// memory up to maxLength; beyond that the oldest records are either spilled
+// to disk in spillDir or discarded. Records older than maxAge are expired.
+type eventHistory struct {
+	sync.Mutex
+
+	maxAge    int64
+	maxLength int
+	spillDir  string
+
+	records     []historyRecord
+	segments    []historySegment
+	nextSegment uint64
+	cutoff      int64
+
+	// Batches of records are spilled to disk in the background so that
+	// dispatching events never waits for the disk. Batches remain in
+	// spilling until their segment has been written.
+	spills   chan []historyRecord
+	spilling [][]historyRecord
+	spilled  chan struct{}
+	closed   bool
+}
+
+func newEventHistory(maxAge time.Duration, maxLength int, spillDir string) *eventHistory {
+	if maxLength < 4 {
+		maxLength = 4
+	}
+
+	if len(spillDir) > 0 {
+		// Anything left over from a previous run is useless since
+		// monotimes are relative to sensor start.
+		err := os.RemoveAll(spillDir)
+		if err == nil {
+			err = os.MkdirAll(spillDir, 0700)
+		}
+		if err != nil {
+			glog.Warningf("Couldn't create event history directory %s: %s",
+				spillDir, err)
+			spillDir = ""
+		}
+	}
+
+	h := &eventHistory{
+		maxAge:    int64(maxAge),
+		maxLength: maxLength,
+		spillDir:  spillDir,
+		records:   make([]historyRecord, 0, maxLength),
+	}
+
+	if len(spillDir) > 0 {
+		h.spills = make(chan []historyRecord, historySpillQueueLength)
+		h.spilled = make(chan struct{})
+		go h.spillSegments()
+	}
+
+	return h
+}
+
+// add records a newly dispatched event in the history. The event's monotime
+// is used as the current time for expiring old records.
+func (h *eventHistory) add(r historyRecord) {
+	monotime := r.event.SensorMonotimeNanos
+	key := newHistoryEventKey(r.event)
+
+	h.Lock()
+	defer h.Unlock()
+
+	// The same sample may be decoded once for every subscription that
+	// registered for it. Only keep one copy.
+	for i := len(h.records) - 1; i >= 0; i-- {
+		e := h.records[i].event
+		if e.SensorMonotimeNanos < monotime {
+			break
+		}
+		if newHistoryEventKey(e) == key {
+			return
+		}
+	}
+
+	h.records = append(h.records, r)
+	h.expire(monotime - h.maxAge)
+
+	if len(h.records) > h.maxLength {
+		n := h.maxLength / 4
+		if h.spills != nil && !h.closed {
+			select {
+			case h.spills <- h.records[:n]:
+				h.spilling = append(h.spilling, h.records[:n])
+			default:
+				glog.V(1).Infof("Discarding %d events from history: spill queue is full",
+					n)
+			}
+		}
+
+		records := make([]historyRecord, len(h.records)-n, h.maxLength)
+		copy(records, h.records[n:])
+		h.records = records
+	}
+}
+
+// expire drops records older than cutoff. Segments are expired when the
+// next one is spilled. The caller must hold the lock.
+func (h *eventHistory) expire(cutoff int64) {
+	h.cutoff = cutoff
+
+	i := sort.Search(len(h.records), func(i int) bool {
+		return h.records[i].event.SensorMonotimeNanos >= cutoff
+	})
+	if i > 0 {
+		h.records = h.records[i:]
+	}
+}
+
+// spillSegments writes batches of records queued by add to segment files
+// until the history is closed.
+func (h *eventHistory) spillSegments() {
+	defer close(h.spilled)
+
+	for records := range h.spills {
+		h.Lock()
+		h.nextSegment++
+		path := filepath.Join(h.spillDir,
+			fmt.Sprintf("%016x.history", h.nextSegment))
+		h.Unlock()
+
+		err := writeHistorySegment(path, records)
+
+		h.Lock()
+		h.spilling = h.spilling[1:]
+		if err == nil {
+			h.segments = append(h.segments, historySegment{
+				path:  path,
+				first: records[0].event.SensorMonotimeNanos,
+				last:  records[len(records)-1].event.SensorMonotimeNanos,
+			})
+		}
+		var expired []historySegment
+		for len(h.segments) > 0 && h.segments[0].last < h.cutoff {
+			expired = append(expired, h.segments[0])
+			h.segments = h.segments[1:]
+		}
+		h.Unlock()
+
+		for _, s := range expired {
+			os.Remove(s.path)
+		}
+	}
+}
+
+// writeHistorySegment writes records to a new segment file. Only the sample
+// data for the fields used to evaluate filters is written.
+func writeHistorySegment(path string, records []historyRecord) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		glog.Warningf("Couldn't create event history segment %s: %s",
+			path, err)
+		return err
+	}
+	defer f.Close()
+
+	enc := gob.NewEncoder(f)
+	for _, r := range records {
+		b, err := proto.Marshal(r.event)
+		if err != nil {
+			glog.V(1).Infof("Couldn't marshal event for history: %s", err)
+			continue
+		}
+		data := make(map[string]interface{}, len(r.fields))
+		for k := range r.fields {
+			if v, ok := r.data[k]; ok {
+				data[k] = v
+			}
+		}
+		err = enc.Encode(&spilledHistoryRecord{
+			Event:  b,
+			Fields: r.fields,
+			Data:   data,
+		})
+		if err != nil {
+			glog.Warningf("Couldn't write event history segment %s: %s",
+				path, err)
+			os.Remove(path)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readHistorySegment(path string) ([]historyRecord, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []historyRecord
+	dec := gob.NewDecoder(f)
+	for {
+		var sr spilledHistoryRecord
+		if err = dec.Decode(&sr); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return records, err
+		}
+
+		e := &api.TelemetryEvent{}
+		if err = proto.Unmarshal(sr.Event, e); err != nil {
+			return records, err
+		}
+		records = append(records, historyRecord{
+			event:  e,
+			fields: sr.Fields,
+			data:   sr.Data,
+		})
+	}
+
+	return records, nil
+}
+
+// events returns all retained records with monotimes in [since, until),
+// oldest first.
+func (h *eventHistory) events(since, until int64) []historyRecord {
+	h.Lock()
+	var segments []historySegment
+	for _, s := range h.segments {
+		if s.last >= since && s.first < until {
+			segments = append(segments, s)
+		}
+	}
+	i := sort.Search(len(h.records), func(i int) bool {
+		return h.records[i].event.SensorMonotimeNanos >= since
+	})
+	var memory []historyRecord
+	for _, records := range h.spilling {
+		for _, r := range records {
+			t := r.event.SensorMonotimeNanos
+			if t >= since && t < until {
+				memory = append(memory, r)
+			}
+		}
+	}
+	for _, r := range h.records[i:] {
+		if r.event.SensorMonotimeNanos < until {
+			memory = append(memory, r)
+		}
+	}
+	h.Unlock()
+
+	var records []historyRecord
+	for _, s := range segments {
+		spilled, err := readHistorySegment(s.path)
+		if err != nil {
+			// The segment may have expired since the lock was
+			// released. Use whatever could be read.
+			glog.V(1).Infof("Couldn't read event history segment %s: %s",
+				s.path, err)
+		}
+		for _, r := range spilled {
+			t := r.event.SensorMonotimeNanos
+			if t >= since && t < until {
+				records = append(records, r)
+			}
+		}
+	}
+
+	return append(records, memory...)
+}
+
+// close discards the history, removing any spilled segments from disk.
+func (h *eventHistory) close() {
+	h.Lock()
+	closed := h.closed
+	h.closed = true
+	h.Unlock()
+
+	if h.spills != nil && !closed {
+		close(h.spills)
+		<-h.spilled
+	}
+
+	h.Lock()
+	defer h.Unlock()
+
+	h.records = nil
+	h.spilling = nil
+	h.segments = nil
+	if len(h.spillDir) > 0 {
+		os.RemoveAll(h.spillDir)
+	}
+}
+
+// replay returns a stream that first emits the retained events matching the
+// subscription's event filter with monotimes in [since, until), and then the
+// live events from in. start is the monotime just before the live events for
+// the subscription were enabled and cutoff is the monotime just after. Live
+// events older than cutoff that were already replayed are dropped.
+//
+// Live events that arrive during the replay are queued up to the
+// subscription's queue length. Beyond that, the subscription's backpressure
+// policy is applied and dropped events are reported with an event created by
+// newDroppedEvent.
+func (h *eventHistory) replay(
+	in *stream.Stream,
+	sub *api.Subscription,
+	since, until, start, cutoff int64,
+	newDroppedEvent func(count uint64) *api.TelemetryEvent,
+) *stream.Stream {
+	data := make(chan interface{}, config.Sensor.ChannelBufferLength)
+	filter := newHistoryFilter(sub.EventFilter)
+
+	var policy api.BackpressurePolicy
+	if sub.Backpressure != nil {
+		policy = sub.Backpressure.Policy
+	}
+	maxPending := queueLength(sub)
+
+	go func() {
+		defer close(data)
+
+		var (
+			pending []interface{}
+			dropped uint64
+		)
+		replayed := make(map[historyEventKey]bool)
+
+		// Keep receiving live events while replaying so that the
+		// subscription doesn't back up into the event monitor unless
+		// its policy is to block. The live events may end before the
+		// replay does (for example, when for_duration has already
+		// elapsed).
+		live := in.Data
+		for _, r := range h.events(since, until) {
+			if !filter.match(r) {
+				continue
+			}
+			if r.event.SensorMonotimeNanos >= start {
+				replayed[newHistoryEventKey(r.event)] = true
+			}
+
+		sendLoop:
+			for {
+				recv := live
+				if len(pending) >= maxPending &&
+					policy == api.BackpressurePolicy_BACKPRESSURE_POLICY_BLOCK {
+					recv = nil
+				}
+
+				select {
+				case data <- r.event:
+					break sendLoop
+				case e, ok := <-recv:
+					if !ok {
+						live = nil
+						continue
+					}
+					if len(pending) < maxPending {
+						pending = append(pending, e)
+					} else if policy == api.BackpressurePolicy_BACKPRESSURE_POLICY_DROP_OLDEST {
+						pending = append(pending[1:], e)
+						dropped++
+					} else {
+						dropped++
+					}
+				}
+			}
+		}
+
+		isDuplicate := func(i interface{}) bool {
+			e, ok := i.(*api.TelemetryEvent)
+			return ok && e.SensorMonotimeNanos < cutoff &&
+				replayed[newHistoryEventKey(e)]
+		}
+
+		if dropped > 0 {
+			data <- newDroppedEvent(dropped)
+		}
+		for _, e := range pending {
+			if !isDuplicate(e) {
+				data <- e
+			}
+		}
+		pending = nil
+
+		if live == nil {
+			return
+		}
+		for e := range live {
+			if !isDuplicate(e) {
+				data <- e
+			}
+		}
+	}()
+
+	return &stream.Stream{
+		Ctrl: in.Ctrl,
+		Data: data,
+	}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
+// historyEventKind identifies the type of a telemetry event for matching
+// against subscription event filters.
+type historyEventKind struct {
+	event   string
+	subtype int32
+}
+
+func historyEventKindOf(e *api.TelemetryEvent) (historyEventKind, bool) {
+	switch e.Event.(type) {
+	case *api.TelemetryEvent_Syscall:
+		return historyEventKind{"syscall", int32(e.GetSyscall().Type)}, true
+	case *api.TelemetryEvent_Process:
+		return historyEventKind{"process", int32(e.GetProcess().Type)}, true
+	case *api.TelemetryEvent_File:
+		return historyEventKind{"file", int32(e.GetFile().Type)}, true
+	case *api.TelemetryEvent_Network:
+		return historyEventKind{"network", int32(e.GetNetwork().Type)}, true
+	case *api.TelemetryEvent_Signal:
+		return historyEventKind{"signal", int32(e.GetSignal().Type)}, true
+	case *api.TelemetryEvent_Namespace:
+		return historyEventKind{"namespace", int32(e.GetNamespace().Type)}, true
+	case *api.TelemetryEvent_Mount:
+		return historyEventKind{"mount", int32(e.GetMount().Type)}, true
+	case *api.TelemetryEvent_KernelModule:
+		return historyEventKind{"kernel_module", int32(e.GetKernelModule().Type)}, true
+	case *api.TelemetryEvent_Container:
+		return historyEventKind{"container", int32(e.GetContainer().Type)}, true
+	}
+
+	// Kernel function call events do not record the probed symbol, so
+	// there is no way to tell which filter they belong to. User function
+	// call and tracepoint events are only produced while a subscription
+	// for them exists. Process access events are filtered on values
+	// resolved by the sensor that are not retained.
+	return historyEventKind{}, false
+}
+
+// historyFilter evaluates a subscription's event filters against retained
+// events. A nil expression for a kind matches all events of that kind.
+type historyFilter struct {
+	filters map[historyEventKind][]*expression.Expression
+}
+
+// newHistoryFilter creates a historyFilter from an event filter. It must be
+// called after the event filter has been registered so that deprecated
+// filter fields have been rewritten as expressions.
+func newHistoryFilter(ef *api.EventFilter) *historyFilter {
+	f := &historyFilter{
+		filters: make(map[historyEventKind][]*expression.Expression),
+	}
+
+	for _, sef := range ef.SyscallEvents {
+		// Complete events are filtered on values that are not retained
+		if sef.Type == api.SyscallEventType_SYSCALL_EVENT_TYPE_COMPLETE {
+			continue
+		}
+
+		// Syscall filters without an id or name are not registered
+		tree, err := syscallFilterExpression(sef)
+		if err == nil && containsIDFilter(tree) {
+			f.add("syscall", int32(sef.Type), tree)
+		}
+	}
+	for _, pef := range ef.ProcessEvents {
+		if pef.Type == api.ProcessEventType_PROCESS_EVENT_TYPE_FORK {
+			f.add("process", int32(pef.Type), nil)
+		} else {
+			f.add("process", int32(pef.Type), pef.FilterExpression)
+		}
+	}
+	for _, fef := range ef.FileEvents {
+		f.add("file", int32(fef.Type), fef.FilterExpression)
+	}
+	for _, nef := range ef.NetworkEvents {
+		f.add("network", int32(nef.Type), nef.FilterExpression)
+	}
+	for _, sef := range ef.SignalEvents {
+		f.add("signal", int32(sef.Type), sef.FilterExpression)
+	}
+	for _, nef := range ef.NamespaceEvents {
+		f.add("namespace", int32(nef.Type), nef.FilterExpression)
+	}
+	for _, mef := range ef.MountEvents {
+		f.add("mount", int32(mef.Type), mef.FilterExpression)
+	}
+	for _, kef := range ef.KernelModuleEvents {
+		f.add("kernel_module", int32(kef.Type), kef.FilterExpression)
+	}
+	for _, cef := range ef.ContainerEvents {
+		f.add("container", int32(cef.Type), cef.FilterExpression)
+	}
+
+	return f
+}
+
+func (f *historyFilter) add(event string, subtype int32, tree *api.Expression) {
+	kind := historyEventKind{event, subtype}
+	if tree == nil {
+		f.filters[kind] = append(f.filters[kind], nil)
+		return
+	}
+
+	expr, err := expression.NewExpression(tree)
+	if err != nil {
+		glog.V(1).Infof("Invalid %s event filter: %s", event, err)
+		return
+	}
+	f.filters[kind] = append(f.filters[kind], expr)
+}
+
+func (f *historyFilter) match(r historyRecord) bool {
+	kind, ok := historyEventKindOf(r.event)
+	if !ok {
+		return false
+	}
+
+	for _, expr := range f.filters[kind] {
+		if expr == nil {
+			return true
+		}
+		v, err := expr.Evaluate(
+			expression.FieldTypeMap(r.fields),
+			expression.FieldValueMap(r.data))
+		if err == nil && expression.IsValueTrue(v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,286 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/config"
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/stream"
+)
+
+func newFileOpenRecord(monotime int64, filename string) historyRecord {
+	return historyRecord{
+		event: &api.TelemetryEvent{
+			SensorMonotimeNanos: monotime,
+			Event: &api.TelemetryEvent_File{
+				File: &api.FileEvent{
+					Type:     api.FileEventType_FILE_EVENT_TYPE_OPEN,
+					Filename: filename,
+				},
+			},
+		},
+		fields: map[string]int32{
+			"filename": int32(api.ValueType_STRING),
+		},
+		data: map[string]interface{}{
+			"filename": filename,
+		},
+	}
+}
+
+func TestHistoryDuplicates(t *testing.T) {
+	h := newEventHistory(time.Minute, 16, "")
+
+	h.add(newFileOpenRecord(1, "/etc/passwd"))
+	h.add(newFileOpenRecord(1, "/etc/passwd"))
+	h.add(newFileOpenRecord(2, "/etc/passwd"))
+
+	if n := len(h.events(0, 10)); n != 2 {
+		t.Errorf("Expected 2 events, got %d", n)
+	}
+}
+
+func TestHistoryExpire(t *testing.T) {
+	h := newEventHistory(100, 16, "")
+
+	for i := int64(0); i < 10; i++ {
+		h.add(newFileOpenRecord(i*50, "/etc/passwd"))
+	}
+
+	records := h.events(0, 1000)
+	if len(records) != 3 {
+		t.Fatalf("Expected 3 events, got %d", len(records))
+	}
+	if m := records[0].event.SensorMonotimeNanos; m != 350 {
+		t.Errorf("Expected oldest event at 350, got %d", m)
+	}
+
+	records = h.events(400, 450)
+	if len(records) != 1 {
+		t.Errorf("Expected 1 event, got %d", len(records))
+	}
+}
+
+func TestHistorySpill(t *testing.T) {
+	dir, err := ioutil.TempDir("", "history")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	spillDir := filepath.Join(dir, "history")
+	h := newEventHistory(time.Minute, 8, spillDir)
+	for i := int64(1); i <= 20; i++ {
+		r := newFileOpenRecord(i, "/etc/passwd")
+		// Sample data that isn't a filter field is not spilled
+		r.data["event"] = r.event
+		h.add(r)
+
+		// Segments are written in the background. Don't let the
+		// spill queue overflow.
+		waitForHistorySpill(t, h)
+	}
+
+	if len(h.records) > 8 {
+		t.Errorf("Expected at most 8 events in memory, got %d",
+			len(h.records))
+	}
+	if len(h.segments) == 0 {
+		t.Fatal("Expected spilled history segments")
+	}
+
+	records := h.events(0, 100)
+	if len(records) != 20 {
+		t.Fatalf("Expected 20 events, got %d", len(records))
+	}
+	for i, r := range records {
+		if m := r.event.SensorMonotimeNanos; m != int64(i+1) {
+			t.Errorf("Expected event %d at %d, got %d", i, i+1, m)
+		}
+		if r.data["filename"] != "/etc/passwd" {
+			t.Errorf("Unexpected spilled data %+v", r.data)
+		}
+	}
+
+	h.close()
+	if _, err = os.Stat(spillDir); !os.IsNotExist(err) {
+		t.Errorf("Expected %s to be removed", spillDir)
+	}
+}
+
+func waitForHistorySpill(t *testing.T, h *eventHistory) {
+	for i := 0; i < 1000; i++ {
+		h.Lock()
+		n := len(h.spilling)
+		h.Unlock()
+		if n == 0 {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("Timed out waiting for history to be spilled")
+}
+
+func TestHistoryFilter(t *testing.T) {
+	f := newHistoryFilter(&api.EventFilter{
+		FileEvents: []*api.FileEventFilter{
+			&api.FileEventFilter{
+				Type: api.FileEventType_FILE_EVENT_TYPE_OPEN,
+				FilterExpression: expression.Equal(
+					expression.Identifier("filename"),
+					expression.Value("/etc/passwd")),
+			},
+		},
+	})
+
+	if !f.match(newFileOpenRecord(1, "/etc/passwd")) {
+		t.Error("Expected match for /etc/passwd")
+	}
+	if f.match(newFileOpenRecord(1, "/etc/shadow")) {
+		t.Error("Unexpected match for /etc/shadow")
+	}
+
+	r := historyRecord{
+		event: &api.TelemetryEvent{
+			Event: &api.TelemetryEvent_Process{
+				Process: &api.ProcessEvent{
+					Type: api.ProcessEventType_PROCESS_EVENT_TYPE_FORK,
+				},
+			},
+		},
+	}
+	if f.match(r) {
+		t.Error("Unexpected match for process event")
+	}
+}
+
+func TestHistoryReplay(t *testing.T) {
+	h := newEventHistory(time.Minute, 16, "")
+	h.add(newFileOpenRecord(10, "/etc/passwd"))
+	h.add(newFileOpenRecord(20, "/etc/shadow"))
+	h.add(newFileOpenRecord(30, "/etc/passwd"))
+
+	sub := &api.Subscription{
+		EventFilter: &api.EventFilter{
+			FileEvents: []*api.FileEventFilter{
+				&api.FileEventFilter{
+					Type: api.FileEventType_FILE_EVENT_TYPE_OPEN,
+				},
+			},
+		},
+	}
+
+	ctrl := make(chan interface{})
+	data := make(chan interface{}, 2)
+	data <- newFileOpenRecord(30, "/etc/passwd").event
+	data <- newFileOpenRecord(40, "/etc/group").event
+	close(data)
+
+	s := h.replay(&stream.Stream{Ctrl: ctrl, Data: data}, sub, 15, 35, 25, 35,
+		newTestDroppedEvent)
+
+	var filenames []string
+	for e := range s.Data {
+		filenames = append(filenames,
+			e.(*api.TelemetryEvent).GetFile().Filename)
+	}
+
+	expected := []string{"/etc/shadow", "/etc/passwd", "/etc/group"}
+	if len(filenames) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, filenames)
+	}
+	for i := range expected {
+		if filenames[i] != expected[i] {
+			t.Fatalf("Expected %v, got %v", expected, filenames)
+		}
+	}
+}
+
+func TestHistoryReplayBackpressure(t *testing.T) {
+	policies := map[api.BackpressurePolicy][]string{
+		api.BackpressurePolicy_BACKPRESSURE_POLICY_DROP_NEWEST: []string{
+			"replayed", "dropped 2", "live 1", "live 2",
+		},
+		api.BackpressurePolicy_BACKPRESSURE_POLICY_DROP_OLDEST: []string{
+			"replayed", "dropped 2", "live 3", "live 4",
+		},
+	}
+
+	// Nothing is received from the replay until all of the live events
+	// have been queued or dropped, so the replayed event mustn't be
+	// buffered.
+	bufferLength := config.Sensor.ChannelBufferLength
+	config.Sensor.ChannelBufferLength = 0
+	defer func() {
+		config.Sensor.ChannelBufferLength = bufferLength
+	}()
+
+	for policy, expected := range policies {
+		h := newEventHistory(time.Minute, 16, "")
+		h.add(newFileOpenRecord(10, "replayed"))
+
+		sub := &api.Subscription{
+			EventFilter: &api.EventFilter{
+				FileEvents: []*api.FileEventFilter{
+					&api.FileEventFilter{
+						Type: api.FileEventType_FILE_EVENT_TYPE_OPEN,
+					},
+				},
+			},
+			Backpressure: &api.BackpressureOptions{
+				Policy:      policy,
+				QueueLength: 2,
+			},
+		}
+
+		data := make(chan interface{})
+		s := h.replay(&stream.Stream{Data: data}, sub, 0, 20, 20, 20,
+			newTestDroppedEvent)
+		for i := 1; i <= 4; i++ {
+			data <- newFileOpenRecord(int64(20+i),
+				fmt.Sprintf("live %d", i)).event
+		}
+		close(data)
+
+		var got []string
+		for i := range s.Data {
+			e := i.(*api.TelemetryEvent)
+			if d := e.GetDroppedEvents(); d != nil {
+				got = append(got, fmt.Sprintf("dropped %d", d.Count))
+			} else {
+				got = append(got, e.GetFile().Filename)
+			}
+		}
+
+		if len(got) != len(expected) {
+			t.Fatalf("%s: expected %v, got %v", policy, expected, got)
+		}
+		for i := range expected {
+			if got[i] != expected[i] {
+				t.Fatalf("%s: expected %v, got %v",
+					policy, expected, got)
+			}
+		}
+	}
+}
@@ -21,7 +21,6 @@ import (
 
 	api "github.com/capsule8/capsule8/api/v0"
 
-	"github.com/capsule8/capsule8/pkg/expression"
 	"github.com/capsule8/capsule8/pkg/sys/perf"
 
 	"github.com/golang/glog"
@@ -37,80 +36,116 @@ type kprobeFilter struct {
 
 var validSymbolRegex = regexp.MustCompile("^[A-Za-z_]{1}[\\w]*$")
 
-func newKprobeFilter(kef *api.KernelFunctionCallFilter) *kprobeFilter {
+// parseKernelEventFilter checks a kernel function call event filter.
+func parseKernelEventFilter(kef *api.KernelFunctionCallFilter) (*parsedFilter, error) {
 	// The symbol must begin with [A-Za-z_] and contain only [A-Za-z0-9_]
 	// We do not accept addresses or offsets
 	if !validSymbolRegex.MatchString(kef.Symbol) {
-		return nil
+		return nil, fmt.Errorf("Invalid kprobe symbol %q", kef.Symbol)
 	}
 
-	var filterString string
+	switch kef.Type {
+	case api.KernelFunctionCallEventType_KERNEL_FUNCTION_CALL_EVENT_TYPE_ENTER,
+		api.KernelFunctionCallEventType_KERNEL_FUNCTION_CALL_EVENT_TYPE_EXIT:
+	default:
+		return nil, fmt.Errorf("Invalid kernel function call event type %s",
+			kef.Type)
+	}
 
-	if kef.FilterExpression != nil {
-		expr, err := expression.NewExpression(kef.FilterExpression)
-		if err != nil {
-			glog.V(1).Infof("Bad kprobe filter expression: %s", err)
-			return nil
-		}
+	f := kprobeFilter{arguments: kef.Arguments}
+	return newParsedFilter(nil, []string{kef.Symbol}, kef.FilterExpression,
+		true, kprobeFieldTypes(f.fetchargs()))
+}
 
-		filterString = expr.KernelFilterString()
+func newKprobeFilter(kef *api.KernelFunctionCallFilter) *kprobeFilter {
+	pf, err := parseKernelEventFilter(kef)
+	if err != nil {
+		glog.V(1).Infof("Invalid kernel function call filter: %s", err)
+		return nil
 	}
 
-	filter := &kprobeFilter{
+	return &kprobeFilter{
 		symbol:    kef.Symbol,
+		onReturn:  kef.Type == api.KernelFunctionCallEventType_KERNEL_FUNCTION_CALL_EVENT_TYPE_EXIT,
 		arguments: kef.Arguments,
-		filter:    filterString,
+		filter:    pf.kernelFilterString(),
 	}
+}
 
-	switch kef.Type {
-	case api.KernelFunctionCallEventType_KERNEL_FUNCTION_CALL_EVENT_TYPE_ENTER:
-		filter.onReturn = false
-	case api.KernelFunctionCallEventType_KERNEL_FUNCTION_CALL_EVENT_TYPE_EXIT:
-		filter.onReturn = true
-	default:
-		return nil
+// byteArray returns the bytes in an array decoded from a trace event sample if
+// all of its elements are 8-bit values.
+func byteArray(array []interface{}) ([]byte, bool) {
+	b := make([]byte, len(array))
+	for i, e := range array {
+		switch e := e.(type) {
+		case int8:
+			b[i] = byte(e)
+		case uint8:
+			b[i] = e
+		default:
+			return nil, false
+		}
 	}
+	return b, true
+}
 
-	return filter
+// newFieldValue converts a value decoded from a trace event sample into a
+// typed FieldValue. Arrays of 8-bit values are converted to bytes.
+func newFieldValue(v interface{}) *api.KernelFunctionCallEvent_FieldValue {
+	value := &api.KernelFunctionCallEvent_FieldValue{}
+	switch v := v.(type) {
+	case []byte:
+		value.FieldType = api.KernelFunctionCallEvent_BYTES
+		value.Value = &api.KernelFunctionCallEvent_FieldValue_BytesValue{BytesValue: v}
+	case string:
+		value.FieldType = api.KernelFunctionCallEvent_STRING
+		value.Value = &api.KernelFunctionCallEvent_FieldValue_StringValue{StringValue: v}
+	case int8:
+		value.FieldType = api.KernelFunctionCallEvent_SINT8
+		value.Value = &api.KernelFunctionCallEvent_FieldValue_SignedValue{SignedValue: int64(v)}
+	case int16:
+		value.FieldType = api.KernelFunctionCallEvent_SINT16
+		value.Value = &api.KernelFunctionCallEvent_FieldValue_SignedValue{SignedValue: int64(v)}
+	case int32:
+		value.FieldType = api.KernelFunctionCallEvent_SINT32
+		value.Value = &api.KernelFunctionCallEvent_FieldValue_SignedValue{SignedValue: int64(v)}
+	case int64:
+		value.FieldType = api.KernelFunctionCallEvent_SINT64
+		value.Value = &api.KernelFunctionCallEvent_FieldValue_SignedValue{SignedValue: v}
+	case uint8:
+		value.FieldType = api.KernelFunctionCallEvent_UINT8
+		value.Value = &api.KernelFunctionCallEvent_FieldValue_UnsignedValue{UnsignedValue: uint64(v)}
+	case uint16:
+		value.FieldType = api.KernelFunctionCallEvent_UINT16
+		value.Value = &api.KernelFunctionCallEvent_FieldValue_UnsignedValue{UnsignedValue: uint64(v)}
+	case uint32:
+		value.FieldType = api.KernelFunctionCallEvent_UINT32
+		value.Value = &api.KernelFunctionCallEvent_FieldValue_UnsignedValue{UnsignedValue: uint64(v)}
+	case uint64:
+		value.FieldType = api.KernelFunctionCallEvent_UINT64
+		value.Value = &api.KernelFunctionCallEvent_FieldValue_UnsignedValue{UnsignedValue: v}
+	case []interface{}:
+		if b, ok := byteArray(v); ok {
+			value.FieldType = api.KernelFunctionCallEvent_BYTES
+			value.Value = &api.KernelFunctionCallEvent_FieldValue_BytesValue{BytesValue: b}
+			break
+		}
+		array := &api.KernelFunctionCallEvent_FieldValueArray{
+			Values: make([]*api.KernelFunctionCallEvent_FieldValue, len(v)),
+		}
+		for i, e := range v {
+			array.Values[i] = newFieldValue(e)
+		}
+		value.FieldType = api.KernelFunctionCallEvent_ARRAY
+		value.Value = &api.KernelFunctionCallEvent_FieldValue_ArrayValue{ArrayValue: array}
+	}
+	return value
 }
 
 func (f *kprobeFilter) decodeKprobe(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
 	args := make(map[string]*api.KernelFunctionCallEvent_FieldValue)
 	for k, v := range data {
-		value := &api.KernelFunctionCallEvent_FieldValue{}
-		switch v := v.(type) {
-		case []byte:
-			value.FieldType = api.KernelFunctionCallEvent_BYTES
-			value.Value = &api.KernelFunctionCallEvent_FieldValue_BytesValue{BytesValue: v}
-		case string:
-			value.FieldType = api.KernelFunctionCallEvent_STRING
-			value.Value = &api.KernelFunctionCallEvent_FieldValue_StringValue{StringValue: v}
-		case int8:
-			value.FieldType = api.KernelFunctionCallEvent_SINT8
-			value.Value = &api.KernelFunctionCallEvent_FieldValue_SignedValue{SignedValue: int64(v)}
-		case int16:
-			value.FieldType = api.KernelFunctionCallEvent_SINT16
-			value.Value = &api.KernelFunctionCallEvent_FieldValue_SignedValue{SignedValue: int64(v)}
-		case int32:
-			value.FieldType = api.KernelFunctionCallEvent_SINT32
-			value.Value = &api.KernelFunctionCallEvent_FieldValue_SignedValue{SignedValue: int64(v)}
-		case int64:
-			value.FieldType = api.KernelFunctionCallEvent_SINT64
-			value.Value = &api.KernelFunctionCallEvent_FieldValue_SignedValue{SignedValue: v}
-		case uint8:
-			value.FieldType = api.KernelFunctionCallEvent_UINT8
-			value.Value = &api.KernelFunctionCallEvent_FieldValue_UnsignedValue{UnsignedValue: uint64(v)}
-		case uint16:
-			value.FieldType = api.KernelFunctionCallEvent_UINT16
-			value.Value = &api.KernelFunctionCallEvent_FieldValue_UnsignedValue{UnsignedValue: uint64(v)}
-		case uint32:
-			value.FieldType = api.KernelFunctionCallEvent_UINT32
-			value.Value = &api.KernelFunctionCallEvent_FieldValue_UnsignedValue{UnsignedValue: uint64(v)}
-		case uint64:
-			value.FieldType = api.KernelFunctionCallEvent_UINT64
-			value.Value = &api.KernelFunctionCallEvent_FieldValue_UnsignedValue{UnsignedValue: v}
-		}
-		args[k] = value
+		args[k] = newFieldValue(v)
 	}
 
 	ev := f.sensor.NewEventFromSample(sample, data)
@@ -136,7 +171,6 @@ func registerKernelEvents(sensor *Sensor, eventMap subscriptionMap, events []*ap
 	for _, kef := range events {
 		f := newKprobeFilter(kef)
 		if f == nil {
-			glog.V(1).Infof("Invalid kprobe symbol: %s", kef.Symbol)
 			continue
 		}
 
@@ -40,6 +40,13 @@ func Main() {
 		defer sensor.Stop()
 		service := NewTelemetryService(sensor, config.Sensor.ListenAddr)
 		manager.RegisterService(service)
+
+		if len(config.Sensor.HTTPListenAddr) > 0 {
+			service := NewGatewayService(
+				config.Sensor.HTTPListenAddr,
+				config.Sensor.ListenAddr)
+			manager.RegisterService(service)
+		}
 	}
 
 	manager.Run()
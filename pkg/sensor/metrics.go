@@ -21,4 +21,7 @@ type MetricsCounters struct {
 
 	// Number of subscriptions
 	Subscriptions int32
+
+	// Number of samples lost by the kernel because a ringbuffer was full
+	LostSamples uint64
 }
@@ -0,0 +1,225 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"fmt"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+	"github.com/golang/glog"
+)
+
+const (
+	initModuleKprobeSymbol    = "sys_init_module"
+	initModuleKprobeFetchargs = "params=+0(%dx):string"
+
+	finitModuleKprobeSymbol    = "sys_finit_module"
+	finitModuleKprobeFetchargs = "fd=%di:s32 params=+0(%si):string flags=%dx:u32"
+
+	deleteModuleKprobeSymbol    = "sys_delete_module"
+	deleteModuleKprobeFetchargs = "name=+0(%di):string flags=%si:u32"
+
+	moduleLoadTracepoint = "module/module_load"
+	moduleFreeTracepoint = "module/module_free"
+)
+
+// Module load flags from include/uapi/linux/module.h
+var moduleInitFlagNames = []flagName{
+	{1, "ignore_modversions"}, // MODULE_INIT_IGNORE_MODVERSIONS
+	{2, "ignore_vermagic"},    // MODULE_INIT_IGNORE_VERMAGIC
+	{4, "compressed_file"},    // MODULE_INIT_COMPRESSED_FILE
+}
+
+// Module unload flags accepted by delete_module(2)
+var moduleDeleteFlagNames = []flagName{
+	{00001000, "trunc"},    // O_TRUNC
+	{00004000, "nonblock"}, // O_NONBLOCK
+}
+
+type kernelModuleFilter struct {
+	sensor *Sensor
+}
+
+func (f *kernelModuleFilter) decodeInitModule(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.TelemetryEvent_KernelModule{
+		KernelModule: &api.KernelModuleEvent{
+			Type:   api.KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_LOAD_ATTEMPT,
+			Params: data["params"].(string),
+			Fd:     -1,
+		},
+	}
+
+	return ev, nil
+}
+
+func (f *kernelModuleFilter) decodeFinitModule(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	flags := uint64(data["flags"].(uint32))
+
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.TelemetryEvent_KernelModule{
+		KernelModule: &api.KernelModuleEvent{
+			Type:      api.KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_LOAD_ATTEMPT,
+			Flags:     flags,
+			FlagNames: flagNames(flags, moduleInitFlagNames),
+			Params:    data["params"].(string),
+			Fd:        data["fd"].(int32),
+		},
+	}
+
+	return ev, nil
+}
+
+func (f *kernelModuleFilter) decodeModuleLoad(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.TelemetryEvent_KernelModule{
+		KernelModule: &api.KernelModuleEvent{
+			Type:   api.KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_LOAD,
+			Name:   data["name"].(string),
+			Taints: data["taints"].(uint32),
+		},
+	}
+
+	return ev, nil
+}
+
+func (f *kernelModuleFilter) decodeDeleteModule(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	flags := uint64(data["flags"].(uint32))
+
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.TelemetryEvent_KernelModule{
+		KernelModule: &api.KernelModuleEvent{
+			Type:      api.KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_UNLOAD_ATTEMPT,
+			Name:      data["name"].(string),
+			Flags:     flags,
+			FlagNames: flagNames(flags, moduleDeleteFlagNames),
+		},
+	}
+
+	return ev, nil
+}
+
+func (f *kernelModuleFilter) decodeModuleFree(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.TelemetryEvent_KernelModule{
+		KernelModule: &api.KernelModuleEvent{
+			Type: api.KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_UNLOAD,
+			Name: data["name"].(string),
+		},
+	}
+
+	return ev, nil
+}
+
+// parseKernelModuleEventFilter checks a kernel module event filter.
+func parseKernelModuleEventFilter(sensor *Sensor, kef *api.KernelModuleEventFilter) (*parsedFilter, error) {
+	switch kef.Type {
+	case api.KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_LOAD_ATTEMPT:
+		// The filter is used for both kprobes, so it must be valid
+		// for both of them.
+		return newParsedFilter(nil,
+			[]string{initModuleKprobeSymbol, finitModuleKprobeSymbol},
+			kef.FilterExpression, true,
+			kprobeFieldTypes(initModuleKprobeFetchargs),
+			kprobeFieldTypes(finitModuleKprobeFetchargs))
+	case api.KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_LOAD:
+		types, err := sensor.tracepointFieldTypes(moduleLoadTracepoint)
+		if err != nil {
+			return nil, err
+		}
+		return newParsedFilter([]string{moduleLoadTracepoint}, nil,
+			kef.FilterExpression, true, types)
+	case api.KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_UNLOAD_ATTEMPT:
+		return newParsedFilter(nil, []string{deleteModuleKprobeSymbol},
+			kef.FilterExpression, true,
+			kprobeFieldTypes(deleteModuleKprobeFetchargs))
+	case api.KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_UNLOAD:
+		types, err := sensor.tracepointFieldTypes(moduleFreeTracepoint)
+		if err != nil {
+			return nil, err
+		}
+		return newParsedFilter([]string{moduleFreeTracepoint}, nil,
+			kef.FilterExpression, true, types)
+	}
+	return nil, fmt.Errorf("Invalid kernel module event type %s", kef.Type)
+}
+
+type kernelModuleFilterSet struct {
+	sensor *Sensor
+
+	loadAttemptFilters   map[string]int
+	loadFilters          map[string]int
+	unloadAttemptFilters map[string]int
+	unloadFilters        map[string]int
+}
+
+func (kfs *kernelModuleFilterSet) add(kef *api.KernelModuleEventFilter) {
+	pf, err := parseKernelModuleEventFilter(kfs.sensor, kef)
+	if err != nil {
+		glog.V(1).Infof("Invalid kernel module event filter: %s", err)
+		return
+	}
+	filterString := pf.kernelFilterString()
+
+	var filters *map[string]int
+	switch kef.Type {
+	case api.KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_LOAD_ATTEMPT:
+		filters = &kfs.loadAttemptFilters
+	case api.KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_LOAD:
+		filters = &kfs.loadFilters
+	case api.KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_UNLOAD_ATTEMPT:
+		filters = &kfs.unloadAttemptFilters
+	case api.KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_UNLOAD:
+		filters = &kfs.unloadFilters
+	default:
+		return
+	}
+
+	if *filters == nil {
+		*filters = make(map[string]int)
+	}
+	(*filters)[filterString]++
+}
+
+func registerKernelModuleEvents(sensor *Sensor, eventMap subscriptionMap, events []*api.KernelModuleEventFilter) {
+	kfs := kernelModuleFilterSet{
+		sensor: sensor,
+	}
+	for _, kef := range events {
+		kfs.add(kef)
+	}
+
+	f := kernelModuleFilter{
+		sensor: sensor,
+	}
+
+	// Both load attempt kprobes share the same filters, so filters must
+	// only use fields common to both of them.
+	registerKprobe(sensor.monitor, eventMap, initModuleKprobeSymbol,
+		initModuleKprobeFetchargs, f.decodeInitModule,
+		kfs.loadAttemptFilters)
+	registerKprobe(sensor.monitor, eventMap, finitModuleKprobeSymbol,
+		finitModuleKprobeFetchargs, f.decodeFinitModule,
+		kfs.loadAttemptFilters)
+	registerEvent(sensor.monitor, eventMap, moduleLoadTracepoint,
+		f.decodeModuleLoad, kfs.loadFilters)
+	registerKprobe(sensor.monitor, eventMap, deleteModuleKprobeSymbol,
+		deleteModuleKprobeFetchargs, f.decodeDeleteModule,
+		kfs.unloadAttemptFilters)
+	registerEvent(sensor.monitor, eventMap, moduleFreeTracepoint,
+		f.decodeModuleFree, kfs.unloadFilters)
+}
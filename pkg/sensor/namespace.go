@@ -0,0 +1,294 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"fmt"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+	"github.com/golang/glog"
+)
+
+const (
+	setnsKprobeSymbol    = "sys_setns"
+	setnsKprobeFetchargs = "fd=%di:s32 nstype=%si:s32"
+
+	unshareKprobeSymbol    = "sys_unshare"
+	unshareKprobeFetchargs = "flags=%di:u64"
+
+	mountKprobeSymbol    = "sys_mount"
+	mountKprobeFetchargs = "source=+0(%di):string target=+0(%si):string fstype=+0(%dx):string flags=%cx:u64"
+
+	umountKprobeSymbol    = "sys_umount"
+	umountKprobeFetchargs = "target=+0(%di):string flags=%si:s32"
+
+	pivotRootKprobeSymbol    = "sys_pivot_root"
+	pivotRootKprobeFetchargs = "target=+0(%di):string put_old=+0(%si):string"
+)
+
+type flagName struct {
+	flag uint64
+	name string
+}
+
+// Namespace type flags from include/uapi/linux/sched.h
+var namespaceFlagNames = []flagName{
+	{0x00020000, "mnt"},    // CLONE_NEWNS
+	{0x02000000, "cgroup"}, // CLONE_NEWCGROUP
+	{0x04000000, "uts"},    // CLONE_NEWUTS
+	{0x08000000, "ipc"},    // CLONE_NEWIPC
+	{0x10000000, "user"},   // CLONE_NEWUSER
+	{0x20000000, "pid"},    // CLONE_NEWPID
+	{0x40000000, "net"},    // CLONE_NEWNET
+}
+
+// Mount flags from include/uapi/linux/fs.h
+var mountFlagNames = []flagName{
+	{1 << 0, "ro"},           // MS_RDONLY
+	{1 << 1, "nosuid"},       // MS_NOSUID
+	{1 << 2, "nodev"},        // MS_NODEV
+	{1 << 3, "noexec"},       // MS_NOEXEC
+	{1 << 4, "sync"},         // MS_SYNCHRONOUS
+	{1 << 5, "remount"},      // MS_REMOUNT
+	{1 << 6, "mand"},         // MS_MANDLOCK
+	{1 << 7, "dirsync"},      // MS_DIRSYNC
+	{1 << 10, "noatime"},     // MS_NOATIME
+	{1 << 11, "nodiratime"},  // MS_NODIRATIME
+	{1 << 12, "bind"},        // MS_BIND
+	{1 << 13, "move"},        // MS_MOVE
+	{1 << 14, "rec"},         // MS_REC
+	{1 << 15, "silent"},      // MS_SILENT
+	{1 << 17, "unbindable"},  // MS_UNBINDABLE
+	{1 << 18, "private"},     // MS_PRIVATE
+	{1 << 19, "slave"},       // MS_SLAVE
+	{1 << 20, "shared"},      // MS_SHARED
+	{1 << 21, "relatime"},    // MS_RELATIME
+	{1 << 24, "strictatime"}, // MS_STRICTATIME
+	{1 << 25, "lazytime"},    // MS_LAZYTIME
+}
+
+// Unmount flags from include/linux/fs.h
+var umountFlagNames = []flagName{
+	{0x00000001, "force"},    // MNT_FORCE
+	{0x00000002, "detach"},   // MNT_DETACH
+	{0x00000004, "expire"},   // MNT_EXPIRE
+	{0x00000008, "nofollow"}, // UMOUNT_NOFOLLOW
+}
+
+// flagNames returns the names of the flags set in flags.
+func flagNames(flags uint64, names []flagName) []string {
+	var result []string
+	for _, n := range names {
+		if flags&n.flag != 0 {
+			result = append(result, n.name)
+		}
+	}
+	return result
+}
+
+type namespaceFilter struct {
+	sensor *Sensor
+}
+
+func (f *namespaceFilter) decodeSetns(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	flags := uint64(uint32(data["nstype"].(int32)))
+
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.TelemetryEvent_Namespace{
+		Namespace: &api.NamespaceEvent{
+			Type:       api.NamespaceEventType_NAMESPACE_EVENT_TYPE_SETNS,
+			Flags:      flags,
+			Namespaces: flagNames(flags, namespaceFlagNames),
+			Fd:         data["fd"].(int32),
+		},
+	}
+
+	return ev, nil
+}
+
+func (f *namespaceFilter) decodeUnshare(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	flags := data["flags"].(uint64)
+
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.TelemetryEvent_Namespace{
+		Namespace: &api.NamespaceEvent{
+			Type:       api.NamespaceEventType_NAMESPACE_EVENT_TYPE_UNSHARE,
+			Flags:      flags,
+			Namespaces: flagNames(flags, namespaceFlagNames),
+		},
+	}
+
+	return ev, nil
+}
+
+func (f *namespaceFilter) decodeMount(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	flags := data["flags"].(uint64)
+
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.TelemetryEvent_Mount{
+		Mount: &api.MountEvent{
+			Type:      api.MountEventType_MOUNT_EVENT_TYPE_MOUNT,
+			Source:    data["source"].(string),
+			Target:    data["target"].(string),
+			Fstype:    data["fstype"].(string),
+			Flags:     flags,
+			FlagNames: flagNames(flags, mountFlagNames),
+		},
+	}
+
+	return ev, nil
+}
+
+func (f *namespaceFilter) decodeUmount(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	flags := uint64(uint32(data["flags"].(int32)))
+
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.TelemetryEvent_Mount{
+		Mount: &api.MountEvent{
+			Type:      api.MountEventType_MOUNT_EVENT_TYPE_UMOUNT,
+			Target:    data["target"].(string),
+			Flags:     flags,
+			FlagNames: flagNames(flags, umountFlagNames),
+		},
+	}
+
+	return ev, nil
+}
+
+func (f *namespaceFilter) decodePivotRoot(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.TelemetryEvent_Mount{
+		Mount: &api.MountEvent{
+			Type:   api.MountEventType_MOUNT_EVENT_TYPE_PIVOT_ROOT,
+			Target: data["target"].(string),
+			PutOld: data["put_old"].(string),
+		},
+	}
+
+	return ev, nil
+}
+
+type namespaceFilterSet struct {
+	setnsFilters     map[string]int
+	unshareFilters   map[string]int
+	mountFilters     map[string]int
+	umountFilters    map[string]int
+	pivotRootFilters map[string]int
+}
+
+// parseNamespaceEventFilter checks a namespace event filter.
+func parseNamespaceEventFilter(nef *api.NamespaceEventFilter) (*parsedFilter, error) {
+	var symbol, fetchargs string
+	switch nef.Type {
+	case api.NamespaceEventType_NAMESPACE_EVENT_TYPE_SETNS:
+		symbol, fetchargs = setnsKprobeSymbol, setnsKprobeFetchargs
+	case api.NamespaceEventType_NAMESPACE_EVENT_TYPE_UNSHARE:
+		symbol, fetchargs = unshareKprobeSymbol, unshareKprobeFetchargs
+	default:
+		return nil, fmt.Errorf("Invalid namespace event type %s", nef.Type)
+	}
+
+	return newParsedFilter(nil, []string{symbol}, nef.FilterExpression,
+		true, kprobeFieldTypes(fetchargs))
+}
+
+// parseMountEventFilter checks a mount event filter.
+func parseMountEventFilter(mef *api.MountEventFilter) (*parsedFilter, error) {
+	var symbol, fetchargs string
+	switch mef.Type {
+	case api.MountEventType_MOUNT_EVENT_TYPE_MOUNT:
+		symbol, fetchargs = mountKprobeSymbol, mountKprobeFetchargs
+	case api.MountEventType_MOUNT_EVENT_TYPE_UMOUNT:
+		symbol, fetchargs = umountKprobeSymbol, umountKprobeFetchargs
+	case api.MountEventType_MOUNT_EVENT_TYPE_PIVOT_ROOT:
+		symbol, fetchargs = pivotRootKprobeSymbol, pivotRootKprobeFetchargs
+	default:
+		return nil, fmt.Errorf("Invalid mount event type %s", mef.Type)
+	}
+
+	return newParsedFilter(nil, []string{symbol}, mef.FilterExpression,
+		true, kprobeFieldTypes(fetchargs))
+}
+
+func (nfs *namespaceFilterSet) addFilter(filters *map[string]int, pf *parsedFilter) {
+	if *filters == nil {
+		*filters = make(map[string]int)
+	}
+	(*filters)[pf.kernelFilterString()]++
+}
+
+func (nfs *namespaceFilterSet) addNamespaceFilter(nef *api.NamespaceEventFilter) {
+	pf, err := parseNamespaceEventFilter(nef)
+	if err != nil {
+		glog.V(1).Infof("Invalid namespace event filter: %s", err)
+		return
+	}
+
+	switch nef.Type {
+	case api.NamespaceEventType_NAMESPACE_EVENT_TYPE_SETNS:
+		nfs.addFilter(&nfs.setnsFilters, pf)
+	case api.NamespaceEventType_NAMESPACE_EVENT_TYPE_UNSHARE:
+		nfs.addFilter(&nfs.unshareFilters, pf)
+	}
+}
+
+func (nfs *namespaceFilterSet) addMountFilter(mef *api.MountEventFilter) {
+	pf, err := parseMountEventFilter(mef)
+	if err != nil {
+		glog.V(1).Infof("Invalid mount event filter: %s", err)
+		return
+	}
+
+	switch mef.Type {
+	case api.MountEventType_MOUNT_EVENT_TYPE_MOUNT:
+		nfs.addFilter(&nfs.mountFilters, pf)
+	case api.MountEventType_MOUNT_EVENT_TYPE_UMOUNT:
+		nfs.addFilter(&nfs.umountFilters, pf)
+	case api.MountEventType_MOUNT_EVENT_TYPE_PIVOT_ROOT:
+		nfs.addFilter(&nfs.pivotRootFilters, pf)
+	}
+}
+
+func registerNamespaceEvents(
+	sensor *Sensor,
+	eventMap subscriptionMap,
+	namespaceEvents []*api.NamespaceEventFilter,
+	mountEvents []*api.MountEventFilter,
+) {
+	nfs := namespaceFilterSet{}
+	for _, nef := range namespaceEvents {
+		nfs.addNamespaceFilter(nef)
+	}
+	for _, mef := range mountEvents {
+		nfs.addMountFilter(mef)
+	}
+
+	f := namespaceFilter{
+		sensor: sensor,
+	}
+
+	registerKprobe(sensor.monitor, eventMap, setnsKprobeSymbol,
+		setnsKprobeFetchargs, f.decodeSetns, nfs.setnsFilters)
+	registerKprobe(sensor.monitor, eventMap, unshareKprobeSymbol,
+		unshareKprobeFetchargs, f.decodeUnshare, nfs.unshareFilters)
+	registerKprobe(sensor.monitor, eventMap, mountKprobeSymbol,
+		mountKprobeFetchargs, f.decodeMount, nfs.mountFilters)
+	registerKprobe(sensor.monitor, eventMap, umountKprobeSymbol,
+		umountKprobeFetchargs, f.decodeUmount, nfs.umountFilters)
+	registerKprobe(sensor.monitor, eventMap, pivotRootKprobeSymbol,
+		pivotRootKprobeFetchargs, f.decodePivotRoot, nfs.pivotRootFilters)
+}
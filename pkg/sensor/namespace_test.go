@@ -0,0 +1,86 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"reflect"
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+)
+
+func TestFlagNames(t *testing.T) {
+	// CLONE_NEWNS | CLONE_NEWPID | CLONE_NEWNET
+	names := flagNames(0x60020000, namespaceFlagNames)
+	expected := []string{"mnt", "pid", "net"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected %v, got %v", expected, names)
+	}
+
+	// MS_RDONLY | MS_BIND | MS_REC
+	names = flagNames(0x5001, mountFlagNames)
+	expected = []string{"ro", "bind", "rec"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected %v, got %v", expected, names)
+	}
+
+	if names = flagNames(0, umountFlagNames); names != nil {
+		t.Errorf("Expected no flag names, got %v", names)
+	}
+}
+
+func TestNamespaceFilterSet(t *testing.T) {
+	nfs := namespaceFilterSet{}
+	nfs.addNamespaceFilter(&api.NamespaceEventFilter{
+		Type: api.NamespaceEventType_NAMESPACE_EVENT_TYPE_SETNS,
+		FilterExpression: expression.Equal(
+			expression.Identifier("nstype"),
+			expression.Value(int32(0x40000000))),
+	})
+	nfs.addNamespaceFilter(&api.NamespaceEventFilter{
+		Type: api.NamespaceEventType_NAMESPACE_EVENT_TYPE_UNSHARE,
+	})
+	nfs.addMountFilter(&api.MountEventFilter{
+		Type: api.MountEventType_MOUNT_EVENT_TYPE_MOUNT,
+		FilterExpression: expression.Equal(
+			expression.Identifier("fstype"),
+			expression.Value("overlay")),
+	})
+
+	// Not valid as a kernel filter
+	nfs.addMountFilter(&api.MountEventFilter{
+		Type: api.MountEventType_MOUNT_EVENT_TYPE_UMOUNT,
+		FilterExpression: expression.IsNull(
+			expression.Identifier("target")),
+	})
+
+	if len(nfs.setnsFilters) != 1 || nfs.setnsFilters["nstype == 1073741824"] != 1 {
+		t.Errorf("Unexpected setns filters %v", nfs.setnsFilters)
+	}
+	if f, active := fullFilterString(nfs.unshareFilters); !active || f != "" {
+		t.Errorf("Expected wildcard unshare filter, got %q", f)
+	}
+	if len(nfs.mountFilters) != 1 || nfs.mountFilters[`fstype == "overlay"`] != 1 {
+		t.Errorf("Unexpected mount filters %v", nfs.mountFilters)
+	}
+	if _, active := fullFilterString(nfs.umountFilters); active {
+		t.Errorf("Unexpected umount filters %v", nfs.umountFilters)
+	}
+	if _, active := fullFilterString(nfs.pivotRootFilters); active {
+		t.Errorf("Unexpected pivot_root filters %v", nfs.pivotRootFilters)
+	}
+}
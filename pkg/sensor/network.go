@@ -188,6 +188,8 @@ func (f *networkFilter) decodeSysExitSendto(sample *perf.SampleRecord, data perf
 }
 
 type networkFilterSet struct {
+	sensor *Sensor
+
 	acceptAttemptFilters   map[string]int
 	acceptResultFilters    map[string]int
 	bindAttemptFilters     map[string]int
@@ -202,19 +204,85 @@ type networkFilterSet struct {
 	recvfromResultFilters  map[string]int
 }
 
-func (nfs *networkFilterSet) add(nef *api.NetworkEventFilter) {
-	var filterString string
-
-	if nef.FilterExpression != nil {
-		expr, err := expression.NewExpression(nef.FilterExpression)
-		if err != nil {
-			glog.V(1).Infof("Bad network filter expression: %s", err)
-			return
+// parseNetworkEventFilter checks a network event filter.
+func parseNetworkEventFilter(sensor *Sensor, nef *api.NetworkEventFilter) (*parsedFilter, error) {
+	var (
+		tracepoints, kprobes []string
+		types                expression.FieldTypeMap
+	)
+	switch nef.Type {
+	case api.NetworkEventType_NETWORK_EVENT_TYPE_ACCEPT_ATTEMPT:
+		tracepoints = []string{
+			"syscalls/sys_enter_accept",
+			"syscalls/sys_enter_accept4",
+		}
+	case api.NetworkEventType_NETWORK_EVENT_TYPE_ACCEPT_RESULT:
+		tracepoints = []string{
+			"syscalls/sys_exit_accept",
+			"syscalls/sys_exit_accept4",
+		}
+	case api.NetworkEventType_NETWORK_EVENT_TYPE_BIND_ATTEMPT:
+		kprobes = []string{networkKprobeBindSymbol}
+		types = kprobeFieldTypes(networkKprobeBindFetchargs)
+	case api.NetworkEventType_NETWORK_EVENT_TYPE_BIND_RESULT:
+		tracepoints = []string{"syscalls/sys_exit_bind"}
+	case api.NetworkEventType_NETWORK_EVENT_TYPE_CONNECT_ATTEMPT:
+		kprobes = []string{networkKprobeConnectSymbol}
+		types = kprobeFieldTypes(networkKprobeConnectFetchargs)
+	case api.NetworkEventType_NETWORK_EVENT_TYPE_CONNECT_RESULT:
+		tracepoints = []string{"syscalls/sys_exit_connect"}
+	case api.NetworkEventType_NETWORK_EVENT_TYPE_LISTEN_ATTEMPT:
+		tracepoints = []string{"syscalls/sys_enter_listen"}
+	case api.NetworkEventType_NETWORK_EVENT_TYPE_LISTEN_RESULT:
+		tracepoints = []string{"syscalls/sys_exit_listen"}
+	case api.NetworkEventType_NETWORK_EVENT_TYPE_RECVFROM_ATTEMPT:
+		tracepoints = []string{
+			"syscalls/sys_enter_recvfrom",
+			"syscalls/sys_enter_recvmsg",
+		}
+	case api.NetworkEventType_NETWORK_EVENT_TYPE_RECVFROM_RESULT:
+		tracepoints = []string{
+			"syscalls/sys_exit_recvfrom",
+			"syscalls/sys_exit_recvmsg",
+		}
+	case api.NetworkEventType_NETWORK_EVENT_TYPE_SENDTO_ATTEMPT:
+		kprobes = []string{
+			networkKprobeSendmsgSymbol,
+			networkKprobeSendtoSymbol,
+		}
+		// Both kprobes have the same fields
+		types = kprobeFieldTypes(networkKprobeSendtoFetchargs)
+	case api.NetworkEventType_NETWORK_EVENT_TYPE_SENDTO_RESULT:
+		tracepoints = []string{
+			"syscalls/sys_exit_sendmsg",
+			"syscalls/sys_exit_sendto",
 		}
+	default:
+		return nil, fmt.Errorf("Invalid network event type %s", nef.Type)
+	}
 
-		filterString = expr.KernelFilterString()
+	// The filter is used for each of the tracepoints, so it must be
+	// valid for all of them.
+	typeMaps, err := sensor.tracepointsFieldTypes(tracepoints)
+	if err != nil {
+		return nil, err
+	}
+	if types != nil {
+		typeMaps = append(typeMaps, types)
 	}
 
+	return newParsedFilter(tracepoints, kprobes, nef.FilterExpression,
+		true, typeMaps...)
+}
+
+func (nfs *networkFilterSet) add(nef *api.NetworkEventFilter) {
+	pf, err := parseNetworkEventFilter(nfs.sensor, nef)
+	if err != nil {
+		glog.V(1).Infof("Invalid network event filter: %s", err)
+		return
+	}
+	filterString := pf.kernelFilterString()
+
 	switch nef.Type {
 	case api.NetworkEventType_NETWORK_EVENT_TYPE_ACCEPT_ATTEMPT:
 		if nfs.acceptAttemptFilters == nil {
@@ -322,14 +390,16 @@ func registerKprobe(monitor *perf.EventMonitor, eventMap subscriptionMap, symbol
 	eventID, err := monitor.RegisterKprobe(symbol, false, fetchargs, fn,
 		perf.WithFilter(f))
 	if err != nil {
-		glog.Warningf("Could not register network kprobe %s", symbol)
+		glog.Warningf("Could not register kprobe %s: %v", symbol, err)
 	} else {
 		eventMap.subscribe(eventID)
 	}
 }
 
 func registerNetworkEvents(sensor *Sensor, eventMap subscriptionMap, events []*api.NetworkEventFilter) {
-	nfs := networkFilterSet{}
+	nfs := networkFilterSet{
+		sensor: sensor,
+	}
 	for _, nef := range events {
 		nfs.add(nef)
 	}
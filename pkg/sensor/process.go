@@ -15,14 +15,15 @@
 package sensor
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"syscall"
 
 	api "github.com/capsule8/capsule8/api/v0"
 
+	"github.com/capsule8/capsule8/pkg/config"
 	"github.com/capsule8/capsule8/pkg/expression"
-	"github.com/capsule8/capsule8/pkg/sys"
 	"github.com/capsule8/capsule8/pkg/sys/perf"
 	"github.com/golang/glog"
 
@@ -36,6 +37,10 @@ const (
 
 type processFilter struct {
 	sensor *Sensor
+
+	// The external event that exit events are reported as once their
+	// resource usage has been read
+	exitEventID uint64
 }
 
 func (f *processFilter) decodeSchedProcessFork(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
@@ -60,14 +65,18 @@ func (f *processFilter) decodeSchedProcessExec(sample *perf.SampleRecord, data p
 	hostPid := data["common_pid"].(int32)
 	filename := data["filename"].(string)
 
-	// Get the command-line from the process info cache. If it's not there
-	// for whatever reason, fallback to using procfs
-	var commandLine []string
+	// Get the command-line and environment from the process info cache,
+	// where they're updated by the exec kprobes before this tracepoint
+	// fires. Don't read them from procfs here, since that would block
+	// the dispatch of every other event.
+	var (
+		commandLine []string
+		environment map[string]string
+	)
 	_, l, _ := f.sensor.ProcessCache.LookupTaskAndLeader(int(hostPid))
-	if l == nil || l.CommandLine == nil || len(l.CommandLine) == 0 {
-		commandLine = sys.HostProcFS().CommandLine(int(hostPid))
-	} else {
+	if l != nil {
 		commandLine = l.CommandLine
+		environment = l.Environment
 	}
 
 	ev := f.sensor.NewEventFromSample(sample, data)
@@ -75,6 +84,15 @@ func (f *processFilter) decodeSchedProcessExec(sample *perf.SampleRecord, data p
 		Type:            api.ProcessEventType_PROCESS_EVENT_TYPE_EXEC,
 		ExecFilename:    filename,
 		ExecCommandLine: commandLine,
+		ExecEnvironment: environment,
+	}
+
+	if h := f.sensor.execHasher; h != nil {
+		var processID string
+		if l != nil {
+			processID = l.ProcessID()
+		}
+		h.exec(int(hostPid), processID, filename)
 	}
 
 	ev.Event = &api.TelemetryEvent_Process{
@@ -84,6 +102,10 @@ func (f *processFilter) decodeSchedProcessExec(sample *perf.SampleRecord, data p
 	return ev, nil
 }
 
+// decodeDoExit creates the exit event for a do_exit sample, but doesn't
+// return it. The event is queued to have the resource usage of the exiting
+// task read from /proc off of the dispatch path, and it is dispatched as an
+// external event once that's done.
 func (f *processFilter) decodeDoExit(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
 	var exitStatus int
 	var exitSignal syscall.Signal
@@ -104,15 +126,36 @@ func (f *processFilter) decodeDoExit(sample *perf.SampleRecord, data perf.TraceE
 	ev := f.sensor.NewEventFromSample(sample, data)
 	ev.Event = &api.TelemetryEvent_Process{
 		Process: &api.ProcessEvent{
-			Type:           api.ProcessEventType_PROCESS_EVENT_TYPE_EXIT,
-			ExitCode:       int32(code),
-			ExitStatus:     uint32(exitStatus),
-			ExitSignal:     uint32(exitSignal),
-			ExitCoreDumped: coreDumped,
+			Type:              api.ProcessEventType_PROCESS_EVENT_TYPE_EXIT,
+			ExitCode:          int32(code),
+			ExitStatus:        uint32(exitStatus),
+			ExitSignal:        uint32(exitSignal),
+			ExitCoreDumped:    coreDumped,
+			ExitResourceUsage: &api.ResourceUsage{},
 		},
 	}
 
-	return ev, nil
+	e := pendingExit{
+		eventID: f.exitEventID,
+		event:   ev,
+		code:    code,
+		tid:     int(ev.ProcessPid),
+		tgid:    int(ev.ProcessPid),
+	}
+
+	// The task's lifetime is known from the process info cache even if
+	// its usage can't be read from /proc.
+	if t, ok := f.sensor.ProcessCache.LookupTask(e.tid); ok && t.StartTime != 0 {
+		e.tgid = t.TGID
+		e.forkTime = t.StartTime
+		if int64(sample.Time) > t.StartTime {
+			ev.GetProcess().ExitResourceUsage.LifetimeNanos =
+				uint64(int64(sample.Time) - t.StartTime)
+		}
+	}
+
+	f.sensor.exitUsage.exit(e)
+	return nil, nil
 }
 
 func processFilterString(wildcard bool, filters map[string]bool) string {
@@ -158,56 +201,101 @@ func rewriteProcessEventFilter(pef *api.ProcessEventFilter) {
 	}
 }
 
+// parseProcessEventFilter checks a process event filter. Deprecated fields
+// must already have been translated into the filter expression.
+func parseProcessEventFilter(sensor *Sensor, pef *api.ProcessEventFilter) (*parsedFilter, error) {
+	switch pef.Type {
+	case api.ProcessEventType_PROCESS_EVENT_TYPE_FORK:
+		if pef.FilterExpression != nil {
+			return nil, errors.New("Fork events cannot be filtered")
+		}
+		return &parsedFilter{
+			tracepoints: []string{"sched/sched_process_fork"},
+		}, nil
+	case api.ProcessEventType_PROCESS_EVENT_TYPE_EXEC:
+		name := "sched/sched_process_exec"
+		types, err := sensor.tracepointFieldTypes(name)
+		if err != nil {
+			return nil, err
+		}
+		return newParsedFilter([]string{name}, nil,
+			pef.FilterExpression, true, types)
+	case api.ProcessEventType_PROCESS_EVENT_TYPE_EXIT:
+		return newParsedFilter(nil, []string{exitSymbol},
+			pef.FilterExpression, true, kprobeFieldTypes(exitFetchargs))
+	case api.ProcessEventType_PROCESS_EVENT_TYPE_CREDENTIALS_CHANGED:
+		// Credential changes are reported by the process info cache,
+		// so they are only filtered by the sensor.
+		return newParsedFilter(nil, nil, pef.FilterExpression, false,
+			credentialsChangedEventTypes)
+	case api.ProcessEventType_PROCESS_EVENT_TYPE_EXEC_HASHED:
+		if !config.Sensor.ExecHash {
+			return nil, errors.New("Executable hashing is not enabled")
+		}
+		return newParsedFilter(nil, nil, pef.FilterExpression, false,
+			execHashedEventTypes)
+	}
+	return nil, fmt.Errorf("Invalid process event type %s", pef.Type)
+}
+
 func registerProcessEvents(sensor *Sensor, eventMap subscriptionMap, events []*api.ProcessEventFilter) {
 	forkFilter := false
 	execFilters := make(map[string]bool)
 	execWildcard := false
 	exitFilters := make(map[string]bool)
 	exitWildcard := false
+	credsFilter := false
+	var credsExpr *api.Expression
+	hashedFilter := false
+	var hashedExpr *api.Expression
 
 	for _, pef := range events {
 		// Translate deprecated fields into an expression
 		rewriteProcessEventFilter(pef)
 
+		pf, err := parseProcessEventFilter(sensor, pef)
+		if err != nil {
+			glog.V(1).Infof("Invalid process event filter: %s", err)
+			continue
+		}
+
 		switch pef.Type {
 		case api.ProcessEventType_PROCESS_EVENT_TYPE_FORK:
 			forkFilter = true
 		case api.ProcessEventType_PROCESS_EVENT_TYPE_EXEC:
-			if pef.FilterExpression == nil {
+			if pf.expr == nil {
 				execWildcard = true
 			} else {
-				expr, err := expression.NewExpression(pef.FilterExpression)
-				if err != nil {
-					glog.V(1).Infof("Invalid process event filter: %s", err)
-					continue
-				}
-				err = expr.ValidateKernelFilter()
-				if err != nil {
-					glog.V(1).Infof("Invalid process event filter as kernel filter: %s", err)
-					continue
-				}
-				s := expr.KernelFilterString()
-				execFilters[s] = true
+				execFilters[pf.kernelFilterString()] = true
 			}
 		case api.ProcessEventType_PROCESS_EVENT_TYPE_EXIT:
-			if pef.FilterExpression == nil {
+			if pf.expr == nil {
 				exitWildcard = true
 			} else {
-				expr, err := expression.NewExpression(pef.FilterExpression)
-				if err != nil {
-					glog.V(1).Infof("Invalid process event filter: %s", err)
-					continue
-				}
-				err = expr.ValidateKernelFilter()
-				if err != nil {
-					glog.V(1).Infof("Invalid process event filter as kernel filter: %s", err)
-					continue
-				}
-				s := expr.KernelFilterString()
-				exitFilters[s] = true
+				exitFilters[pf.kernelFilterString()] = true
+			}
+		case api.ProcessEventType_PROCESS_EVENT_TYPE_CREDENTIALS_CHANGED:
+			// Credential changes are reported by the process info
+			// cache, so they are only filtered by the sensor.
+			if pef.FilterExpression == nil {
+				credsFilter = true
+				credsExpr = nil
+			} else if !credsFilter || credsExpr != nil {
+				credsFilter = true
+				credsExpr = expression.LogicalOr(credsExpr,
+					pef.FilterExpression)
+			}
+		case api.ProcessEventType_PROCESS_EVENT_TYPE_EXEC_HASHED:
+			// Executable hashes are reported by the sensor, so they
+			// are only filtered by the sensor.
+			if pef.FilterExpression == nil {
+				hashedFilter = true
+				hashedExpr = nil
+			} else if !hashedFilter || hashedExpr != nil {
+				hashedFilter = true
+				hashedExpr = expression.LogicalOr(hashedExpr,
+					pef.FilterExpression)
 			}
-		default:
-			continue
 		}
 	}
 
@@ -244,15 +332,82 @@ func registerProcessEvents(sensor *Sensor, eventMap subscriptionMap, events []*a
 
 	if exitWildcard || len(exitFilters) > 0 {
 		filterString := processFilterString(exitWildcard, exitFilters)
+		registerExitEvents(sensor, eventMap, filterString)
+	}
 
-		eventID, err := sensor.monitor.RegisterKprobe(exitSymbol,
-			false, exitFetchargs, f.decodeDoExit,
-			perf.WithFilter(filterString))
-		if err != nil {
-			glog.Errorf("Couldn't register kprobe for %s: %s",
-				exitSymbol, err)
-		} else {
-			eventMap.subscribe(eventID)
-		}
+	if credsFilter {
+		registerCredentialsChangedEvents(sensor, eventMap, credsExpr)
+	}
+
+	if hashedFilter {
+		registerExecHashedEvents(sensor, eventMap, hashedExpr)
 	}
 }
+
+// registerExitEvents registers a do_exit kprobe with the given kernel filter,
+// and the external event that its samples are reported as once the resource
+// usage of the exiting task has been read. Both are owned by the
+// subscription.
+func registerExitEvents(
+	sensor *Sensor,
+	eventMap subscriptionMap,
+	filterString string,
+) {
+	exitEventID, err := sensor.monitor.RegisterExternalEvent(
+		"PROCESS_EXIT", decodeExit, kprobeFieldTypes(exitFetchargs))
+	if err != nil {
+		glog.Errorf("Failed to register external event: %s", err)
+		return
+	}
+
+	f := processFilter{
+		sensor:      sensor,
+		exitEventID: exitEventID,
+	}
+	eventID, err := sensor.monitor.RegisterKprobe(exitSymbol,
+		false, exitFetchargs, f.decodeDoExit,
+		perf.WithFilter(filterString))
+	if err != nil {
+		glog.Errorf("Couldn't register kprobe for %s: %s",
+			exitSymbol, err)
+		sensor.monitor.UnregisterEvent(exitEventID)
+		return
+	}
+
+	eventMap.subscribe(eventID)
+	s := eventMap.subscribe(exitEventID)
+	s.unregister = func(eventID uint64, _ *subscription) {
+		sensor.monitor.UnregisterEvent(eventID)
+	}
+}
+
+func registerCredentialsChangedEvents(
+	sensor *Sensor,
+	eventMap subscriptionMap,
+	filter *api.Expression,
+) {
+	expr, err := parseFilterExpression(filter, false,
+		credentialsChangedEventTypes)
+	if err != nil {
+		glog.V(1).Infof("Invalid process event filter: %s", err)
+		return
+	}
+
+	s := eventMap.subscribe(sensor.ProcessCache.CredentialsChangedEventID)
+	s.filter = expr
+}
+
+func registerExecHashedEvents(
+	sensor *Sensor,
+	eventMap subscriptionMap,
+	filter *api.Expression,
+) {
+	expr, err := parseFilterExpression(filter, false, execHashedEventTypes)
+	if err != nil {
+		glog.V(1).Infof("Invalid process event filter: %s", err)
+		return
+	}
+
+	s := eventMap.subscribe(sensor.execHashedEventID)
+	s.filter = expr
+}
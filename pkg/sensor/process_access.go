@@ -0,0 +1,287 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"fmt"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+	"github.com/golang/glog"
+)
+
+const (
+	ptraceKprobeSymbol    = "sys_ptrace"
+	ptraceKprobeFetchargs = "request=%di:s64 pid=%si:s32"
+
+	processVMReadvKprobeSymbol  = "sys_process_vm_readv"
+	processVMWritevKprobeSymbol = "sys_process_vm_writev"
+	processVMKprobeFetchargs    = "pid=%di:s32"
+)
+
+// PTRACE_TRACEME
+const ptraceTracemeRequest int64 = 0
+
+// ptrace requests from include/uapi/linux/ptrace.h and
+// arch/x86/include/uapi/asm/ptrace-abi.h
+var ptraceRequestNames = map[int64]string{
+	0:      "traceme",
+	1:      "peektext",
+	2:      "peekdata",
+	3:      "peekuser",
+	4:      "poketext",
+	5:      "pokedata",
+	6:      "pokeuser",
+	7:      "cont",
+	8:      "kill",
+	9:      "singlestep",
+	12:     "getregs",
+	13:     "setregs",
+	14:     "getfpregs",
+	15:     "setfpregs",
+	16:     "attach",
+	17:     "detach",
+	18:     "getfpxregs",
+	19:     "setfpxregs",
+	24:     "syscall",
+	0x4200: "setoptions",
+	0x4201: "geteventmsg",
+	0x4202: "getsiginfo",
+	0x4203: "setsiginfo",
+	0x4204: "getregset",
+	0x4205: "setregset",
+	0x4206: "seize",
+	0x4207: "interrupt",
+	0x4208: "listen",
+	0x4209: "peeksiginfo",
+}
+
+var processAccessEventTypes = expression.FieldTypeMap{
+	"tracer_pid":          int32(api.ValueType_SINT32),
+	"tracee_pid":          int32(api.ValueType_SINT32),
+	"request":             int32(api.ValueType_SINT64),
+	"tracer_container_id": int32(api.ValueType_STRING),
+	"tracee_container_id": int32(api.ValueType_STRING),
+	"cross_process":       int32(api.ValueType_BOOL),
+	"cross_container":     int32(api.ValueType_BOOL),
+}
+
+func processAccessData(ev *api.ProcessAccessEvent) expression.FieldValueMap {
+	data := expression.FieldValueMap{
+		"tracer_pid":          ev.TracerPid,
+		"tracee_pid":          ev.TraceePid,
+		"tracer_container_id": ev.TracerContainerId,
+		"tracee_container_id": ev.TraceeContainerId,
+		"cross_process":       ev.CrossProcess,
+		"cross_container":     ev.CrossContainer,
+	}
+	if ev.Type == api.ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_PTRACE {
+		data["request"] = ev.Request
+	}
+	return data
+}
+
+type processAccessFilter struct {
+	sensor *Sensor
+
+	// Filters for each event type, evaluated by the sensor because the
+	// container IDs are not known to the kernel. A nil expression
+	// matches all events of its type.
+	filters map[api.ProcessAccessEventType][]*expression.Expression
+}
+
+// taskProcessInfo returns the TGID and container ID of the process
+// containing the task with the given PID. The TGID is 0 if the task is not
+// known.
+func (f *processAccessFilter) taskProcessInfo(pid int32) (int, string) {
+	_, leader, ok := f.sensor.ProcessCache.LookupTaskAndLeader(int(pid))
+	if !ok || leader == nil {
+		return 0, ""
+	}
+	if i := f.sensor.ProcessCache.LookupTaskContainerInfo(leader); i != nil {
+		return leader.TGID, i.ID
+	}
+	return leader.TGID, leader.ContainerID
+}
+
+// newProcessAccessEvent creates a ProcessAccessEvent for an access by tracer
+// to tracee, resolving the processes and containers of both.
+func (f *processAccessFilter) newProcessAccessEvent(
+	eventType api.ProcessAccessEventType,
+	tracerPid, traceePid int32,
+) *api.ProcessAccessEvent {
+	tracerTGID, tracerContainerID := f.taskProcessInfo(tracerPid)
+	traceeTGID, traceeContainerID := f.taskProcessInfo(traceePid)
+
+	return &api.ProcessAccessEvent{
+		Type:              eventType,
+		TracerPid:         tracerPid,
+		TraceePid:         traceePid,
+		TracerContainerId: tracerContainerID,
+		TraceeContainerId: traceeContainerID,
+		CrossProcess: tracerPid != traceePid &&
+			(tracerTGID == 0 || tracerTGID != traceeTGID),
+		CrossContainer: tracerContainerID != traceeContainerID,
+	}
+}
+
+func (f *processAccessFilter) match(ev *api.ProcessAccessEvent) bool {
+	var data expression.FieldValueMap
+	for _, expr := range f.filters[ev.Type] {
+		if expr == nil {
+			return true
+		}
+		if data == nil {
+			data = processAccessData(ev)
+		}
+		v, err := expr.Evaluate(processAccessEventTypes, data)
+		if err != nil {
+			glog.V(1).Infof("Expression evaluation error: %s", err)
+			continue
+		}
+		if expression.IsValueTrue(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *processAccessFilter) newEvent(
+	sample *perf.SampleRecord,
+	data perf.TraceEventSampleData,
+	pae *api.ProcessAccessEvent,
+) (interface{}, error) {
+	if !f.match(pae) {
+		return nil, nil
+	}
+
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.TelemetryEvent_ProcessAccess{
+		ProcessAccess: pae,
+	}
+
+	return ev, nil
+}
+
+func (f *processAccessFilter) decodePtrace(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	request := data["request"].(int64)
+	tracerPid := data["common_pid"].(int32)
+	traceePid := data["pid"].(int32)
+
+	if request == ptraceTracemeRequest {
+		// The calling task is asking to be traced by its parent
+		traceePid = tracerPid
+		tracerPid = 0
+		if _, leader, ok := f.sensor.ProcessCache.LookupTaskAndLeader(int(traceePid)); ok && leader != nil {
+			tracerPid = int32(leader.PPID)
+		}
+	}
+
+	pae := f.newProcessAccessEvent(
+		api.ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_PTRACE,
+		tracerPid, traceePid)
+	pae.Request = request
+	pae.RequestName = ptraceRequestNames[request]
+
+	return f.newEvent(sample, data, pae)
+}
+
+func (f *processAccessFilter) decodeProcessVMReadv(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	pae := f.newProcessAccessEvent(
+		api.ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_VM_READ,
+		data["common_pid"].(int32), data["pid"].(int32))
+
+	return f.newEvent(sample, data, pae)
+}
+
+func (f *processAccessFilter) decodeProcessVMWritev(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	pae := f.newProcessAccessEvent(
+		api.ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_VM_WRITE,
+		data["common_pid"].(int32), data["pid"].(int32))
+
+	return f.newEvent(sample, data, pae)
+}
+
+// parseProcessAccessEventFilter checks a process access event filter.
+func parseProcessAccessEventFilter(pef *api.ProcessAccessEventFilter) (*parsedFilter, error) {
+	var symbol string
+	switch pef.Type {
+	case api.ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_PTRACE:
+		symbol = ptraceKprobeSymbol
+	case api.ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_VM_READ:
+		symbol = processVMReadvKprobeSymbol
+	case api.ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_VM_WRITE:
+		symbol = processVMWritevKprobeSymbol
+	default:
+		return nil, fmt.Errorf("Invalid process access event type %s",
+			pef.Type)
+	}
+
+	// Container IDs are resolved by the sensor, so process access events
+	// are only filtered by the sensor.
+	return newParsedFilter(nil, []string{symbol}, pef.FilterExpression,
+		false, processAccessEventTypes)
+}
+
+func (f *processAccessFilter) add(pef *api.ProcessAccessEventFilter) {
+	pf, err := parseProcessAccessEventFilter(pef)
+	if err != nil {
+		glog.V(1).Infof("Invalid process access event filter: %s", err)
+		return
+	}
+
+	if f.filters == nil {
+		f.filters = make(map[api.ProcessAccessEventType][]*expression.Expression)
+	}
+	f.filters[pef.Type] = append(f.filters[pef.Type], pf.expr)
+}
+
+func (f *processAccessFilter) register(
+	eventMap subscriptionMap,
+	eventType api.ProcessAccessEventType,
+	symbol, fetchargs string,
+	fn perf.TraceEventDecoderFn,
+) {
+	if len(f.filters[eventType]) == 0 {
+		return
+	}
+
+	// Filtering is done by the decoder, so the kprobe is unfiltered
+	registerKprobe(f.sensor.monitor, eventMap, symbol, fetchargs, fn,
+		map[string]int{"": 1})
+}
+
+func registerProcessAccessEvents(sensor *Sensor, eventMap subscriptionMap, events []*api.ProcessAccessEventFilter) {
+	f := &processAccessFilter{
+		sensor: sensor,
+	}
+	for _, pef := range events {
+		f.add(pef)
+	}
+
+	f.register(eventMap,
+		api.ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_PTRACE,
+		ptraceKprobeSymbol, ptraceKprobeFetchargs, f.decodePtrace)
+	f.register(eventMap,
+		api.ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_VM_READ,
+		processVMReadvKprobeSymbol, processVMKprobeFetchargs,
+		f.decodeProcessVMReadv)
+	f.register(eventMap,
+		api.ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_VM_WRITE,
+		processVMWritevKprobeSymbol, processVMKprobeFetchargs,
+		f.decodeProcessVMWritev)
+}
@@ -0,0 +1,87 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+)
+
+func TestProcessAccessFilter(t *testing.T) {
+	s := &Sensor{}
+	s.ProcessCache.cache = newArrayTaskCache(32)
+
+	c1 := &ContainerInfo{ID: "c1"}
+	tasks := []*Task{
+		&Task{PID: 10, TGID: 10},
+		&Task{PID: 11, TGID: 10},
+		&Task{PID: 12, TGID: 12},
+		&Task{PID: 20, TGID: 20, ContainerID: "c1", ContainerInfo: c1},
+	}
+	for _, task := range tasks {
+		s.ProcessCache.cache.InsertTask(task.PID, task)
+	}
+
+	f := &processAccessFilter{sensor: s}
+	f.add(&api.ProcessAccessEventFilter{
+		Type: api.ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_PTRACE,
+		FilterExpression: expression.Equal(
+			expression.Identifier("cross_process"),
+			expression.Value(true)),
+	})
+	f.add(&api.ProcessAccessEventFilter{
+		Type: api.ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_VM_WRITE,
+		FilterExpression: expression.Equal(
+			expression.Identifier("cross_container"),
+			expression.Value(true)),
+	})
+
+	// Not a valid field
+	f.add(&api.ProcessAccessEventFilter{
+		Type: api.ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_VM_READ,
+		FilterExpression: expression.Equal(
+			expression.Identifier("no_such_field"),
+			expression.Value(true)),
+	})
+	if len(f.filters[api.ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_VM_READ]) != 0 {
+		t.Error("Unexpected filter for invalid expression")
+	}
+
+	ptrace := api.ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_PTRACE
+	vmWrite := api.ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_VM_WRITE
+
+	ev := f.newProcessAccessEvent(ptrace, 10, 11)
+	if ev.CrossProcess || ev.CrossContainer || f.match(ev) {
+		t.Errorf("Unexpected match for access within a process: %+v", ev)
+	}
+
+	ev = f.newProcessAccessEvent(ptrace, 10, 12)
+	if !ev.CrossProcess || ev.CrossContainer || !f.match(ev) {
+		t.Errorf("Expected match for cross-process access: %+v", ev)
+	}
+
+	ev = f.newProcessAccessEvent(vmWrite, 10, 12)
+	if f.match(ev) {
+		t.Errorf("Unexpected match for access on the host: %+v", ev)
+	}
+
+	ev = f.newProcessAccessEvent(vmWrite, 10, 20)
+	if ev.TraceeContainerId != "c1" || !ev.CrossContainer || !f.match(ev) {
+		t.Errorf("Expected match for cross-container access: %+v", ev)
+	}
+}
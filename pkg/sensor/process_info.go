@@ -32,7 +32,10 @@ import (
 	"sync"
 	"unicode"
 
+	api "github.com/capsule8/capsule8/api/v0"
+
 	"github.com/capsule8/capsule8/pkg/config"
+	"github.com/capsule8/capsule8/pkg/expression"
 	"github.com/capsule8/capsule8/pkg/sys"
 	"github.com/capsule8/capsule8/pkg/sys/perf"
 	"github.com/capsule8/capsule8/pkg/sys/proc"
@@ -47,7 +50,14 @@ const (
 		"euid=+24(%di):u32 egid=+28(%di):u32 " +
 		"fsuid=+32(%di):u32 fsgid=+36(%di):u32"
 
-	execveArgCount = 6
+	// Each string fetched by a kprobe may be up to 4096 bytes long, and
+	// a perf sample must be smaller than 64KiB, so each exec kprobe
+	// fetches at most execveStringsPerProbe strings. Longer argv and envp
+	// arrays are fetched by multiple kprobes at the same address.
+	execveStringsPerProbe = 14
+
+	// The maximum ExecArgvCount and ExecEnvironmentCount
+	maxExecveStrings = 256
 
 	doExecveAddress         = "do_execve"
 	doExecveatAddress       = "do_execveat"
@@ -88,10 +98,15 @@ type Task struct {
 	Command string
 
 	// CommandLine is the command-line used when the process was exec'd via
-	// execve(). It is composed of the first 6 elements of argv. It may
-	// not be complete if argv contained more than 6 elements.
+	// execve(). It is composed of the first ExecArgvCount elements of
+	// argv.
 	CommandLine []string
 
+	// Environment contains the environment variables named by the
+	// ExecEnvironment configuration that were passed to execve() when the
+	// process was exec'd.
+	Environment map[string]string
+
 	// Creds are the credentials (uid, gid) for the task. This is kept
 	// up-to-date by recording changes observed via a kprobe on
 	// commit_creds().
@@ -105,6 +120,11 @@ type Task struct {
 	// the container to which the task belongs, if any.
 	ContainerInfo *ContainerInfo
 
+	// StartTime is the time that the task was forked, on the same clock
+	// as perf_event samples. It is zero for tasks that were running when
+	// the sensor started.
+	StartTime int64
+
 	// processID is a cached unique ID for the process.
 	processID string
 }
@@ -143,9 +163,11 @@ func (t *Task) Invalidate() {
 	// Clear references for GC
 	t.Command = ""
 	t.CommandLine = nil
+	t.Environment = nil
 	t.ContainerID = ""
 	t.ContainerInfo = nil
 	t.Creds = nil
+	t.StartTime = 0
 	t.processID = ""
 }
 
@@ -214,6 +236,7 @@ type taskCache interface {
 	LookupTaskAndLeader(int) (*Task, *Task, bool)
 	InsertTask(int, *Task)
 	DeleteTask(int)
+	ForEachTask(func(*Task))
 }
 
 type arrayTaskCache struct {
@@ -261,6 +284,14 @@ func (c *arrayTaskCache) DeleteTask(pid int) {
 	c.entries[pid].Invalidate()
 }
 
+func (c *arrayTaskCache) ForEachTask(f func(*Task)) {
+	for i := range c.entries {
+		if t := &c.entries[i]; t.IsValid() {
+			f(t)
+		}
+	}
+}
+
 type mapTaskCache struct {
 	sync.Mutex
 	entries map[int]*Task
@@ -336,6 +367,15 @@ func (c *mapTaskCache) DeleteTask(pid int) {
 	c.Unlock()
 }
 
+func (c *mapTaskCache) ForEachTask(f func(*Task)) {
+	c.Lock()
+	defer c.Unlock()
+
+	for _, t := range c.entries {
+		f(t)
+	}
+}
+
 // ProcessInfoCache is an object that caches process information. It is
 // maintained automatically via an existing sensor object.
 type ProcessInfoCache struct {
@@ -343,6 +383,17 @@ type ProcessInfoCache struct {
 
 	sensor *Sensor
 
+	// CredentialsChangedEventID is an external event ID registered with
+	// the sensor's event monitor. The cache enqueues it whenever the
+	// credentials of a cached task change.
+	CredentialsChangedEventID uint64
+
+	// The numbers of argv and envp elements captured by the exec kprobes
+	// and the names of the environment variables to keep.
+	execArgvCount   int
+	execEnvCount    int
+	execEnvironment map[string]bool
+
 	scanningLock  sync.Mutex
 	scanning      bool
 	scanningQueue []scannerDeferredAction
@@ -362,8 +413,35 @@ func NewProcessInfoCache(sensor *Sensor) ProcessInfoCache {
 	})
 
 	cache := ProcessInfoCache{
-		sensor:   sensor,
-		scanning: true,
+		sensor:        sensor,
+		scanning:      true,
+		execArgvCount: config.Sensor.ExecArgvCount,
+	}
+
+	if cache.execArgvCount < 1 {
+		glog.Warningf("ExecArgvCount %d is too small, using 1",
+			cache.execArgvCount)
+		cache.execArgvCount = 1
+	} else if cache.execArgvCount > maxExecveStrings {
+		glog.Warningf("ExecArgvCount %d is too large, using %d",
+			cache.execArgvCount, maxExecveStrings)
+		cache.execArgvCount = maxExecveStrings
+	}
+	if len(config.Sensor.ExecEnvironment) > 0 {
+		cache.execEnvCount = config.Sensor.ExecEnvironmentCount
+		if cache.execEnvCount < 1 {
+			glog.Warningf("ExecEnvironmentCount %d leaves no room for ExecEnvironment, using 1",
+				cache.execEnvCount)
+			cache.execEnvCount = 1
+		} else if cache.execEnvCount > maxExecveStrings {
+			glog.Warningf("ExecEnvironmentCount %d is too large, using %d",
+				cache.execEnvCount, maxExecveStrings)
+			cache.execEnvCount = maxExecveStrings
+		}
+		cache.execEnvironment = make(map[string]bool)
+		for _, name := range config.Sensor.ExecEnvironment {
+			cache.execEnvironment[name] = true
+		}
 	}
 
 	maxPid := proc.MaxPid()
@@ -382,6 +460,15 @@ func NewProcessInfoCache(sensor *Sensor) ProcessInfoCache {
 		glog.Fatalf("Couldn't register event %s: %s", eventName, err)
 	}
 
+	cache.CredentialsChangedEventID, err = sensor.monitor.RegisterExternalEvent(
+		"PROCESS_CREDENTIALS_CHANGED",
+		cache.decodeCredentialsChanged,
+		credentialsChangedEventTypes,
+	)
+	if err != nil {
+		glog.Fatalf("Failed to register external event: %s", err)
+	}
+
 	// Attach kprobe on commit_creds to capture task privileges
 	_, err = sensor.monitor.RegisterKprobe(commitCredsAddress, false,
 		commitCredsArgs, cache.decodeCommitCreds,
@@ -405,33 +492,19 @@ func NewProcessInfoCache(sensor *Sensor) ProcessInfoCache {
 	// if that succeeds, it's the only one we need. Otherwise, we need a
 	// bunch of others to try to hit everything. We may end up getting
 	// duplicate events, which is ok.
-	_, err = sensor.monitor.RegisterKprobe(
-		doExecveatCommonAddress, false,
-		makeExecveFetchArgs("dx"), cache.decodeExecve,
-		perf.WithEventEnabled())
+	err = cache.newExecveProbe().register(doExecveatCommonAddress, "dx", "cx")
 	if err != nil {
-		_, err = sensor.monitor.RegisterKprobe(
-			sysExecveAddress, false,
-			makeExecveFetchArgs("si"), cache.decodeExecve,
-			perf.WithEventEnabled())
+		err = cache.newExecveProbe().register(sysExecveAddress, "si", "dx")
 		if err != nil {
 			glog.Fatalf("Couldn't register event %s: %s",
 				sysExecveAddress, err)
 		}
-		_, _ = sensor.monitor.RegisterKprobe(
-			doExecveAddress, false,
-			makeExecveFetchArgs("si"), cache.decodeExecve,
-			perf.WithEventEnabled())
+		_ = cache.newExecveProbe().register(doExecveAddress, "si", "dx")
 
-		_, err = sensor.monitor.RegisterKprobe(
-			sysExecveatAddress, false,
-			makeExecveFetchArgs("dx"), cache.decodeExecve,
-			perf.WithEventEnabled())
+		err = cache.newExecveProbe().register(sysExecveatAddress, "dx", "cx")
 		if err == nil {
-			_, _ = sensor.monitor.RegisterKprobe(
-				doExecveatAddress, false,
-				makeExecveFetchArgs("dx"), cache.decodeExecve,
-				perf.WithEventEnabled())
+			_ = cache.newExecveProbe().register(doExecveatAddress,
+				"dx", "cx")
 		}
 	}
 
@@ -457,12 +530,31 @@ func NewProcessInfoCache(sensor *Sensor) ProcessInfoCache {
 	return cache
 }
 
-func makeExecveFetchArgs(reg string) string {
-	parts := make([]string, execveArgCount)
-	for i := 0; i < execveArgCount; i++ {
-		parts[i] = fmt.Sprintf("argv%d=+0(+%d(%%%s)):string", i, i*8, reg)
+// filterEnvironment returns the NAME=value strings in env whose names are
+// in the execEnvironment set, or nil if no environment is being captured.
+func (pc *ProcessInfoCache) filterEnvironment(env []string) map[string]string {
+	if len(pc.execEnvironment) == 0 {
+		return nil
 	}
-	return strings.Join(parts, " ")
+
+	environment := make(map[string]string)
+	for _, s := range env {
+		parts := strings.SplitN(s, "=", 2)
+		if len(parts) == 2 && pc.execEnvironment[parts[0]] {
+			environment[parts[0]] = parts[1]
+		}
+	}
+	return environment
+}
+
+// procEnvironment reads the environment of a process from /proc, keeping
+// only the variables in the execEnvironment set. It's only used when scanning
+// existing tasks.
+func (pc *ProcessInfoCache) procEnvironment(pid int) map[string]string {
+	if len(pc.execEnvironment) == 0 {
+		return nil
+	}
+	return pc.filterEnvironment(procFS.Environment(pid))
 }
 
 func (pc *ProcessInfoCache) cacheTaskFromProc(tgid, pid int) error {
@@ -492,6 +584,7 @@ func (pc *ProcessInfoCache) cacheTaskFromProc(tgid, pid int) error {
 		PPID:        s.PPID,
 		Command:     s.Name,
 		CommandLine: procFS.CommandLine(tgid),
+		Environment: pc.procEnvironment(tgid),
 		ContainerID: containerID,
 		Creds: &Cred{
 			UID:   s.UID[0],
@@ -576,6 +669,48 @@ func (pc *ProcessInfoCache) LookupTaskAndLeader(pid int) (*Task, *Task, bool) {
 	return pc.cache.LookupTaskAndLeader(pid)
 }
 
+// ForEachTask calls f for each task in the cache. The cache may be locked
+// while f is called, so f must not call back into the cache.
+func (pc *ProcessInfoCache) ForEachTask(f func(*Task)) {
+	pc.cache.ForEachTask(f)
+}
+
+// ProcessLineage returns the lineage of the process containing the task with
+// the given PID, starting with the process itself and followed by each of its
+// ancestors, up to maxDepth processes. The lineage ends at the init process of
+// the task's container, at the host's init process, or at the first ancestor
+// that is not known to the cache.
+func (pc *ProcessInfoCache) ProcessLineage(pid int, maxDepth int) []*api.Process {
+	_, leader, ok := pc.LookupTaskAndLeader(pid)
+	if !ok || leader == nil {
+		return nil
+	}
+
+	containerID := leader.ContainerID
+	lineage := make([]*api.Process, 0, maxDepth)
+	for len(lineage) < maxDepth {
+		lineage = append(lineage, &api.Process{
+			Pid:     int32(leader.TGID),
+			Command: leader.Command,
+		})
+		if leader.TGID <= 1 || leader.PPID <= 0 {
+			break
+		}
+
+		_, parent, ok := pc.LookupTaskAndLeader(leader.PPID)
+		if !ok || parent == nil || parent.TGID == leader.TGID {
+			break
+		}
+		if len(containerID) > 0 && parent.ContainerID != containerID {
+			// leader is the container's init process
+			break
+		}
+		leader = parent
+	}
+
+	return lineage
+}
+
 // LookupTaskContainerInfo returns the container info for a task, possibly
 // consulting the sensor's container cache and updating the task cached
 // information.
@@ -622,6 +757,7 @@ func (pc *ProcessInfoCache) decodeNewTask(
 
 	var (
 		commandLine   []string
+		environment   map[string]string
 		containerID   string
 		containerInfo *ContainerInfo
 	)
@@ -629,6 +765,7 @@ func (pc *ProcessInfoCache) decodeNewTask(
 	parentTask, ok := pc.LookupTask(parentPid)
 	if ok {
 		commandLine = parentTask.CommandLine
+		environment = parentTask.Environment
 		containerID = parentTask.ContainerID
 		containerInfo = parentTask.ContainerInfo
 	}
@@ -638,8 +775,10 @@ func (pc *ProcessInfoCache) decodeNewTask(
 		PPID:          parentPid,
 		Command:       childComm,
 		CommandLine:   commandLine,
+		Environment:   environment,
 		ContainerID:   containerID,
 		ContainerInfo: containerInfo,
+		StartTime:     int64(sample.Time),
 	}
 
 	const cloneThread = 0x10000 // CLONE_THREAD from the kernel
@@ -670,28 +809,132 @@ func (pc *ProcessInfoCache) decodeCommitCreds(
 		glog.Fatal("Received commit_creds with zero usage")
 	}
 
+	creds := &Cred{
+		UID:   data["uid"].(uint32),
+		GID:   data["gid"].(uint32),
+		EUID:  data["euid"].(uint32),
+		EGID:  data["egid"].(uint32),
+		SUID:  data["suid"].(uint32),
+		SGID:  data["sgid"].(uint32),
+		FSUID: data["fsuid"].(uint32),
+		FSGID: data["fsgid"].(uint32),
+	}
 	changes := map[string]interface{}{
-		"Creds": &Cred{
-			UID:   data["uid"].(uint32),
-			GID:   data["gid"].(uint32),
-			EUID:  data["euid"].(uint32),
-			EGID:  data["egid"].(uint32),
-			SUID:  data["suid"].(uint32),
-			SGID:  data["sgid"].(uint32),
-			FSUID: data["fsuid"].(uint32),
-			FSGID: data["fsgid"].(uint32),
-		},
+		"Creds": creds,
+	}
+	sampleID := perf.SampleID{
+		PID:  sample.Pid,
+		TID:  sample.Tid,
+		Time: sample.Time,
+		CPU:  sample.CPU,
 	}
 
 	pc.maybeDeferAction(func() {
 		if t, ok := pc.LookupTask(pid); ok {
+			oldCreds := t.Creds
 			t.Update(changes)
+
+			// commit_creds is called for many reasons, so only
+			// report actual changes to known credentials.
+			if oldCreds != nil && *oldCreds != *creds {
+				pc.enqueueCredentialsChanged(sampleID, pid,
+					oldCreds, creds)
+			}
 		}
 	})
 
 	return nil, nil
 }
 
+var credentialsChangedEventTypes = expression.FieldTypeMap{
+	"uid":       int32(api.ValueType_UINT32),
+	"gid":       int32(api.ValueType_UINT32),
+	"euid":      int32(api.ValueType_UINT32),
+	"egid":      int32(api.ValueType_UINT32),
+	"suid":      int32(api.ValueType_UINT32),
+	"sgid":      int32(api.ValueType_UINT32),
+	"fsuid":     int32(api.ValueType_UINT32),
+	"fsgid":     int32(api.ValueType_UINT32),
+	"old_uid":   int32(api.ValueType_UINT32),
+	"old_gid":   int32(api.ValueType_UINT32),
+	"old_euid":  int32(api.ValueType_UINT32),
+	"old_egid":  int32(api.ValueType_UINT32),
+	"old_suid":  int32(api.ValueType_UINT32),
+	"old_sgid":  int32(api.ValueType_UINT32),
+	"old_fsuid": int32(api.ValueType_UINT32),
+	"old_fsgid": int32(api.ValueType_UINT32),
+}
+
+func credentialsChangedData(pid int, oldCreds, newCreds *Cred) map[string]interface{} {
+	return map[string]interface{}{
+		"common_pid": int32(pid),
+		"uid":        newCreds.UID,
+		"gid":        newCreds.GID,
+		"euid":       newCreds.EUID,
+		"egid":       newCreds.EGID,
+		"suid":       newCreds.SUID,
+		"sgid":       newCreds.SGID,
+		"fsuid":      newCreds.FSUID,
+		"fsgid":      newCreds.FSGID,
+		"old_uid":    oldCreds.UID,
+		"old_gid":    oldCreds.GID,
+		"old_euid":   oldCreds.EUID,
+		"old_egid":   oldCreds.EGID,
+		"old_suid":   oldCreds.SUID,
+		"old_sgid":   oldCreds.SGID,
+		"old_fsuid":  oldCreds.FSUID,
+		"old_fsgid":  oldCreds.FSGID,
+	}
+}
+
+func (pc *ProcessInfoCache) enqueueCredentialsChanged(
+	sampleID perf.SampleID,
+	pid int,
+	oldCreds, newCreds *Cred,
+) {
+	err := pc.sensor.monitor.EnqueueExternalSample(
+		pc.CredentialsChangedEventID, sampleID,
+		credentialsChangedData(pid, oldCreds, newCreds))
+	if err != nil {
+		glog.V(2).Infof("Couldn't enqueue credentials change for %d: %s",
+			pid, err)
+	}
+}
+
+func (pc *ProcessInfoCache) decodeCredentialsChanged(
+	sample *perf.SampleRecord,
+	data perf.TraceEventSampleData,
+) (interface{}, error) {
+	ev := pc.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.TelemetryEvent_Process{
+		Process: &api.ProcessEvent{
+			Type: api.ProcessEventType_PROCESS_EVENT_TYPE_CREDENTIALS_CHANGED,
+			OldCredentials: &api.Credentials{
+				Uid:   data["old_uid"].(uint32),
+				Gid:   data["old_gid"].(uint32),
+				Euid:  data["old_euid"].(uint32),
+				Egid:  data["old_egid"].(uint32),
+				Suid:  data["old_suid"].(uint32),
+				Sgid:  data["old_sgid"].(uint32),
+				Fsuid: data["old_fsuid"].(uint32),
+				Fsgid: data["old_fsgid"].(uint32),
+			},
+			NewCredentials: &api.Credentials{
+				Uid:   data["uid"].(uint32),
+				Gid:   data["gid"].(uint32),
+				Euid:  data["euid"].(uint32),
+				Egid:  data["egid"].(uint32),
+				Suid:  data["suid"].(uint32),
+				Sgid:  data["sgid"].(uint32),
+				Fsuid: data["fsuid"].(uint32),
+				Fsgid: data["fsgid"].(uint32),
+			},
+		},
+	}
+
+	return ev, nil
+}
+
 //
 // decodeRuncTaskRename is called when runc exec's and obtains the containerID
 // from /procfs and caches it.
@@ -737,32 +980,186 @@ func (pc *ProcessInfoCache) decodeRuncTaskRename(
 	return nil, nil
 }
 
-// decodeDoExecve decodes sys_execve() and sys_execveat() events to obtain the
-// command-line for the process.
-func (pc *ProcessInfoCache) decodeExecve(
-	sample *perf.SampleRecord,
-	data perf.TraceEventSampleData,
-) (interface{}, error) {
-	pid := int(data["common_pid"].(int32))
-	commandLine := make([]string, 0, execveArgCount)
-	for i := 0; i < execveArgCount; i++ {
-		s := data[fmt.Sprintf("argv%d", i)].(string)
+// execveWindow is a range of argv or envp strings fetched by one exec
+// kprobe.
+type execveWindow struct {
+	prefix string // "argv" or "envp"
+	first  int
+	count  int
+}
+
+// fetchargs returns the kprobe fetchargs for the window's strings, where
+// reg is the register holding the argv or envp pointer.
+func (w execveWindow) fetchargs(reg string) string {
+	parts := make([]string, w.count)
+	for i := range parts {
+		parts[i] = fmt.Sprintf("%s%d=+0(+%d(%%%s)):string",
+			w.prefix, w.first+i, (w.first+i)*8, reg)
+	}
+	return strings.Join(parts, " ")
+}
+
+// strings returns the strings fetched for the window up to the first empty
+// one, and whether an empty string was found.
+func (w execveWindow) strings(data perf.TraceEventSampleData) ([]string, bool) {
+	strs := make([]string, 0, w.count)
+	for i := w.first; i < w.first+w.count; i++ {
+		s, _ := data[fmt.Sprintf("%s%d", w.prefix, i)].(string)
 		if len(s) == 0 {
-			break
+			return strs, true
+		}
+		strs = append(strs, s)
+	}
+	return strs, false
+}
+
+// pendingExecve holds the strings fetched by each window of an exec until
+// the samples for all of the windows have been received.
+type pendingExecve struct {
+	strings  [][]string
+	ended    []bool
+	received int
+}
+
+// execveProbe is the set of kprobes registered at one exec function to fetch
+// argv and envp. Each kprobe fetches one window of strings, so a sample is
+// received for each window whenever a process is exec'd. The samples for a
+// task are combined before the task is updated. They are all emitted by the
+// same kprobe hit, so a window that is received twice starts a new exec,
+// e.g. if samples were lost.
+type execveProbe struct {
+	cache   *ProcessInfoCache
+	windows []execveWindow
+
+	sync.Mutex
+	pending map[int]*pendingExecve
+
+	truncatedOnce sync.Once
+}
+
+// newExecveProbe returns an execveProbe fetching the configured number of
+// argv and envp strings.
+func (pc *ProcessInfoCache) newExecveProbe() *execveProbe {
+	p := &execveProbe{
+		cache:   pc,
+		pending: make(map[int]*pendingExecve),
+	}
+	for _, x := range []struct {
+		prefix string
+		count  int
+	}{
+		{"argv", pc.execArgvCount},
+		{"envp", pc.execEnvCount},
+	} {
+		for first := 0; first < x.count; first += execveStringsPerProbe {
+			count := x.count - first
+			if count > execveStringsPerProbe {
+				count = execveStringsPerProbe
+			}
+			p.windows = append(p.windows, execveWindow{
+				prefix: x.prefix,
+				first:  first,
+				count:  count,
+			})
+		}
+	}
+	return p
+}
+
+// register registers a kprobe for each of the windows at address, where
+// argvReg and envpReg are the registers holding the argv and envp pointers.
+// Either all of the kprobes are registered, or none are.
+func (p *execveProbe) register(address, argvReg, envpReg string) error {
+	monitor := p.cache.sensor.monitor
+	eventIDs := make([]uint64, 0, len(p.windows))
+	for i, w := range p.windows {
+		reg := argvReg
+		if w.prefix == "envp" {
+			reg = envpReg
+		}
+
+		index := i
+		eventID, err := monitor.RegisterKprobe(address, false,
+			w.fetchargs(reg),
+			func(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+				p.decode(index, data)
+				return nil, nil
+			},
+			perf.WithEventEnabled())
+		if err != nil {
+			for _, id := range eventIDs {
+				monitor.UnregisterEvent(id)
+			}
+			return err
 		}
-		commandLine = append(commandLine, s)
+		eventIDs = append(eventIDs, eventID)
 	}
+	return nil
+}
+
+// decode records the strings fetched for window index. Once all of the
+// windows for an exec have been received, the task is updated with the
+// command-line and environment.
+func (p *execveProbe) decode(index int, data perf.TraceEventSampleData) {
+	pid := int(data["common_pid"].(int32))
+	strs, ended := p.windows[index].strings(data)
+
+	p.Lock()
+	pe, ok := p.pending[pid]
+	if !ok || pe.strings[index] != nil {
+		pe = &pendingExecve{
+			strings: make([][]string, len(p.windows)),
+			ended:   make([]bool, len(p.windows)),
+		}
+		p.pending[pid] = pe
+	}
+	pe.strings[index] = strs
+	pe.ended[index] = ended
+	pe.received++
+	if pe.received < len(p.windows) {
+		p.Unlock()
+		return
+	}
+	delete(p.pending, pid)
+	p.Unlock()
 
+	pc := p.cache
+	commandLine, _ := p.join(pe, "argv")
 	changes := map[string]interface{}{
 		"CommandLine": commandLine,
 	}
+	if pc.execEnvCount > 0 {
+		env, ended := p.join(pe, "envp")
+		environment := pc.filterEnvironment(env)
+		if !ended && len(environment) < len(pc.execEnvironment) {
+			p.truncatedOnce.Do(func() {
+				glog.Warningf("Environment of pid %d has more than %d strings; increase ExecEnvironmentCount to capture variables set later",
+					pid, pc.execEnvCount)
+			})
+		}
+		changes["Environment"] = environment
+	}
 	pc.maybeDeferAction(func() {
 		if t, ok := pc.LookupTask(pid); ok {
 			t.Update(changes)
 		}
 	})
+}
 
-	return nil, nil
+// join returns the strings fetched by the windows for prefix in order up to
+// the first empty string, and whether an empty string was found.
+func (p *execveProbe) join(pe *pendingExecve, prefix string) ([]string, bool) {
+	var strs []string
+	for i, w := range p.windows {
+		if w.prefix != prefix {
+			continue
+		}
+		strs = append(strs, pe.strings[i]...)
+		if pe.ended[i] {
+			return strs, true
+		}
+	}
+	return strs, false
 }
 
 func commToString(comm []interface{}) string {
@@ -15,9 +15,13 @@
 package sensor
 
 import (
+	"fmt"
 	"math/rand"
 	"os"
+	"reflect"
 	"testing"
+
+	"github.com/capsule8/capsule8/pkg/expression"
 )
 
 /*
@@ -39,10 +43,10 @@ const arrayTaskCacheSize = 32768
 const mapTaskCacheSize = 32768
 
 var values = []Task{
-	{1, 2, 3, "foo", nil, nil, "6e250051f33e0988aa6e549daa6c36de5ddf296bced4f31cf1b8249556f27ed2", nil, ""},
-	{1, 2, 3, "bar", nil, nil, "6e250051f33e0988aa6e549daa6c36de5ddf296bced4f31cf1b8249556f27ed2", nil, ""},
-	{1, 2, 3, "baz", nil, nil, "6e250051f33e0988aa6e549daa6c36de5ddf296bced4f31cf1b8249556f27ed2", nil, ""},
-	{1, 2, 3, "qux", nil, nil, "6e250051f33e0988aa6e549daa6c36de5ddf296bced4f31cf1b8249556f27ed2", nil, ""},
+	{1, 2, 3, "foo", nil, nil, nil, "6e250051f33e0988aa6e549daa6c36de5ddf296bced4f31cf1b8249556f27ed2", nil, 0, ""},
+	{1, 2, 3, "bar", nil, nil, nil, "6e250051f33e0988aa6e549daa6c36de5ddf296bced4f31cf1b8249556f27ed2", nil, 0, ""},
+	{1, 2, 3, "baz", nil, nil, nil, "6e250051f33e0988aa6e549daa6c36de5ddf296bced4f31cf1b8249556f27ed2", nil, 0, ""},
+	{1, 2, 3, "qux", nil, nil, nil, "6e250051f33e0988aa6e549daa6c36de5ddf296bced4f31cf1b8249556f27ed2", nil, 0, ""},
 }
 
 func TestCaches(t *testing.T) {
@@ -76,6 +80,28 @@ func TestCaches(t *testing.T) {
 	}
 }
 
+func TestForEachTask(t *testing.T) {
+	caches := []taskCache{
+		newArrayTaskCache(16),
+		newMapTaskCache(16),
+	}
+
+	for _, c := range caches {
+		c.InsertTask(3, &Task{PID: 3, TGID: 3})
+		c.InsertTask(5, &Task{PID: 5, TGID: 3})
+		c.InsertTask(7, &Task{PID: 7, TGID: 7})
+		c.DeleteTask(7)
+
+		pids := make(map[int]bool)
+		c.ForEachTask(func(t *Task) {
+			pids[t.PID] = true
+		})
+		if len(pids) != 2 || !pids[3] || !pids[5] {
+			t.Errorf("Unexpected tasks from %T: %v", c, pids)
+		}
+	}
+}
+
 func randomTgid(pidMap map[int]int) int {
 	var tgids []int
 
@@ -298,3 +324,160 @@ func BenchmarkContainerCacheMissParallel(b *testing.B) {
 		}
 	})
 }
+
+func TestCredentialsChangedFilter(t *testing.T) {
+	// "euid became 0"
+	expr, err := expression.NewExpression(expression.LogicalAnd(
+		expression.Equal(
+			expression.Identifier("euid"),
+			expression.Value(uint32(0))),
+		expression.NotEqual(
+			expression.Identifier("old_euid"),
+			expression.Value(uint32(0)))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = expr.Validate(credentialsChangedEventTypes); err != nil {
+		t.Fatal(err)
+	}
+
+	user := &Cred{UID: 1000, GID: 1000, EUID: 1000, EGID: 1000}
+	root := &Cred{UID: 1000, GID: 1000, EUID: 0, EGID: 1000}
+
+	matches := func(oldCreds, newCreds *Cred) bool {
+		data := credentialsChangedData(1234, oldCreds, newCreds)
+		for k := range credentialsChangedEventTypes {
+			if _, ok := data[k]; !ok {
+				t.Fatalf("Missing data for field %s", k)
+			}
+		}
+		v, err := expr.Evaluate(credentialsChangedEventTypes,
+			expression.FieldValueMap(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return expression.IsValueTrue(v)
+	}
+
+	if !matches(user, root) {
+		t.Error("Expected match for change to euid 0")
+	}
+	if matches(root, user) {
+		t.Error("Unexpected match for change from euid 0")
+	}
+}
+
+func TestProcessLineage(t *testing.T) {
+	pc := ProcessInfoCache{cache: newArrayTaskCache(32)}
+	tasks := []*Task{
+		&Task{PID: 1, TGID: 1, Command: "init"},
+		&Task{PID: 10, TGID: 10, PPID: 1, Command: "containerd-shim"},
+		&Task{PID: 11, TGID: 11, PPID: 10, Command: "bash"},
+		&Task{PID: 20, TGID: 20, PPID: 10, Command: "tini", ContainerID: "c1"},
+		&Task{PID: 21, TGID: 21, PPID: 20, Command: "sh", ContainerID: "c1"},
+		&Task{PID: 22, TGID: 22, PPID: 21, Command: "curl", ContainerID: "c1"},
+		&Task{PID: 23, TGID: 22, PPID: 21, Command: "curl", ContainerID: "c1"},
+	}
+	for _, task := range tasks {
+		pc.cache.InsertTask(task.PID, task)
+	}
+
+	pids := func(pid, depth int) []int32 {
+		var result []int32
+		for _, p := range pc.ProcessLineage(pid, depth) {
+			result = append(result, p.Pid)
+		}
+		return result
+	}
+
+	if l := pids(23, 10); !reflect.DeepEqual(l, []int32{22, 21, 20}) {
+		t.Errorf("Expected lineage to stop at container init, got %v", l)
+	}
+	if l := pids(23, 2); !reflect.DeepEqual(l, []int32{22, 21}) {
+		t.Errorf("Expected lineage of depth 2, got %v", l)
+	}
+	if l := pids(11, 10); !reflect.DeepEqual(l, []int32{11, 10, 1}) {
+		t.Errorf("Expected lineage to stop at host init, got %v", l)
+	}
+	if l := pids(30, 10); l != nil {
+		t.Errorf("Unexpected lineage for unknown pid: %v", l)
+	}
+}
+
+func TestDecodeExecve(t *testing.T) {
+	pc := ProcessInfoCache{
+		cache:           newArrayTaskCache(32),
+		execArgvCount:   16,
+		execEnvCount:    3,
+		execEnvironment: map[string]bool{"LD_PRELOAD": true},
+	}
+	pc.cache.InsertTask(5, &Task{PID: 5, TGID: 5, Command: "sh"})
+
+	p := pc.newExecveProbe()
+	if len(p.windows) != 3 {
+		t.Fatalf("Expected 3 windows, got %+v", p.windows)
+	}
+	expected := "argv14=+0(+112(%si)):string argv15=+0(+120(%si)):string"
+	if s := p.windows[1].fetchargs("si"); s != expected {
+		t.Errorf("Expected fetchargs %q, got %q", expected, s)
+	}
+	expected = "envp0=+0(+0(%dx)):string envp1=+0(+8(%dx)):string " +
+		"envp2=+0(+16(%dx)):string"
+	if s := p.windows[2].fetchargs("dx"); s != expected {
+		t.Errorf("Expected fetchargs %q, got %q", expected, s)
+	}
+
+	argv := make([]string, 15)
+	samples := make([]map[string]interface{}, len(p.windows))
+	for i := range samples {
+		samples[i] = map[string]interface{}{"common_pid": int32(5)}
+	}
+	for i := range argv {
+		argv[i] = fmt.Sprintf("arg%d", i)
+		samples[i/14][fmt.Sprintf("argv%d", i)] = argv[i]
+	}
+	samples[1]["argv15"] = ""
+	samples[2]["envp0"] = "HOME=/root"
+	samples[2]["envp1"] = "LD_PRELOAD=/tmp/evil.so"
+	samples[2]["envp2"] = ""
+
+	// The samples for the windows may be received in any order, and the
+	// task is only updated once all of them are.
+	task, _ := pc.LookupTask(5)
+	p.decode(2, samples[2])
+	p.decode(0, samples[0])
+	if task.CommandLine != nil {
+		t.Errorf("Unexpected command-line %v", task.CommandLine)
+	}
+	p.decode(1, samples[1])
+	if !reflect.DeepEqual(task.CommandLine, argv) {
+		t.Errorf("Unexpected command-line %v", task.CommandLine)
+	}
+	env := map[string]string{"LD_PRELOAD": "/tmp/evil.so"}
+	if !reflect.DeepEqual(task.Environment, env) {
+		t.Errorf("Unexpected environment %v", task.Environment)
+	}
+	if len(p.pending) != 0 {
+		t.Errorf("Unexpected pending execs %v", p.pending)
+	}
+
+	// A window received twice starts a new exec, discarding the
+	// incomplete one.
+	p.decode(0, samples[0])
+	p.decode(0, map[string]interface{}{
+		"common_pid": int32(5),
+		"argv0":      "/bin/ls",
+		"argv1":      "",
+	})
+	p.decode(1, map[string]interface{}{"common_pid": int32(5)})
+	p.decode(2, map[string]interface{}{
+		"common_pid": int32(5),
+		"envp0":      "",
+	})
+	if !reflect.DeepEqual(task.CommandLine, []string{"/bin/ls"}) {
+		t.Errorf("Unexpected command-line %v", task.CommandLine)
+	}
+	if len(task.Environment) != 0 {
+		t.Errorf("Unexpected environment %v", task.Environment)
+	}
+}
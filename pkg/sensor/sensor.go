@@ -23,6 +23,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	api "github.com/capsule8/capsule8/api/v0"
@@ -74,6 +75,16 @@ type Sensor struct {
 	// Mapping of event ids to data streams (subscriptions)
 	eventMap *safeSubscriptionMap
 
+	// Recently dispatched events for subscriptions with since_duration
+	history *eventHistory
+
+	// Reads the resource usage of exiting tasks for exit events
+	exitUsage *exitUsageReader
+
+	// Hashes executables for exec events, if enabled
+	execHasher        *executableHasher
+	execHashedEventID uint64
+
 	// Used by syscall events to handle syscall enter events with
 	// argument filters
 	dummySyscallEventID    uint64
@@ -137,6 +148,15 @@ func (s *Sensor) Start() error {
 		}
 	}
 
+	if config.Sensor.EventHistoryDuration > 0 {
+		var spillDir string
+		if config.Sensor.EventHistorySpill {
+			spillDir = filepath.Join(config.Global.RunDir, "history")
+		}
+		s.history = newEventHistory(config.Sensor.EventHistoryDuration,
+			config.Sensor.EventHistoryLength, spillDir)
+	}
+
 	// Create the sensor-global event monitor. This EventMonitor instance
 	// will be used for all perf_event events
 	err = s.createEventMonitor()
@@ -148,6 +168,21 @@ func (s *Sensor) Start() error {
 	s.ContainerCache = NewContainerCache(s)
 	s.ProcessCache = NewProcessInfoCache(s)
 
+	s.exitUsage = newExitUsageReader(sys.HostProcFS(), s.reportExit)
+
+	if config.Sensor.ExecHash {
+		s.execHashedEventID, err = s.monitor.RegisterExternalEvent(
+			"PROCESS_EXEC_HASHED",
+			s.decodeExecHashed,
+			execHashedEventTypes,
+		)
+		if err != nil {
+			glog.Fatalf("Failed to register external event: %s", err)
+		}
+		s.execHasher = newExecutableHasher(sys.HostProcFS().MountPoint,
+			s.reportExecutableHash)
+	}
+
 	if len(config.Sensor.DockerContainerDir) > 0 {
 		s.dockerMonitor = newDockerMonitor(s,
 			config.Sensor.DockerContainerDir)
@@ -175,6 +210,21 @@ func (s *Sensor) Stop() {
 		glog.V(2).Info("Sensor-global EventMonitor stopped successfully")
 	}
 
+	if s.history != nil {
+		s.history.close()
+		s.history = nil
+	}
+
+	if s.exitUsage != nil {
+		s.exitUsage.stop()
+		s.exitUsage = nil
+	}
+
+	if s.execHasher != nil {
+		s.execHasher.stop()
+		s.execHasher = nil
+	}
+
 	if len(s.traceFSMountPoint) > 0 {
 		s.unmountTraceFS()
 	}
@@ -189,36 +239,162 @@ func (s *Sensor) dispatchSample(eventID uint64, sample perf.EventMonitorSample)
 		glog.Warning(sample.Err)
 	}
 
+	if eventID == perf.LostSamplesEventID {
+		s.dispatchLostSamples(sample)
+		return
+	}
+
 	event, ok := sample.DecodedSample.(*api.TelemetryEvent)
 	if !ok || event == nil {
 		return
 	}
 
+	if s.history != nil {
+		s.history.add(historyRecord{
+			event:  event,
+			fields: sample.Fields,
+			data:   sample.DecodedData,
+		})
+	}
+
 	eventMap := s.eventMap.getMap()
 	subscriptions, ok := eventMap[eventID]
 	if !ok {
 		return
 	}
 
-	for _, s := range subscriptions {
-		if s.data == nil {
+	var lineage []*api.Process
+	for _, sub := range subscriptions {
+		if sub.data == nil {
 			continue
 		}
-		if s.filter != nil {
-			v, err := s.filter.Evaluate(
+		if sub.filter != nil {
+			v, err := sub.filter.Evaluate(
 				expression.FieldTypeMap(sample.Fields),
 				expression.FieldValueMap(sample.DecodedData))
 			if err != nil {
 				glog.V(1).Infof("Expression evaluation error: %s", err)
+				atomic.AddUint64(&sub.info.filterErrors, 1)
 				continue
 			}
 			if !expression.IsValueTrue(v) {
 				continue
 			}
 		}
-		glog.V(2).Infof("Sending %+v", event)
-		s.data <- event
+
+		e := event
+		if depth := sub.info.lineageDepth; depth > 0 && event.ProcessPid > 0 {
+			if lineage == nil {
+				lineage = s.ProcessCache.ProcessLineage(
+					int(event.ProcessPid),
+					maxLineageDepth(subscriptions))
+			}
+			if depth > len(lineage) {
+				depth = len(lineage)
+			}
+
+			// The event is shared by all subscriptions, so
+			// each gets its own copy with its own lineage.
+			c := *event
+			c.ProcessLineage = lineage[:depth]
+			e = &c
+		}
+
+		glog.V(2).Infof("Sending %+v", e)
+		sub.send(e, s.newDroppedEventsEvent)
+	}
+}
+
+// maxLineageDepth returns the largest process lineage depth requested by any
+// of the subscriptions.
+func maxLineageDepth(subscriptions map[uint64]*subscription) int {
+	depth := 0
+	for _, sub := range subscriptions {
+		if sub.info != nil && sub.info.lineageDepth > depth {
+			depth = sub.info.lineageDepth
+		}
 	}
+	return depth
+}
+
+// lostSamplesSubscriptions returns the subscriptions that may have lost
+// samples when the kernel drops samples from a ringbuffer. Ringbuffers are
+// shared by all of the events registered with the EventMonitor, so this is
+// every subscription with at least one event for which isKernelEvent returns
+// true, indexed by subscription ID.
+func lostSamplesSubscriptions(
+	eventMap subscriptionMap,
+	isKernelEvent func(uint64) bool,
+) map[uint64]*subscription {
+	subscriptions := make(map[uint64]*subscription)
+	eventMap.forEach(func(eventID, subscriptionID uint64, sub *subscription) {
+		if _, ok := subscriptions[subscriptionID]; ok {
+			return
+		}
+		if sub.data != nil && isKernelEvent(eventID) {
+			subscriptions[subscriptionID] = sub
+		}
+	})
+	return subscriptions
+}
+
+// isKernelEvent returns true if samples for an event are read from the
+// EventMonitor's ringbuffers.
+func (s *Sensor) isKernelEvent(eventID uint64) bool {
+	t, ok := s.monitor.RegisteredEventType(eventID)
+	return ok && t != perf.EventTypeExternal
+}
+
+// dispatchLostSamples notifies all subscriptions with kernel events that
+// samples were lost by the kernel. Subscription filters are not applied, since
+// there is no data to evaluate them against.
+func (s *Sensor) dispatchLostSamples(sample perf.EventMonitorSample) {
+	lost, _ := sample.DecodedData["lost"].(uint64)
+	cpu, _ := sample.DecodedData["cpu"].(uint32)
+	startTime, _ := sample.DecodedData["start_time"].(uint64)
+	endTime, _ := sample.DecodedData["end_time"].(uint64)
+
+	s.Metrics.LostSamples += lost
+	glog.V(1).Infof("Lost %d samples on CPU %d", lost, cpu)
+
+	lostEvents := &api.LostEventsEvent{
+		Count:            lost,
+		EndMonotimeNanos: int64(endTime) - s.bootMonotimeNanos,
+	}
+	if startTime != 0 {
+		lostEvents.StartMonotimeNanos = int64(startTime) - s.bootMonotimeNanos
+	}
+
+	subscriptions := lostSamplesSubscriptions(s.eventMap.getMap(),
+		s.isKernelEvent)
+	for _, sub := range subscriptions {
+		e := s.NewEvent()
+		e.SensorMonotimeNanos = lostEvents.EndMonotimeNanos
+		e.Cpu = int32(cpu)
+		e.Event = &api.TelemetryEvent_LostEvents{
+			LostEvents: lostEvents,
+		}
+		sub.send(e, s.newDroppedEventsEvent)
+	}
+}
+
+func (s *Sensor) newDroppedEventsEvent(count uint64) *api.TelemetryEvent {
+	e := s.NewEvent()
+	e.Event = &api.TelemetryEvent_DroppedEvents{
+		DroppedEvents: &api.DroppedEventsEvent{
+			Count: count,
+		},
+	}
+	return e
+}
+
+// tracingDir returns the tracefs directory used by the sensor, or an empty
+// string if there is none.
+func (s *Sensor) tracingDir() string {
+	if len(s.traceFSMountPoint) > 0 {
+		return s.traceFSMountPoint
+	}
+	return sys.TracingDir()
 }
 
 func (s *Sensor) mountTraceFS() error {
@@ -457,19 +633,28 @@ func (s *Sensor) createPerfEventStream(sub *api.Subscription) (*stream.Stream, e
 	registerContainerEvents(s, eventMap, sub.EventFilter.ContainerEvents)
 	registerFileEvents(s, eventMap, sub.EventFilter.FileEvents)
 	registerKernelEvents(s, eventMap, sub.EventFilter.KernelEvents)
+	registerKernelModuleEvents(s, eventMap, sub.EventFilter.KernelModuleEvents)
+	registerNamespaceEvents(s, eventMap, sub.EventFilter.NamespaceEvents,
+		sub.EventFilter.MountEvents)
 	registerNetworkEvents(s, eventMap, sub.EventFilter.NetworkEvents)
 	registerProcessEvents(s, eventMap, sub.EventFilter.ProcessEvents)
+	registerProcessAccessEvents(s, eventMap, sub.EventFilter.ProcessAccessEvents)
+	registerSignalEvents(s, eventMap, sub.EventFilter.SignalEvents)
 	registerSyscallEvents(s, eventMap, sub.EventFilter.SyscallEvents)
+	registerTracepointEvents(s, eventMap, sub.EventFilter.TracepointEvents)
+	registerUserEvents(s, eventMap, sub.EventFilter.UserEvents)
 
 	if len(eventMap) == 0 {
 		return nil, nil
 	}
 
 	ctrl := make(chan interface{})
-	data := make(chan interface{}, config.Sensor.ChannelBufferLength)
+	data := make(chan interface{}, queueLength(sub))
 
+	info := newSubscriptionInfo(sub)
 	eventMap.forEach(func(eventID, subscriptionID uint64, s *subscription) {
 		s.data = data
+		s.info = info
 	})
 	subscriptionID := s.eventMap.subscribe(eventMap)
 	glog.V(2).Infof("Subscription %d registered", subscriptionID)
@@ -517,6 +702,24 @@ func (s *Sensor) applyModifiers(eventStream *stream.Stream, modifier api.Modifie
 	return eventStream
 }
 
+// hasPerfEventFilters returns true if an event filter includes any filters for
+// events that are registered with the EventMonitor.
+func hasPerfEventFilters(ef *api.EventFilter) bool {
+	return len(ef.ContainerEvents) > 0 ||
+		len(ef.FileEvents) > 0 ||
+		len(ef.KernelEvents) > 0 ||
+		len(ef.KernelModuleEvents) > 0 ||
+		len(ef.MountEvents) > 0 ||
+		len(ef.NamespaceEvents) > 0 ||
+		len(ef.NetworkEvents) > 0 ||
+		len(ef.ProcessAccessEvents) > 0 ||
+		len(ef.ProcessEvents) > 0 ||
+		len(ef.SignalEvents) > 0 ||
+		len(ef.SyscallEvents) > 0 ||
+		len(ef.TracepointEvents) > 0 ||
+		len(ef.UserEvents) > 0
+}
+
 // NewSubscription creates a new telemetry subscription from the given
 // api.Subscription descriptor. NewSubscription returns a stream.Stream of
 // api.Events matching the specified filters. Closing the Stream cancels the
@@ -527,13 +730,17 @@ func (s *Sensor) NewSubscription(sub *api.Subscription) (*stream.Stream, error)
 	eventStream, joiner := stream.NewJoiner()
 	joiner.Off()
 
-	if len(sub.EventFilter.ContainerEvents) > 0 ||
-		len(sub.EventFilter.FileEvents) > 0 ||
-		len(sub.EventFilter.KernelEvents) > 0 ||
-		len(sub.EventFilter.NetworkEvents) > 0 ||
-		len(sub.EventFilter.ProcessEvents) > 0 ||
-		len(sub.EventFilter.SyscallEvents) > 0 {
+	// Live events are enabled between replayStart and replayCutoff.
+	// Replayed history stops at replayCutoff, and live events inside of
+	// this window may already have been replayed.
+	var replayStart int64
+	replay := s.history != nil && sub.SinceDuration != nil &&
+		sub.SinceDuration.Value > 0
+	if replay {
+		replayStart = s.currentMonotimeNanos()
+	}
 
+	if hasPerfEventFilters(sub.EventFilter) {
 		pes, err := s.createPerfEventStream(sub)
 		if err != nil {
 			joiner.Close()
@@ -544,6 +751,15 @@ func (s *Sensor) NewSubscription(sub *api.Subscription) (*stream.Stream, error)
 		}
 	}
 
+	for _, mf := range sub.EventFilter.ContainerMetricsEvents {
+		ms, err := newContainerMetricsSource(s, mf, sub.ContainerFilter)
+		if err != nil {
+			joiner.Close()
+			return nil, err
+		}
+		joiner.Add(ms)
+	}
+
 	for _, cf := range sub.EventFilter.ChargenEvents {
 		cs, err := newChargenSource(s, cf)
 		if err != nil {
@@ -562,6 +778,18 @@ func (s *Sensor) NewSubscription(sub *api.Subscription) (*stream.Stream, error)
 		joiner.Add(ts)
 	}
 
+	if replay {
+		replayCutoff := s.currentMonotimeNanos()
+		since := replayCutoff - sub.SinceDuration.Value
+		until := replayCutoff
+		if sub.ForDuration != nil && since+sub.ForDuration.Value < until {
+			until = since + sub.ForDuration.Value
+		}
+		eventStream = s.history.replay(eventStream, sub,
+			since, until, replayStart, replayCutoff,
+			s.newDroppedEventsEvent)
+	}
+
 	if sub.ContainerFilter != nil {
 		// Filter stream as requested by subscriber in the
 		// specified ContainerFilter to restrict the events to
@@ -576,6 +804,17 @@ func (s *Sensor) NewSubscription(sub *api.Subscription) (*stream.Stream, error)
 		eventStream = s.applyModifiers(eventStream, *sub.Modifier)
 	}
 
+	if sub.ForDuration != nil {
+		// The duration is relative to since_duration when it is
+		// given, so it may have already elapsed. Closing the stream
+		// tears down the subscription's events.
+		d := time.Duration(sub.ForDuration.Value)
+		if replay {
+			d -= time.Duration(sub.SinceDuration.Value)
+		}
+		eventStream = stream.Duration(eventStream, d)
+	}
+
 	s.Metrics.Subscriptions++
 	joiner.On()
 
@@ -0,0 +1,186 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/sys"
+	"github.com/capsule8/capsule8/pkg/sys/proc"
+	"github.com/capsule8/capsule8/pkg/version"
+	"github.com/golang/glog"
+
+	"golang.org/x/net/context"
+)
+
+// sensorServiceServer implements the SensorService gRPC API. It is served
+// alongside the TelemetryService.
+type sensorServiceServer struct {
+	sensor *Sensor
+}
+
+func (ss *sensorServiceServer) GetSensorInfo(
+	ctx context.Context,
+	req *api.GetSensorInfoRequest,
+) (*api.GetSensorInfoResponse, error) {
+	s := ss.sensor
+
+	kernelRelease, err := proc.KernelRelease()
+	if err != nil {
+		glog.V(1).Infof("Couldn't read kernel release: %s", err)
+	}
+
+	tracingDir := s.tracingDir()
+	perfEventDir := s.perfEventMountPoint
+	if len(perfEventDir) == 0 {
+		perfEventDir = sys.PerfEventDir()
+	}
+
+	var kprobes bool
+	if len(tracingDir) > 0 {
+		_, err = os.Stat(filepath.Join(tracingDir, "kprobe_events"))
+		kprobes = err == nil
+	}
+
+	return &api.GetSensorInfoResponse{
+		SensorId:                 s.ID,
+		Version:                  version.Version,
+		Build:                    version.Build,
+		KernelRelease:            kernelRelease,
+		BootId:                   proc.BootID(),
+		TracefsAvailable:         len(tracingDir) > 0,
+		PerfEventCgroupAvailable: len(perfEventDir) > 0,
+		KprobesAvailable:         kprobes,
+	}, nil
+}
+
+func (ss *sensorServiceServer) ListSubscriptions(
+	ctx context.Context,
+	req *api.ListSubscriptionsRequest,
+) (*api.ListSubscriptionsResponse, error) {
+	return &api.ListSubscriptionsResponse{
+		Subscriptions: ss.sensor.eventMap.subscriptions(),
+	}, nil
+}
+
+func newContainerInfo(info *ContainerInfo) *api.ContainerInfo {
+	return &api.ContainerInfo{
+		Id:        info.ID,
+		Name:      info.Name,
+		ImageId:   info.ImageID,
+		ImageName: info.ImageName,
+		State:     api.ContainerState(info.State),
+		Runtime:   api.ContainerRuntime(info.Runtime),
+		Pid:       int32(info.Pid),
+	}
+}
+
+func (ss *sensorServiceServer) ListContainers(
+	ctx context.Context,
+	req *api.ListContainersRequest,
+) (*api.ListContainersResponse, error) {
+	var cf *containerFilter
+	if req.ContainerFilter != nil {
+		cf = newContainerFilter(req.ContainerFilter)
+	}
+
+	var containers []*api.ContainerInfo
+	ss.sensor.ContainerCache.ForEachContainer(func(info *ContainerInfo) {
+		if cf == nil || cf.matchContainer(info) {
+			containers = append(containers, newContainerInfo(info))
+		}
+	})
+	sort.Slice(containers, func(i, j int) bool {
+		return containers[i].Id < containers[j].Id
+	})
+
+	return &api.ListContainersResponse{
+		Containers: containers,
+	}, nil
+}
+
+func newProcessInfo(t *Task) *api.ProcessInfo {
+	p := &api.ProcessInfo{
+		ProcessId:   t.ProcessID(),
+		Pid:         int32(t.PID),
+		Tgid:        int32(t.TGID),
+		Ppid:        int32(t.PPID),
+		Command:     t.Command,
+		CommandLine: t.CommandLine,
+		ContainerId: t.ContainerID,
+	}
+
+	if c := t.Creds; c != nil {
+		p.Credentials = &api.Credentials{
+			Uid:   c.UID,
+			Gid:   c.GID,
+			Euid:  c.EUID,
+			Egid:  c.EGID,
+			Suid:  c.SUID,
+			Sgid:  c.SGID,
+			Fsuid: c.FSUID,
+			Fsgid: c.FSGID,
+		}
+	}
+
+	return p
+}
+
+func (ss *sensorServiceServer) ListProcesses(
+	ctx context.Context,
+	req *api.ListProcessesRequest,
+) (*api.ListProcessesResponse, error) {
+	// Copy the tasks out of the cache first so that the container cache
+	// isn't consulted while the task cache is locked.
+	var tasks []Task
+	ss.sensor.ProcessCache.ForEachTask(func(t *Task) {
+		tasks = append(tasks, *t)
+	})
+
+	var cf *containerFilter
+	if req.ContainerFilter != nil {
+		cf = newContainerFilter(req.ContainerFilter)
+	}
+
+	processes := make([]*api.ProcessInfo, 0, len(tasks))
+	for i := range tasks {
+		t := &tasks[i]
+		if cf != nil {
+			if len(t.ContainerID) == 0 {
+				continue
+			}
+			info := ss.sensor.ContainerCache.LookupContainer(
+				t.ContainerID, false)
+			if info == nil {
+				info = &ContainerInfo{ID: t.ContainerID}
+			}
+			if !cf.matchContainer(info) {
+				continue
+			}
+		}
+		processes = append(processes, newProcessInfo(t))
+	}
+	sort.Slice(processes, func(i, j int) bool {
+		return processes[i].Pid < processes[j].Pid
+	})
+
+	return &api.ListProcessesResponse{
+		Processes: processes,
+	}, nil
+}
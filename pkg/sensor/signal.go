@@ -0,0 +1,149 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"fmt"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+	"github.com/golang/glog"
+)
+
+const (
+	signalGenerateTracepoint = "signal/signal_generate"
+	signalDeliverTracepoint  = "signal/signal_deliver"
+)
+
+type signalFilter struct {
+	sensor *Sensor
+}
+
+// taskContainerID returns the ID of the container of the process containing
+// the task with the given PID, or an empty string if there is none or the
+// task is not known.
+func (f *signalFilter) taskContainerID(pid int) string {
+	if _, leader, ok := f.sensor.ProcessCache.LookupTaskAndLeader(pid); ok && leader != nil {
+		return leader.ContainerID
+	}
+	return ""
+}
+
+func (f *signalFilter) decodeSignalGenerate(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	senderPid := data["common_pid"].(int32)
+	targetPid := data["pid"].(int32)
+
+	senderContainerID := f.taskContainerID(int(senderPid))
+	targetContainerID := f.taskContainerID(int(targetPid))
+
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.TelemetryEvent_Signal{
+		Signal: &api.SignalEvent{
+			Type:              api.SignalEventType_SIGNAL_EVENT_TYPE_GENERATE,
+			Signal:            uint32(data["sig"].(int32)),
+			Code:              data["code"].(int32),
+			SenderPid:         senderPid,
+			TargetPid:         targetPid,
+			Result:            data["result"].(int32),
+			Group:             data["group"].(int32) != 0,
+			TargetContainerId: targetContainerID,
+			CrossContainer:    senderContainerID != targetContainerID,
+		},
+	}
+
+	return ev, nil
+}
+
+func (f *signalFilter) decodeSignalDeliver(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.TelemetryEvent_Signal{
+		Signal: &api.SignalEvent{
+			Type:      api.SignalEventType_SIGNAL_EVENT_TYPE_DELIVER,
+			Signal:    uint32(data["sig"].(int32)),
+			Code:      data["code"].(int32),
+			TargetPid: data["common_pid"].(int32),
+		},
+	}
+
+	return ev, nil
+}
+
+// parseSignalEventFilter checks a signal event filter.
+func parseSignalEventFilter(sensor *Sensor, sef *api.SignalEventFilter) (*parsedFilter, error) {
+	var name string
+	switch sef.Type {
+	case api.SignalEventType_SIGNAL_EVENT_TYPE_GENERATE:
+		name = signalGenerateTracepoint
+	case api.SignalEventType_SIGNAL_EVENT_TYPE_DELIVER:
+		name = signalDeliverTracepoint
+	default:
+		return nil, fmt.Errorf("Invalid signal event type %s", sef.Type)
+	}
+
+	types, err := sensor.tracepointFieldTypes(name)
+	if err != nil {
+		return nil, err
+	}
+	return newParsedFilter([]string{name}, nil, sef.FilterExpression, true,
+		types)
+}
+
+type signalFilterSet struct {
+	sensor *Sensor
+
+	generateFilters map[string]int
+	deliverFilters  map[string]int
+}
+
+func (sfs *signalFilterSet) add(sef *api.SignalEventFilter) {
+	pf, err := parseSignalEventFilter(sfs.sensor, sef)
+	if err != nil {
+		glog.V(1).Infof("Invalid signal event filter: %s", err)
+		return
+	}
+	filterString := pf.kernelFilterString()
+
+	switch sef.Type {
+	case api.SignalEventType_SIGNAL_EVENT_TYPE_GENERATE:
+		if sfs.generateFilters == nil {
+			sfs.generateFilters = make(map[string]int)
+		}
+		sfs.generateFilters[filterString]++
+	case api.SignalEventType_SIGNAL_EVENT_TYPE_DELIVER:
+		if sfs.deliverFilters == nil {
+			sfs.deliverFilters = make(map[string]int)
+		}
+		sfs.deliverFilters[filterString]++
+	}
+}
+
+func registerSignalEvents(sensor *Sensor, eventMap subscriptionMap, events []*api.SignalEventFilter) {
+	sfs := signalFilterSet{
+		sensor: sensor,
+	}
+	for _, sef := range events {
+		sfs.add(sef)
+	}
+
+	f := signalFilter{
+		sensor: sensor,
+	}
+
+	registerEvent(sensor.monitor, eventMap, signalGenerateTracepoint,
+		f.decodeSignalGenerate, sfs.generateFilters)
+	registerEvent(sensor.monitor, eventMap, signalDeliverTracepoint,
+		f.decodeSignalDeliver, sfs.deliverFilters)
+}
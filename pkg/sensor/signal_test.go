@@ -0,0 +1,71 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"os"
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+)
+
+func TestSignalFilterSet(t *testing.T) {
+	dir := newTestTracingDir(t, map[string][]string{
+		signalGenerateTracepoint: {"sig", "code"},
+		signalDeliverTracepoint:  {"sig", "code"},
+	})
+	defer os.RemoveAll(dir)
+
+	sfs := signalFilterSet{
+		sensor: &Sensor{traceFSMountPoint: dir},
+	}
+	sfs.add(&api.SignalEventFilter{
+		Type: api.SignalEventType_SIGNAL_EVENT_TYPE_GENERATE,
+		FilterExpression: expression.Equal(
+			expression.Identifier("sig"),
+			expression.Value(int32(9))),
+	})
+	sfs.add(&api.SignalEventFilter{
+		Type: api.SignalEventType_SIGNAL_EVENT_TYPE_GENERATE,
+		FilterExpression: expression.Equal(
+			expression.Identifier("sig"),
+			expression.Value(int32(15))),
+	})
+
+	// Not valid as a kernel filter
+	sfs.add(&api.SignalEventFilter{
+		Type: api.SignalEventType_SIGNAL_EVENT_TYPE_DELIVER,
+		FilterExpression: expression.IsNull(
+			expression.Identifier("sig")),
+	})
+
+	if len(sfs.generateFilters) != 2 ||
+		sfs.generateFilters["sig == 9"] != 1 ||
+		sfs.generateFilters["sig == 15"] != 1 {
+		t.Errorf("Unexpected generate filters %v", sfs.generateFilters)
+	}
+	if _, active := fullFilterString(sfs.deliverFilters); active {
+		t.Errorf("Unexpected deliver filters %v", sfs.deliverFilters)
+	}
+
+	sfs.add(&api.SignalEventFilter{
+		Type: api.SignalEventType_SIGNAL_EVENT_TYPE_DELIVER,
+	})
+	if f, active := fullFilterString(sfs.deliverFilters); !active || f != "" {
+		t.Errorf("Expected wildcard deliver filter, got %q", f)
+	}
+}
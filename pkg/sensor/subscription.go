@@ -15,12 +15,19 @@
 package sensor
 
 import (
+	"sort"
 	"sync"
 	"sync/atomic"
 
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/config"
 	"github.com/capsule8/capsule8/pkg/expression"
 )
 
+// Maximum number of processes to include in an event's process lineage
+const maxProcessLineageDepth = 64
+
 type subscriptionUnregisterFn func(eventID uint64, sub *subscription)
 
 type subscription struct {
@@ -28,6 +35,125 @@ type subscription struct {
 	data       chan interface{}
 	unregister subscriptionUnregisterFn
 	filter     *expression.Expression
+	info       *subscriptionInfo
+}
+
+// subscriptionInfo is shared by all of the per-event subscriptions that are
+// registered together for a single api.Subscription.
+type subscriptionInfo struct {
+	// Updated atomically
+	delivered    uint64
+	dropped      uint64
+	filterErrors uint64
+
+	subscription *api.Subscription
+	policy       api.BackpressurePolicy
+	lineageDepth int
+
+	// The lock serializes sends that may drop events and protects the
+	// number of dropped events not yet reported to the subscriber.
+	sync.Mutex
+	unreported uint64
+}
+
+func newSubscriptionInfo(sub *api.Subscription) *subscriptionInfo {
+	info := &subscriptionInfo{
+		subscription: sub,
+	}
+	if sub.Backpressure != nil {
+		info.policy = sub.Backpressure.Policy
+	}
+	info.lineageDepth = int(sub.ProcessLineageDepth)
+	if info.lineageDepth > maxProcessLineageDepth {
+		info.lineageDepth = maxProcessLineageDepth
+	}
+	return info
+}
+
+// queueLength returns the length of the event queue to use for an
+// api.Subscription.
+func queueLength(sub *api.Subscription) int {
+	if sub.Backpressure != nil && sub.Backpressure.QueueLength > 0 {
+		return int(sub.Backpressure.QueueLength)
+	}
+	return config.Sensor.ChannelBufferLength
+}
+
+// send queues an event for the subscription according to its backpressure
+// policy. If events have been dropped since the last report, an event
+// created by newDroppedEvent reporting them is queued first.
+func (s *subscription) send(
+	event *api.TelemetryEvent,
+	newDroppedEvent func(count uint64) *api.TelemetryEvent,
+) {
+	info := s.info
+	switch info.policy {
+	case api.BackpressurePolicy_BACKPRESSURE_POLICY_DROP_NEWEST:
+		info.Lock()
+		if info.unreported > 0 {
+			if !s.trySend(newDroppedEvent(info.unreported)) {
+				info.unreported++
+				info.Unlock()
+				atomic.AddUint64(&info.dropped, 1)
+				return
+			}
+			info.unreported = 0
+		}
+		if s.trySend(event) {
+			atomic.AddUint64(&info.delivered, 1)
+		} else {
+			info.unreported++
+			atomic.AddUint64(&info.dropped, 1)
+		}
+		info.Unlock()
+
+	case api.BackpressurePolicy_BACKPRESSURE_POLICY_DROP_OLDEST:
+		info.Lock()
+		if info.unreported > 0 {
+			// Take the count before evicting anything to make
+			// room, because the report may evict queued events.
+			n := info.unreported
+			info.unreported = 0
+			s.sendEvictingOldest(newDroppedEvent(n))
+		}
+		s.sendEvictingOldest(event)
+		atomic.AddUint64(&info.delivered, 1)
+		info.Unlock()
+
+	default:
+		s.data <- event
+		atomic.AddUint64(&info.delivered, 1)
+	}
+}
+
+func (s *subscription) trySend(event *api.TelemetryEvent) bool {
+	select {
+	case s.data <- event:
+		return true
+	default:
+		return false
+	}
+}
+
+// sendEvictingOldest queues an event, removing the oldest queued events as
+// needed to make room for it. Must be called with s.info locked.
+func (s *subscription) sendEvictingOldest(event *api.TelemetryEvent) {
+	for !s.trySend(event) {
+		select {
+		case i := <-s.data:
+			e := i.(*api.TelemetryEvent)
+			if d, ok := e.Event.(*api.TelemetryEvent_DroppedEvents); ok {
+				// Carry the evicted report forward
+				s.info.unreported += d.DroppedEvents.Count
+			} else {
+				s.info.unreported++
+				atomic.AddUint64(&s.info.delivered, ^uint64(0))
+				atomic.AddUint64(&s.info.dropped, 1)
+			}
+		default:
+			// The subscriber made room; try again.
+		}
+	}
 }
 
 //
@@ -169,3 +295,38 @@ func (ssm *safeSubscriptionMap) unsubscribe(
 		}
 	}
 }
+
+// subscriptions returns information about all active subscriptions, ordered
+// by subscription ID.
+func (ssm *safeSubscriptionMap) subscriptions() []*api.SubscriptionInfo {
+	infos := make(map[uint64]*api.SubscriptionInfo)
+	ssm.getMap().forEach(func(eventID, subscriptionID uint64, s *subscription) {
+		i, ok := infos[subscriptionID]
+		if !ok {
+			i = &api.SubscriptionInfo{
+				SubscriptionId: subscriptionID,
+			}
+			if s.info != nil {
+				i.Subscription = s.info.subscription
+				i.EventsDelivered = atomic.LoadUint64(&s.info.delivered)
+				i.EventsDropped = atomic.LoadUint64(&s.info.dropped)
+				i.FilterErrors = atomic.LoadUint64(&s.info.filterErrors)
+			}
+			infos[subscriptionID] = i
+		}
+		i.EventIds = append(i.EventIds, eventID)
+	})
+
+	result := make([]*api.SubscriptionInfo, 0, len(infos))
+	for _, i := range infos {
+		sort.Slice(i.EventIds, func(a, b int) bool {
+			return i.EventIds[a] < i.EventIds[b]
+		})
+		result = append(result, i)
+	}
+	sort.Slice(result, func(a, b int) bool {
+		return result[a].SubscriptionId < result[b].SubscriptionId
+	})
+
+	return result
+}
@@ -0,0 +1,260 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"reflect"
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+)
+
+func TestSubscriptionInfo(t *testing.T) {
+	ssm := newSafeSubscriptionMap()
+
+	subscribe := func(sub *api.Subscription, eventIDs ...uint64) uint64 {
+		info := &subscriptionInfo{subscription: sub}
+		sm := newSubscriptionMap()
+		for _, eventID := range eventIDs {
+			sm.subscribe(eventID).info = info
+		}
+		return ssm.subscribe(sm)
+	}
+
+	sub1 := &api.Subscription{}
+	sub2 := &api.Subscription{}
+	id1 := subscribe(sub1, 3, 1, 2)
+	id2 := subscribe(sub2, 2)
+
+	ssm.getMap()[2][id1].info.delivered = 10
+	ssm.getMap()[2][id2].info.dropped = 5
+	ssm.getMap()[2][id2].info.filterErrors = 2
+
+	infos := ssm.subscriptions()
+	if len(infos) != 2 {
+		t.Fatalf("Expected 2 subscriptions, got %d", len(infos))
+	}
+
+	expected := []*api.SubscriptionInfo{
+		&api.SubscriptionInfo{
+			SubscriptionId:  id1,
+			Subscription:    sub1,
+			EventIds:        []uint64{1, 2, 3},
+			EventsDelivered: 10,
+		},
+		&api.SubscriptionInfo{
+			SubscriptionId: id2,
+			Subscription:   sub2,
+			EventIds:       []uint64{2},
+			EventsDropped:  5,
+			FilterErrors:   2,
+		},
+	}
+	if !reflect.DeepEqual(infos, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, infos)
+	}
+
+	ssm.unsubscribe(id1, nil)
+	if infos = ssm.subscriptions(); len(infos) != 1 {
+		t.Errorf("Expected 1 subscription, got %d", len(infos))
+	}
+}
+
+func newBackpressureSubscription(
+	policy api.BackpressurePolicy,
+	queueLength uint32,
+) *subscription {
+	sub := &api.Subscription{
+		Backpressure: &api.BackpressureOptions{
+			Policy:      policy,
+			QueueLength: queueLength,
+		},
+	}
+	return &subscription{
+		data: make(chan interface{}, queueLength),
+		info: newSubscriptionInfo(sub),
+	}
+}
+
+func newSequenceEvent(n uint64) *api.TelemetryEvent {
+	return &api.TelemetryEvent{SensorSequenceNumber: n}
+}
+
+func newTestDroppedEvent(count uint64) *api.TelemetryEvent {
+	return &api.TelemetryEvent{
+		Event: &api.TelemetryEvent_DroppedEvents{
+			DroppedEvents: &api.DroppedEventsEvent{
+				Count: count,
+			},
+		},
+	}
+}
+
+// drain returns a description of the events queued for a subscription: the
+// sequence number of each event, or the negated count of dropped events.
+func drain(s *subscription) []int64 {
+	var result []int64
+	for {
+		select {
+		case i := <-s.data:
+			e := i.(*api.TelemetryEvent)
+			if d := e.GetDroppedEvents(); d != nil {
+				result = append(result, -int64(d.Count))
+			} else {
+				result = append(result, int64(e.SensorSequenceNumber))
+			}
+		default:
+			return result
+		}
+	}
+}
+
+func TestBackpressureDropNewest(t *testing.T) {
+	s := newBackpressureSubscription(
+		api.BackpressurePolicy_BACKPRESSURE_POLICY_DROP_NEWEST, 2)
+
+	for i := uint64(1); i <= 5; i++ {
+		s.send(newSequenceEvent(i), newTestDroppedEvent)
+	}
+	if r := drain(s); !reflect.DeepEqual(r, []int64{1, 2}) {
+		t.Errorf("Expected [1 2], got %v", r)
+	}
+
+	s.send(newSequenceEvent(6), newTestDroppedEvent)
+	if r := drain(s); !reflect.DeepEqual(r, []int64{-3, 6}) {
+		t.Errorf("Expected [-3 6], got %v", r)
+	}
+
+	if s.info.delivered != 3 || s.info.dropped != 3 {
+		t.Errorf("Expected 3 delivered and 3 dropped, got %d and %d",
+			s.info.delivered, s.info.dropped)
+	}
+}
+
+func TestBackpressureDropOldest(t *testing.T) {
+	s := newBackpressureSubscription(
+		api.BackpressurePolicy_BACKPRESSURE_POLICY_DROP_OLDEST, 3)
+
+	for i := uint64(1); i <= 5; i++ {
+		s.send(newSequenceEvent(i), newTestDroppedEvent)
+	}
+	if r := drain(s); !reflect.DeepEqual(r, []int64{4, -1, 5}) {
+		t.Errorf("Expected [4 -1 5], got %v", r)
+	}
+
+	// Making room for the report and event 5 dropped two more events
+	s.send(newSequenceEvent(6), newTestDroppedEvent)
+	if r := drain(s); !reflect.DeepEqual(r, []int64{-2, 6}) {
+		t.Errorf("Expected [-2 6], got %v", r)
+	}
+
+	if s.info.delivered != 3 || s.info.dropped != 3 {
+		t.Errorf("Expected 3 delivered and 3 dropped, got %d and %d",
+			s.info.delivered, s.info.dropped)
+	}
+}
+
+func TestHasPerfEventFilters(t *testing.T) {
+	filters := []*api.EventFilter{
+		&api.EventFilter{
+			SignalEvents: []*api.SignalEventFilter{
+				&api.SignalEventFilter{
+					Type: api.SignalEventType_SIGNAL_EVENT_TYPE_GENERATE,
+				},
+			},
+		},
+		&api.EventFilter{
+			MountEvents: []*api.MountEventFilter{
+				&api.MountEventFilter{
+					Type: api.MountEventType_MOUNT_EVENT_TYPE_MOUNT,
+				},
+			},
+		},
+		&api.EventFilter{
+			NamespaceEvents: []*api.NamespaceEventFilter{
+				&api.NamespaceEventFilter{
+					Type: api.NamespaceEventType_NAMESPACE_EVENT_TYPE_SETNS,
+				},
+			},
+		},
+		&api.EventFilter{
+			KernelModuleEvents: []*api.KernelModuleEventFilter{
+				&api.KernelModuleEventFilter{
+					Type: api.KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_LOAD,
+				},
+			},
+		},
+		&api.EventFilter{
+			ProcessAccessEvents: []*api.ProcessAccessEventFilter{
+				&api.ProcessAccessEventFilter{
+					Type: api.ProcessAccessEventType_PROCESS_ACCESS_EVENT_TYPE_PTRACE,
+				},
+			},
+		},
+		&api.EventFilter{
+			UserEvents: []*api.UserFunctionCallFilter{
+				&api.UserFunctionCallFilter{
+					Type: api.UserFunctionCallEventType_USER_FUNCTION_CALL_EVENT_TYPE_ENTER,
+				},
+			},
+		},
+		&api.EventFilter{
+			TracepointEvents: []*api.TracepointEventFilter{
+				&api.TracepointEventFilter{
+					Name: "sched:sched_switch",
+				},
+			},
+		},
+	}
+	for _, ef := range filters {
+		if !hasPerfEventFilters(ef) {
+			t.Errorf("Expected perf events for %+v", ef)
+		}
+	}
+
+	ef := &api.EventFilter{
+		TickerEvents: []*api.TickerEventFilter{
+			&api.TickerEventFilter{Interval: 1},
+		},
+	}
+	if hasPerfEventFilters(ef) {
+		t.Errorf("Unexpected perf events for %+v", ef)
+	}
+}
+
+func TestLostSamplesSubscriptions(t *testing.T) {
+	sm := newSubscriptionMap()
+	data := make(chan interface{})
+
+	// Subscription 1 has kernel events 1 and 2, subscription 2 has only
+	// external event 3, and subscription 3 has kernel event 2 but is not
+	// receiving events.
+	for _, eventID := range []uint64{1, 2} {
+		sm[eventID] = map[uint64]*subscription{
+			1: &subscription{data: data},
+		}
+	}
+	sm[3] = map[uint64]*subscription{
+		2: &subscription{data: data},
+	}
+	sm[2][3] = &subscription{}
+
+	subscriptions := lostSamplesSubscriptions(sm, func(eventID uint64) bool {
+		return eventID != 3
+	})
+	if len(subscriptions) != 1 || subscriptions[1] == nil {
+		t.Errorf("Unexpected subscriptions %v", subscriptions)
+	}
+}
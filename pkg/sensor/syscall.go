@@ -15,6 +15,7 @@
 package sensor
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"sync/atomic"
@@ -22,6 +23,7 @@ import (
 	api "github.com/capsule8/capsule8/api/v0"
 
 	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/sys"
 	"github.com/capsule8/capsule8/pkg/sys/perf"
 
 	"github.com/golang/glog"
@@ -35,30 +37,69 @@ func (f *syscallFilter) decodeDummySysEnter(sample *perf.SampleRecord, data perf
 	return nil, nil
 }
 
+// newSyscallEnterEvent creates a SyscallEvent from the data of a syscall
+// enter kprobe, including the named arguments in argNames, if any.
+func newSyscallEnterEvent(data perf.TraceEventSampleData, argNames []string) *api.SyscallEvent {
+	id := data["id"].(int64)
+	name, _ := sys.SyscallName(id)
+
+	ev := &api.SyscallEvent{
+		Type: api.SyscallEventType_SYSCALL_EVENT_TYPE_ENTER,
+		Id:   id,
+		Name: name,
+		Arg0: data["arg0"].(uint64),
+		Arg1: data["arg1"].(uint64),
+		Arg2: data["arg2"].(uint64),
+		Arg3: data["arg3"].(uint64),
+		Arg4: data["arg4"].(uint64),
+		Arg5: data["arg5"].(uint64),
+	}
+
+	if len(argNames) > 0 {
+		ev.Arguments = make(map[string]*api.KernelFunctionCallEvent_FieldValue,
+			len(argNames))
+		for _, name := range argNames {
+			if v, ok := data[name]; ok {
+				ev.Arguments[name] = newFieldValue(v)
+			}
+		}
+	}
+
+	return ev
+}
+
 func (f *syscallFilter) decodeSyscallTraceEnter(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
 	ev := f.sensor.NewEventFromSample(sample, data)
 	ev.Event = &api.TelemetryEvent_Syscall{
-		Syscall: &api.SyscallEvent{
-			Type: api.SyscallEventType_SYSCALL_EVENT_TYPE_ENTER,
-			Id:   data["id"].(int64),
-			Arg0: data["arg0"].(uint64),
-			Arg1: data["arg1"].(uint64),
-			Arg2: data["arg2"].(uint64),
-			Arg3: data["arg3"].(uint64),
-			Arg4: data["arg4"].(uint64),
-			Arg5: data["arg5"].(uint64),
-		},
+		Syscall: newSyscallEnterEvent(data, nil),
 	}
 
 	return ev, nil
 }
 
+// newDecodeSyscallTraceEnterArgs returns a decoder for syscall enter events
+// that also decodes the syscall's named arguments.
+func (f *syscallFilter) newDecodeSyscallTraceEnterArgs(argNames []string) perf.TraceEventDecoderFn {
+	return func(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+		ev := f.sensor.NewEventFromSample(sample, data)
+		ev.Event = &api.TelemetryEvent_Syscall{
+			Syscall: newSyscallEnterEvent(data, argNames),
+		}
+
+		return ev, nil
+	}
+}
+
 func (f *syscallFilter) decodeSysExit(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	id := data["id"].(int64)
+	name, _ := sys.SyscallName(id)
+
 	ev := f.sensor.NewEventFromSample(sample, data)
 	ev.Event = &api.TelemetryEvent_Syscall{
 		Syscall: &api.SyscallEvent{
 			Type: api.SyscallEventType_SYSCALL_EVENT_TYPE_EXIT,
-			Id:   data["id"].(int64),
+			Id:   id,
+			Name: name,
 			Ret:  data["ret"].(int64),
 		},
 	}
@@ -169,6 +210,26 @@ func rewriteSyscallEventFilter(sef *api.SyscallEventFilter) {
 	}
 }
 
+// syscallFilterExpression returns the filter expression for a syscall event
+// filter. If the filter selects a syscall by name, the expression also
+// filters on the syscall's number.
+func syscallFilterExpression(sef *api.SyscallEventFilter) (*api.Expression, error) {
+	if len(sef.Name) == 0 {
+		return sef.FilterExpression, nil
+	}
+
+	nr, ok := sys.SyscallNumber(sef.Name)
+	if !ok {
+		return nil, fmt.Errorf("Unknown system call %q", sef.Name)
+	}
+
+	return expression.LogicalAnd(
+		expression.Equal(
+			expression.Identifier("id"),
+			expression.Value(nr)),
+		sef.FilterExpression), nil
+}
+
 const (
 	syscallNewEnterKprobeAddress string = "syscall_trace_enter_phase1"
 	syscallOldEnterKprobeAddress string = "syscall_trace_enter"
@@ -184,38 +245,266 @@ const (
 		"arg5=+64(%di):u64" // r9
 )
 
+// The fields fetched for all syscall enter events
+var syscallEnterFieldTypes = kprobeFieldTypes(syscallEnterKprobeFetchargs)
+
+// Offsets into the x86_64 version of struct pt_regs of the registers used to
+// pass each syscall argument, in order.
+var syscallArgOffsets = []int{
+	112, // di
+	104, // si
+	96,  // dx
+	56,  // r10
+	72,  // r8
+	64,  // r9
+}
+
+// syscallArgFetchType returns the kprobe fetch type to use for a syscall
+// argument with the given C type name. Pointers to characters are fetched as
+// strings from user memory; other pointers are fetched as addresses.
+func syscallArgFetchType(typeName string) string {
+	var (
+		base     []string
+		pointers int
+	)
+
+	for _, token := range strings.Fields(strings.Replace(typeName, "*", " * ", -1)) {
+		switch token {
+		case "const", "__user":
+		case "*":
+			pointers++
+		default:
+			base = append(base, token)
+		}
+	}
+
+	t := strings.Join(base, " ")
+	if pointers > 0 {
+		if pointers == 1 && t == "char" {
+			return "string"
+		}
+		return "u64"
+	}
+
+	switch t {
+	case "int", "pid_t", "clockid_t", "timer_t", "key_serial_t", "mqd_t",
+		"rwf_t":
+		return "s32"
+	case "unsigned int", "unsigned", "uid_t", "gid_t", "qid_t", "u32",
+		"__u32":
+		return "u32"
+	case "umode_t":
+		return "u16"
+	case "long", "off_t", "loff_t", "ssize_t", "time_t", "s64", "__s64":
+		return "s64"
+	}
+	return "u64"
+}
+
+// syscallArgFetchargs returns the kprobe fetchargs for the named arguments of
+// a syscall, given the fields of the syscall's sys_enter tracepoint, along
+// with the names of the arguments. Arguments with names that conflict with
+// the fields fetched for all syscalls are not included.
+func syscallArgFetchargs(fields []perf.TraceEventFieldInfo) (string, []string) {
+	var (
+		fetchargs []string
+		names     []string
+	)
+
+	i := 0
+	for _, field := range fields {
+		if strings.HasPrefix(field.Name, "common_") ||
+			field.Name == "__syscall_nr" {
+			continue
+		}
+		if i >= len(syscallArgOffsets) {
+			break
+		}
+		offset := syscallArgOffsets[i]
+		i++
+
+		if _, ok := syscallEnterFieldTypes[field.Name]; ok {
+			continue
+		}
+
+		var fetcharg string
+		t := syscallArgFetchType(field.TypeName)
+		if t == "string" {
+			fetcharg = fmt.Sprintf("%s=+0(+%d(%%di)):string",
+				field.Name, offset)
+		} else {
+			fetcharg = fmt.Sprintf("%s=+%d(%%di):%s",
+				field.Name, offset, t)
+		}
+		fetchargs = append(fetchargs, fetcharg)
+		names = append(names, field.Name)
+	}
+
+	return strings.Join(fetchargs, " "), names
+}
+
+// syscallEnterFetchargs returns the kprobe fetchargs for enter events for
+// the syscall with the given name, including its named arguments, along with
+// the names of the arguments.
+func syscallEnterFetchargs(tracingDir, name string) (string, []string, error) {
+	fields, err := perf.TraceEventFormat(tracingDir,
+		sys.SyscallEnterTracepoint(name))
+	if err != nil {
+		return "", nil, err
+	}
+
+	fetchargs, names := syscallArgFetchargs(fields)
+	if len(fetchargs) > 0 {
+		fetchargs = syscallEnterKprobeFetchargs + " " + fetchargs
+	} else {
+		fetchargs = syscallEnterKprobeFetchargs
+	}
+	return fetchargs, names, nil
+}
+
+// joinSyscallFilters returns a filter that matches any of the given filters.
+func joinSyscallFilters(filterSet map[string]bool) string {
+	filters := make([]string, 0, len(filterSet))
+	for k := range filterSet {
+		filters = append(filters, fmt.Sprintf("(%s)", k))
+	}
+	return strings.Join(filters, " || ")
+}
+
+func (f *syscallFilter) registerEnterKprobe(
+	eventMap subscriptionMap,
+	fetchargs string,
+	fn perf.TraceEventDecoderFn,
+	filter string,
+) {
+	sensor := f.sensor
+	if atomic.AddInt64(&sensor.dummySyscallEventCount, 1) == 1 {
+		// Create the dummy syscall event. This event is needed
+		// to put the kernel into a mode where it'll make the
+		// function calls needed to make the kprobe we'll add
+		// fire. Add the tracepoint, but make sure it never
+		// adds events into the ringbuffer by using a filter
+		// that will never evaluate true.
+		eventName := "raw_syscalls/sys_enter"
+		eventID, err := sensor.monitor.RegisterTracepoint(
+			eventName, f.decodeDummySysEnter,
+			perf.WithFilter("id == 0x7fffffff"))
+		if err != nil {
+			glog.V(1).Infof("Couldn't register dummy syscall event %s: %v", eventName, err)
+			atomic.AddInt64(&sensor.dummySyscallEventCount, -1)
+		} else {
+			sensor.dummySyscallEventID = eventID
+		}
+	}
+
+	// There are two possible kprobes. Newer kernels (>= 4.1) have
+	// refactored syscall entry code, so syscall_trace_enter_phase1
+	// is the right one, but for older kernels syscall_trace_enter
+	// is the right one. Both have the same signature, so the
+	// fetchargs doesn't have to change. Try the new probe first,
+	// because the old probe will also set in the newer kernels,
+	// but it won't fire.
+	eventID, err := sensor.monitor.RegisterKprobe(
+		syscallNewEnterKprobeAddress, false,
+		fetchargs, fn, perf.WithFilter(filter))
+	if err != nil {
+		eventID, err = sensor.monitor.RegisterKprobe(
+			syscallOldEnterKprobeAddress, false,
+			fetchargs, fn, perf.WithFilter(filter))
+	}
+	if err != nil {
+		glog.V(1).Infof("Couldn't register syscall enter kprobe: %v", err)
+	} else {
+		s := eventMap.subscribe(eventID)
+		s.unregister = func(uint64, *subscription) {
+			eventID := sensor.dummySyscallEventID
+			if atomic.AddInt64(&sensor.dummySyscallEventCount, -1) == 0 {
+				sensor.monitor.UnregisterEvent(eventID)
+			}
+		}
+	}
+}
+
+// parseSyscallEventFilter checks a syscall event filter. Deprecated fields
+// must already have been translated into the filter expression.
+func parseSyscallEventFilter(sensor *Sensor, sef *api.SyscallEventFilter) (*parsedFilter, error) {
+	if sef.Type == api.SyscallEventType_SYSCALL_EVENT_TYPE_COMPLETE {
+		return parseSyscallCompleteEventFilter(sensor, sef)
+	}
+
+	tree, err := syscallFilterExpression(sef)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		tracepoints, kprobes []string
+		types                expression.FieldTypeMap
+	)
+	switch sef.Type {
+	case api.SyscallEventType_SYSCALL_EVENT_TYPE_ENTER:
+		tracepoints = []string{"raw_syscalls/sys_enter"}
+		kprobes = []string{syscallNewEnterKprobeAddress}
+		fetchargs := syscallEnterKprobeFetchargs
+		if len(sef.Name) > 0 {
+			fetchargs, _, err = syscallEnterFetchargs(
+				sensor.tracingDir(), sef.Name)
+			if err != nil {
+				return nil, fmt.Errorf("Couldn't get arguments for syscall %s: %s",
+					sef.Name, err)
+			}
+		}
+		types = kprobeFieldTypes(fetchargs)
+	case api.SyscallEventType_SYSCALL_EVENT_TYPE_EXIT:
+		tracepoints = []string{"raw_syscalls/sys_exit"}
+		types, err = sensor.tracepointFieldTypes("raw_syscalls/sys_exit")
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("Invalid syscall event type %s", sef.Type)
+	}
+
+	// No wildcard filters for now
+	if !containsIDFilter(tree) {
+		return nil, errors.New("Syscall event filters must filter on id or name")
+	}
+
+	return newParsedFilter(tracepoints, kprobes, tree, true, types)
+}
+
 func registerSyscallEvents(sensor *Sensor, eventMap subscriptionMap, events []*api.SyscallEventFilter) {
 	enterFilters := make(map[string]bool)
+	namedEnterFilters := make(map[string]map[string]bool)
 	exitFilters := make(map[string]bool)
+	completeFilters := make(map[string][]*api.Expression)
 
 	for _, sef := range events {
 		// Translate deprecated fields into an expression
 		rewriteSyscallEventFilter(sef)
 
-		if !containsIDFilter(sef.FilterExpression) {
-			// No wildcard filters for now
-			continue
-		}
-
-		expr, err := expression.NewExpression(sef.FilterExpression)
+		pf, err := parseSyscallEventFilter(sensor, sef)
 		if err != nil {
 			glog.V(1).Infof("Invalid syscall event filter: %s", err)
 			continue
 		}
-		err = expr.ValidateKernelFilter()
-		if err != nil {
-			glog.V(1).Infof("Invalid syscall event filter as kernel filter: %s", err)
-			continue
-		}
-		s := expr.KernelFilterString()
 
 		switch sef.Type {
 		case api.SyscallEventType_SYSCALL_EVENT_TYPE_ENTER:
-			enterFilters[s] = true
+			s := pf.kernelFilterString()
+			if len(sef.Name) > 0 {
+				if namedEnterFilters[sef.Name] == nil {
+					namedEnterFilters[sef.Name] = make(map[string]bool)
+				}
+				namedEnterFilters[sef.Name][s] = true
+			} else {
+				enterFilters[s] = true
+			}
 		case api.SyscallEventType_SYSCALL_EVENT_TYPE_EXIT:
-			exitFilters[s] = true
-		default:
-			continue
+			exitFilters[pf.kernelFilterString()] = true
+		case api.SyscallEventType_SYSCALL_EVENT_TYPE_COMPLETE:
+			completeFilters[sef.Name] = append(
+				completeFilters[sef.Name], sef.FilterExpression)
 		}
 	}
 
@@ -224,69 +513,32 @@ func registerSyscallEvents(sensor *Sensor, eventMap subscriptionMap, events []*a
 	}
 
 	if len(enterFilters) > 0 {
-		filters := make([]string, 0, len(enterFilters))
-		for k := range enterFilters {
-			filters = append(filters, fmt.Sprintf("(%s)", k))
-		}
-		filter := strings.Join(filters, " || ")
-
-		if atomic.AddInt64(&sensor.dummySyscallEventCount, 1) == 1 {
-			// Create the dummy syscall event. This event is needed
-			// to put the kernel into a mode where it'll make the
-			// function calls needed to make the kprobe we'll add
-			// fire. Add the tracepoint, but make sure it never
-			// adds events into the ringbuffer by using a filter
-			// that will never evaluate true.
-			eventName := "raw_syscalls/sys_enter"
-			eventID, err := sensor.monitor.RegisterTracepoint(
-				eventName, f.decodeDummySysEnter,
-				perf.WithFilter("id == 0x7fffffff"))
-			if err != nil {
-				glog.V(1).Infof("Couldn't register dummy syscall event %s: %v", eventName, err)
-				atomic.AddInt64(&sensor.dummySyscallEventCount, -1)
-			} else {
-				sensor.dummySyscallEventID = eventID
-			}
-		}
+		f.registerEnterKprobe(eventMap,
+			syscallEnterKprobeFetchargs, f.decodeSyscallTraceEnter,
+			joinSyscallFilters(enterFilters))
+	}
 
-		// There are two possible kprobes. Newer kernels (>= 4.1) have
-		// refactored syscall entry code, so syscall_trace_enter_phase1
-		// is the right one, but for older kernels syscall_trace_enter
-		// is the right one. Both have the same signature, so the
-		// fetchargs doesn't have to change. Try the new probe first,
-		// because the old probe will also set in the newer kernels,
-		// but it won't fire.
-		eventID, err := sensor.monitor.RegisterKprobe(
-			syscallNewEnterKprobeAddress, false,
-			syscallEnterKprobeFetchargs,
-			f.decodeSyscallTraceEnter,
-			perf.WithFilter(filter))
+	// Syscalls selected by name each get their own kprobe so that their
+	// named arguments can be fetched.
+	for name, filters := range namedEnterFilters {
+		fetchargs, argNames, err := syscallEnterFetchargs(
+			sensor.tracingDir(), name)
 		if err != nil {
-			eventID, err = sensor.monitor.RegisterKprobe(
-				syscallOldEnterKprobeAddress, false,
-				syscallEnterKprobeFetchargs,
-				f.decodeSyscallTraceEnter,
-				perf.WithFilter(filter))
-		}
-		if err != nil {
-			glog.V(1).Infof("Couldn't register syscall enter kprobe: %v", err)
-		} else {
-			s := eventMap.subscribe(eventID)
-			s.unregister = func(uint64, *subscription) {
-				eventID := sensor.dummySyscallEventID
-				if atomic.AddInt64(&sensor.dummySyscallEventCount, -1) == 0 {
-					sensor.monitor.UnregisterEvent(eventID)
-				}
-			}
+			glog.V(1).Infof("Couldn't get arguments for syscall %s: %v",
+				name, err)
+			continue
 		}
+		f.registerEnterKprobe(eventMap, fetchargs,
+			f.newDecodeSyscallTraceEnterArgs(argNames),
+			joinSyscallFilters(filters))
+	}
+
+	for name, trees := range completeFilters {
+		registerSyscallCompleteEvents(sensor, eventMap, name, trees)
 	}
 
 	if len(exitFilters) > 0 {
-		filters := make([]string, 0, len(exitFilters))
-		for k := range exitFilters {
-			filters = append(filters, fmt.Sprintf("(%s)", k))
-		}
-		filter := strings.Join(filters, " || ")
+		filter := joinSyscallFilters(exitFilters)
 
 		eventName := "raw_syscalls/sys_exit"
 		eventID, err := sensor.monitor.RegisterTracepoint(eventName, f.decodeSysExit,
@@ -0,0 +1,281 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"sync"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/sys"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+
+	"github.com/golang/glog"
+)
+
+// The maximum number of syscalls awaiting exit that are tracked for each
+// subscribed syscall. Syscalls that never return (e.g., exit_group) would
+// otherwise be tracked forever.
+const maxPendingSyscalls = 65536
+
+// pendingSyscall is a syscall that has been entered by a thread, but has not
+// yet returned.
+type pendingSyscall struct {
+	tid  int32
+	time uint64
+	data perf.TraceEventSampleData
+}
+
+// syscallCompleteFilter pairs the enter and exit events for a syscall made
+// by each thread into a single complete event. Filters are evaluated by the
+// sensor since the duration is not known to the kernel.
+type syscallCompleteFilter struct {
+	sensor   *Sensor
+	argNames []string
+	types    expression.FieldTypeMap
+
+	// A nil expression matches all events
+	filters []*expression.Expression
+
+	// Pending syscalls are kept in the order that they were entered, so
+	// that the oldest can be evicted without searching for it.
+	mu      sync.Mutex
+	pending map[int32]*list.Element // indexed by tid
+	order   *list.List              // of pendingSyscall, oldest first
+}
+
+func newSyscallCompleteFilter(sensor *Sensor, fetchargs string, argNames []string) *syscallCompleteFilter {
+	return &syscallCompleteFilter{
+		sensor:   sensor,
+		argNames: argNames,
+		types:    syscallCompleteFieldTypes(fetchargs),
+		pending:  make(map[int32]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// syscallCompleteFieldTypes returns the types of the fields available to
+// filters for complete events for a syscall with the given enter fetchargs.
+func syscallCompleteFieldTypes(fetchargs string) expression.FieldTypeMap {
+	types := kprobeFieldTypes(fetchargs)
+	types["ret"] = perf.TraceEventFieldTypeSignedInt64
+	types["duration_nanos"] = perf.TraceEventFieldTypeUnsignedInt64
+	return types
+}
+
+// parseSyscallCompleteEventFilter checks a syscall complete event filter.
+func parseSyscallCompleteEventFilter(sensor *Sensor, sef *api.SyscallEventFilter) (*parsedFilter, error) {
+	if len(sef.Name) == 0 {
+		return nil, errors.New("Syscall complete event filters require a name")
+	}
+	if _, ok := sys.SyscallNumber(sef.Name); !ok {
+		return nil, fmt.Errorf("Unknown system call %q", sef.Name)
+	}
+
+	fetchargs, _, err := syscallEnterFetchargs(sensor.tracingDir(),
+		sef.Name)
+	if err != nil {
+		return nil, fmt.Errorf("Couldn't get arguments for syscall %s: %s",
+			sef.Name, err)
+	}
+
+	// The duration is not known to the kernel, so complete events are
+	// only filtered by the sensor.
+	return newParsedFilter(
+		[]string{"raw_syscalls/sys_enter", "raw_syscalls/sys_exit"},
+		[]string{syscallNewEnterKprobeAddress},
+		sef.FilterExpression, false,
+		syscallCompleteFieldTypes(fetchargs))
+}
+
+func (f *syscallCompleteFilter) add(tree *api.Expression) error {
+	expr, err := parseFilterExpression(tree, false, f.types)
+	if err != nil {
+		return err
+	}
+
+	f.filters = append(f.filters, expr)
+	return nil
+}
+
+func (f *syscallCompleteFilter) match(values expression.FieldValueMap) bool {
+	for _, expr := range f.filters {
+		if expr == nil {
+			return true
+		}
+		v, err := expr.Evaluate(f.types, values)
+		if err != nil {
+			glog.V(1).Infof("Expression evaluation error: %s", err)
+			continue
+		}
+		if expression.IsValueTrue(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *syscallCompleteFilter) decodeEnter(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	tid := data["common_pid"].(int32)
+
+	f.mu.Lock()
+	f.removePending(tid)
+	if len(f.pending) >= maxPendingSyscalls {
+		f.removePending(f.order.Front().Value.(pendingSyscall).tid)
+	}
+	f.pending[tid] = f.order.PushBack(pendingSyscall{
+		tid:  tid,
+		time: sample.Time,
+		data: data,
+	})
+	f.mu.Unlock()
+
+	// Nothing is emitted until the syscall returns
+	return nil, nil
+}
+
+// removePending removes the pending syscall for a thread, if there is one.
+// The lock must be held by the caller.
+func (f *syscallCompleteFilter) removePending(tid int32) (pendingSyscall, bool) {
+	e, ok := f.pending[tid]
+	if !ok {
+		return pendingSyscall{}, false
+	}
+	delete(f.pending, tid)
+	return f.order.Remove(e).(pendingSyscall), true
+}
+
+// decodeTaskExit removes the pending syscall of a thread that has exited,
+// since the syscall will never return.
+func (f *syscallCompleteFilter) decodeTaskExit(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	f.mu.Lock()
+	f.removePending(data["pid"].(int32))
+	f.mu.Unlock()
+
+	return nil, nil
+}
+
+// decodeTaskExec handles a thread other than the thread group leader calling
+// exec, which changes the thread's ID to the leader's. Any syscall pending for
+// the leader will never return, and a syscall pending for the thread returns
+// with the leader's ID.
+func (f *syscallCompleteFilter) decodeTaskExec(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	pid := data["pid"].(int32)
+	oldPid := data["old_pid"].(int32)
+	if pid == oldPid {
+		return nil, nil
+	}
+
+	f.mu.Lock()
+	f.removePending(pid)
+	if p, ok := f.removePending(oldPid); ok {
+		p.tid = pid
+		f.pending[pid] = f.order.PushBack(p)
+	}
+	f.mu.Unlock()
+
+	return nil, nil
+}
+
+func (f *syscallCompleteFilter) decodeExit(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	tid := data["common_pid"].(int32)
+
+	f.mu.Lock()
+	p, ok := f.removePending(tid)
+	f.mu.Unlock()
+
+	if !ok {
+		// The syscall was entered before the subscription began
+		return nil, nil
+	}
+
+	var duration uint64
+	if sample.Time > p.time {
+		duration = sample.Time - p.time
+	}
+	ret := data["ret"].(int64)
+
+	values := make(expression.FieldValueMap, len(p.data)+2)
+	for k, v := range p.data {
+		values[k] = v
+	}
+	values["ret"] = ret
+	values["duration_nanos"] = duration
+	if !f.match(values) {
+		return nil, nil
+	}
+
+	sev := newSyscallEnterEvent(p.data, f.argNames)
+	sev.Type = api.SyscallEventType_SYSCALL_EVENT_TYPE_COMPLETE
+	sev.Ret = ret
+	sev.DurationNanos = duration
+
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.TelemetryEvent_Syscall{
+		Syscall: sev,
+	}
+
+	return ev, nil
+}
+
+// registerSyscallCompleteEvents registers the events needed to pair the
+// enter and exit events of the named syscall.
+func registerSyscallCompleteEvents(
+	sensor *Sensor,
+	eventMap subscriptionMap,
+	name string,
+	trees []*api.Expression,
+) {
+	nr, ok := sys.SyscallNumber(name)
+	if !ok {
+		glog.V(1).Infof("Unknown system call %q", name)
+		return
+	}
+
+	fetchargs, argNames, err := syscallEnterFetchargs(sensor.tracingDir(), name)
+	if err != nil {
+		glog.V(1).Infof("Couldn't get arguments for syscall %s: %v",
+			name, err)
+		return
+	}
+
+	f := newSyscallCompleteFilter(sensor, fetchargs, argNames)
+	for _, tree := range trees {
+		if err = f.add(tree); err != nil {
+			glog.V(1).Infof("Invalid syscall event filter: %s", err)
+		}
+	}
+	if len(f.filters) == 0 {
+		return
+	}
+
+	filter := fmt.Sprintf("id == %d", nr)
+	sf := syscallFilter{
+		sensor: sensor,
+	}
+	sf.registerEnterKprobe(eventMap, fetchargs, f.decodeEnter, filter)
+	registerEvent(sensor.monitor, eventMap, "raw_syscalls/sys_exit",
+		f.decodeExit, map[string]int{filter: 1})
+
+	// Pending syscalls are discarded when their threads exit or exec
+	registerEvent(sensor.monitor, eventMap, "sched/sched_process_exit",
+		f.decodeTaskExit, map[string]int{"": 1})
+	registerEvent(sensor.monitor, eventMap, "sched/sched_process_exec",
+		f.decodeTaskExec, map[string]int{"": 1})
+}
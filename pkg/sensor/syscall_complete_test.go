@@ -0,0 +1,156 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+)
+
+func newSyscallEnterData(tid int32, filename string) perf.TraceEventSampleData {
+	return perf.TraceEventSampleData{
+		"common_pid": tid,
+		"id":         int64(257),
+		"arg0":       uint64(0xffffff9c),
+		"arg1":       uint64(0x7ffc0000),
+		"arg2":       uint64(0),
+		"arg3":       uint64(0),
+		"arg4":       uint64(0),
+		"arg5":       uint64(0),
+		"filename":   filename,
+	}
+}
+
+func TestSyscallComplete(t *testing.T) {
+	s := &Sensor{}
+	s.ProcessCache.cache = newArrayTaskCache(32)
+
+	fetchargs := syscallEnterKprobeFetchargs + " filename=+0(+104(%di)):string"
+	f := newSyscallCompleteFilter(s, fetchargs, []string{"filename"})
+	err := f.add(expression.GreaterThan(
+		expression.Identifier("duration_nanos"),
+		expression.Value(uint64(100*time.Millisecond))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = f.add(expression.Equal(
+		expression.Identifier("no_such_field"),
+		expression.Value(uint64(0)))); err == nil {
+		t.Error("Expected error for invalid filter")
+	}
+
+	start := uint64(time.Second)
+	exit := func(tid int32, d time.Duration, ret int64) *api.TelemetryEvent {
+		i, err := f.decodeExit(
+			&perf.SampleRecord{Time: start + uint64(d)},
+			perf.TraceEventSampleData{
+				"common_pid": tid,
+				"id":         int64(257),
+				"ret":        ret,
+			})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == nil {
+			return nil
+		}
+		return i.(*api.TelemetryEvent)
+	}
+
+	// Exit without a matching enter
+	if ev := exit(1, 0, 0); ev != nil {
+		t.Errorf("Unexpected event for unmatched exit: %+v", ev)
+	}
+
+	f.decodeEnter(&perf.SampleRecord{Time: start}, newSyscallEnterData(1, "/etc/passwd"))
+	f.decodeEnter(&perf.SampleRecord{Time: start}, newSyscallEnterData(2, "/etc/shadow"))
+
+	// Too fast to match the filter
+	if ev := exit(1, 10*time.Millisecond, 3); ev != nil {
+		t.Errorf("Unexpected event for fast syscall: %+v", ev)
+	}
+
+	ev := exit(2, 250*time.Millisecond, -13)
+	if ev == nil {
+		t.Fatal("Expected event for slow syscall")
+	}
+	sev := ev.GetSyscall()
+	if sev.Type != api.SyscallEventType_SYSCALL_EVENT_TYPE_COMPLETE ||
+		sev.Id != 257 || sev.Ret != -13 ||
+		sev.DurationNanos != uint64(250*time.Millisecond) {
+		t.Errorf("Unexpected complete event %+v", sev)
+	}
+	if a := sev.Arguments["filename"]; a == nil || a.GetStringValue() != "/etc/shadow" {
+		t.Errorf("Unexpected filename argument %+v", a)
+	}
+
+	if len(f.pending) != 0 {
+		t.Errorf("Unexpected pending syscalls %+v", f.pending)
+	}
+}
+
+func TestSyscallCompleteEviction(t *testing.T) {
+	f := newSyscallCompleteFilter(&Sensor{}, syscallEnterKprobeFetchargs, nil)
+	for i := 0; i <= maxPendingSyscalls; i++ {
+		f.decodeEnter(&perf.SampleRecord{Time: uint64(i + 1)},
+			newSyscallEnterData(int32(i+1), ""))
+	}
+
+	if len(f.pending) != maxPendingSyscalls {
+		t.Errorf("Expected %d pending syscalls, got %d",
+			maxPendingSyscalls, len(f.pending))
+	}
+	if _, ok := f.pending[1]; ok {
+		t.Error("Expected oldest pending syscall to be evicted")
+	}
+}
+
+func TestSyscallCompleteTaskExitAndExec(t *testing.T) {
+	f := newSyscallCompleteFilter(&Sensor{}, syscallEnterKprobeFetchargs, nil)
+	for tid := int32(1); tid <= 4; tid++ {
+		f.decodeEnter(&perf.SampleRecord{Time: uint64(tid)},
+			newSyscallEnterData(tid, ""))
+	}
+
+	f.decodeTaskExit(&perf.SampleRecord{}, perf.TraceEventSampleData{
+		"pid": int32(2),
+	})
+	if _, ok := f.pending[2]; ok {
+		t.Error("Expected pending syscall to be removed on exit")
+	}
+
+	// Thread 4 execs and becomes thread group leader 3
+	f.decodeTaskExec(&perf.SampleRecord{}, perf.TraceEventSampleData{
+		"pid":     int32(3),
+		"old_pid": int32(4),
+	})
+	if _, ok := f.pending[4]; ok {
+		t.Error("Expected pending syscall to be moved on exec")
+	}
+	e, ok := f.pending[3]
+	if !ok || e.Value.(pendingSyscall).time != 4 {
+		t.Error("Expected pending syscall for new thread ID")
+	}
+
+	if len(f.pending) != 2 || f.order.Len() != 2 ||
+		f.order.Front().Value.(pendingSyscall).tid != 1 {
+		t.Errorf("Unexpected pending syscalls %+v", f.pending)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+)
+
+func TestSyscallArgFetchType(t *testing.T) {
+	types := map[string]string{
+		"const char *":               "string",
+		"const char __user *":        "string",
+		"char *":                     "string",
+		"const char *const *":        "u64",
+		"struct stat *":              "u64",
+		"int":                        "s32",
+		"pid_t":                      "s32",
+		"unsigned int":               "u32",
+		"umode_t":                    "u16",
+		"loff_t":                     "s64",
+		"unsigned long":              "u64",
+		"size_t":                     "u64",
+		"struct __kernel_timespec *": "u64",
+	}
+	for typeName, expected := range types {
+		if ft := syscallArgFetchType(typeName); ft != expected {
+			t.Errorf("Expected %s for %q, got %s", expected, typeName, ft)
+		}
+	}
+}
+
+func TestSyscallArgFetchargs(t *testing.T) {
+	fields := []perf.TraceEventFieldInfo{
+		{Name: "common_type", TypeName: "unsigned short", Offset: 0},
+		{Name: "common_pid", TypeName: "int", Offset: 4},
+		{Name: "__syscall_nr", TypeName: "int", Offset: 8},
+		{Name: "dfd", TypeName: "int", Offset: 16},
+		{Name: "filename", TypeName: "const char *", Offset: 24},
+		{Name: "flags", TypeName: "int", Offset: 32},
+		{Name: "mode", TypeName: "umode_t", Offset: 40},
+	}
+
+	fetchargs, names := syscallArgFetchargs(fields)
+	expected := "dfd=+112(%di):s32 filename=+0(+104(%di)):string " +
+		"flags=+96(%di):s32 mode=+56(%di):u16"
+	if fetchargs != expected {
+		t.Errorf("Expected fetchargs %q, got %q", expected, fetchargs)
+	}
+	if !reflect.DeepEqual(names, []string{"dfd", "filename", "flags", "mode"}) {
+		t.Errorf("Unexpected argument names %v", names)
+	}
+
+	// keyctl(2) names its arguments option, arg2, arg3, etc.
+	fields = []perf.TraceEventFieldInfo{
+		{Name: "option", TypeName: "int", Offset: 16},
+		{Name: "arg2", TypeName: "unsigned long", Offset: 24},
+	}
+	fetchargs, names = syscallArgFetchargs(fields)
+	if fetchargs != "option=+112(%di):s32" ||
+		!reflect.DeepEqual(names, []string{"option"}) {
+		t.Errorf("Unexpected fetchargs %q for conflicting names", fetchargs)
+	}
+}
+
+func TestSyscallFilterExpression(t *testing.T) {
+	if runtime.GOARCH != "amd64" {
+		t.Skip("System call numbers are only known for amd64")
+	}
+
+	tree, err := syscallFilterExpression(&api.SyscallEventFilter{
+		Type: api.SyscallEventType_SYSCALL_EVENT_TYPE_ENTER,
+		Name: "openat",
+		FilterExpression: expression.Equal(
+			expression.Identifier("filename"),
+			expression.Value("/etc/shadow")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !containsIDFilter(tree) {
+		t.Error("Expected filter on id")
+	}
+
+	expr, err := expression.NewExpression(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `id == 257 && filename == "/etc/shadow"`
+	if s := expr.KernelFilterString(); s != expected {
+		t.Errorf("Expected %q, got %q", expected, s)
+	}
+
+	_, err = syscallFilterExpression(&api.SyscallEventFilter{
+		Type: api.SyscallEventType_SYSCALL_EVENT_TYPE_ENTER,
+		Name: "no_such_syscall",
+	})
+	if err == nil {
+		t.Error("Expected error for unknown syscall")
+	}
+}
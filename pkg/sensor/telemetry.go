@@ -23,15 +23,19 @@ import (
 	"net"
 	"os"
 	"strings"
+	"time"
 
 	api "github.com/capsule8/capsule8/api/v0"
 	"github.com/capsule8/capsule8/pkg/config"
 	"github.com/golang/glog"
 
+	"golang.org/x/net/context"
 	"golang.org/x/sys/unix"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
 // TelemetryService is a service that can be used with the ServiceManager to
@@ -113,26 +117,11 @@ func (ts *TelemetryService) Serve() error {
 	if config.Sensor.UseTLS {
 		glog.V(1).Infoln("Starting telemetry server with TLS credentials")
 
-		certificate, err := tls.LoadX509KeyPair(config.Sensor.TLSServerCertPath, config.Sensor.TLSServerKeyPath)
+		tlsConfig, err := serverTLSConfig()
 		if err != nil {
-			return fmt.Errorf("could not load server key pair: %s", err)
+			return err
 		}
-
-		certPool := x509.NewCertPool()
-		ca, err := ioutil.ReadFile(config.Sensor.TLSCACertPath)
-		if err != nil {
-			return fmt.Errorf("could not read ca certificate: %s", err)
-		}
-
-		if ok := certPool.AppendCertsFromPEM(ca); !ok {
-			return errors.New("failed to append certs")
-		}
-
-		creds := credentials.NewTLS(&tls.Config{
-			ClientAuth:   tls.RequireAndVerifyClientCert,
-			Certificates: []tls.Certificate{certificate},
-			ClientCAs:    certPool,
-		})
+		creds := credentials.NewTLS(tlsConfig)
 		ts.server = grpc.NewServer(grpc.Creds(creds))
 	} else {
 		glog.V(1).Infoln("Starting telemetry server")
@@ -141,12 +130,41 @@ func (ts *TelemetryService) Serve() error {
 
 	t := &telemetryServiceServer{
 		sensor: ts.sensor,
+		acked:  newAckSubscriptionMap(),
 	}
 	api.RegisterTelemetryServiceServer(ts.server, t)
+	api.RegisterSensorServiceServer(ts.server,
+		&sensorServiceServer{sensor: ts.sensor})
 
 	return ts.server.Serve(lis)
 }
 
+// serverTLSConfig returns the TLS configuration for serving the sensor API
+// using the configured credentials. Clients are required to present a
+// certificate signed by the configured certificate authority.
+func serverTLSConfig() (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(config.Sensor.TLSServerCertPath, config.Sensor.TLSServerKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not load server key pair: %s", err)
+	}
+
+	certPool := x509.NewCertPool()
+	ca, err := ioutil.ReadFile(config.Sensor.TLSCACertPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read ca certificate: %s", err)
+	}
+
+	if ok := certPool.AppendCertsFromPEM(ca); !ok {
+		return nil, errors.New("failed to append certs")
+	}
+
+	return &tls.Config{
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    certPool,
+	}, nil
+}
+
 // Stop will stop a running TelemetryService.
 func (ts *TelemetryService) Stop() {
 	ts.server.Stop()
@@ -154,6 +172,7 @@ func (ts *TelemetryService) Stop() {
 
 type telemetryServiceServer struct {
 	sensor *Sensor
+	acked  *ackSubscriptionMap
 }
 
 func (t *telemetryServiceServer) GetEvents(req *api.GetEventsRequest, stream api.TelemetryService_GetEventsServer) error {
@@ -174,27 +193,188 @@ func (t *telemetryServiceServer) GetEvents(req *api.GetEventsRequest, stream api
 		eventStream.Close()
 	}()
 
-sendLoop:
-	for {
-		ev, ok := <-eventStream.Data
-		if !ok {
-			break sendLoop
-		}
+	var (
+		batch     []*api.ReceivedTelemetryEvent
+		maxEvents int
+		latency   time.Duration
+		timer     *time.Timer
+		timerC    <-chan time.Time
+	)
 
+	if b := sub.BatchOptions; b != nil && (b.MaxEvents > 0 || b.MaxLatency > 0) {
+		maxEvents = int(b.MaxEvents)
+		latency = time.Duration(b.MaxLatency)
+	} else {
 		// Send back events right away
-		te := &api.ReceivedTelemetryEvent{
-			Event: ev.(*api.TelemetryEvent),
+		maxEvents = 1
+	}
+
+	flush := func() error {
+		if timer != nil && !timer.Stop() {
+			// Make sure that a stale expiration isn't seen
+			// after the timer is reset for the next batch.
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timerC = nil
+		if len(batch) == 0 {
+			return nil
 		}
 
-		err = stream.Send(&api.GetEventsResponse{
-			Events: []*api.ReceivedTelemetryEvent{
-				te,
-			},
+		err := stream.Send(&api.GetEventsResponse{
+			Events: batch,
 		})
+		batch = nil
 		if err != nil {
-			break sendLoop
+			glog.V(1).Infof("Failed to send events: %s", err)
+		}
+		return err
+	}
+
+sendLoop:
+	for {
+		select {
+		case ev, ok := <-eventStream.Data:
+			if !ok {
+				break sendLoop
+			}
+
+			batch = append(batch, &api.ReceivedTelemetryEvent{
+				Event: ev.(*api.TelemetryEvent),
+			})
+
+			if maxEvents > 0 && len(batch) >= maxEvents {
+				if err = flush(); err != nil {
+					return err
+				}
+			} else if len(batch) == 1 && latency > 0 {
+				// Start the latency timer with the first event
+				// in the batch
+				if timer == nil {
+					timer = time.NewTimer(latency)
+				} else {
+					timer.Reset(latency)
+				}
+				timerC = timer.C
+			}
+
+		case <-timerC:
+			if err = flush(); err != nil {
+				return err
+			}
 		}
 	}
 
+	if err = flush(); err != nil {
+		return err
+	}
+
+	// The event stream closes on its own when the subscription ends
+	// (e.g. for_duration has elapsed). Returning nil ends the gRPC
+	// stream with an OK status.
+	glog.V(1).Infof("Subscription %+v ended", sub)
 	return nil
 }
+
+func (t *telemetryServiceServer) GetEventsWithAck(stream api.TelemetryService_GetEventsWithAckServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	var as *ackSubscription
+	if len(req.SubscriptionId) > 0 {
+		glog.V(1).Infof("GetEventsWithAck(%s)", req.SubscriptionId)
+
+		as = t.acked.lookup(req.SubscriptionId)
+		if as == nil {
+			return status.Errorf(codes.NotFound,
+				"unknown subscription %s", req.SubscriptionId)
+		}
+	} else {
+		sub := req.Subscription
+		if sub == nil {
+			return status.Error(codes.InvalidArgument,
+				"subscription or subscription_id is required")
+		}
+
+		glog.V(1).Infof("GetEventsWithAck(%+v)", sub)
+
+		eventStream, err := t.sensor.NewSubscription(sub)
+		if err != nil {
+			glog.Errorf("Failed to get events for subscription %+v: %s",
+				sub, err.Error())
+			return err
+		}
+
+		as = newAckSubscription(eventStream)
+		t.acked.start(as)
+	}
+	as.acknowledge(req.Acks)
+
+	c := as.attach()
+	if c == nil {
+		return status.Errorf(codes.NotFound,
+			"subscription %s has ended", as.id)
+	}
+	defer as.detach(c)
+
+	err = stream.Send(&api.GetEventsResponse{
+		SubscriptionId: as.id,
+	})
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			as.acknowledge(req.Acks)
+		}
+	}()
+
+	for {
+		select {
+		case r, ok := <-c.out:
+			if !ok {
+				select {
+				case <-as.done:
+					// Every event in the subscription has
+					// been delivered and acknowledged.
+					glog.V(1).Infof("Acked subscription %s ended",
+						as.id)
+					return nil
+				default:
+					return status.Errorf(codes.Aborted,
+						"subscription %s resumed on another stream",
+						as.id)
+				}
+			}
+
+			err = stream.Send(r)
+			if err != nil {
+				glog.V(1).Infof("Failed to send event: %s", err)
+				return err
+			}
+
+		case <-stream.Context().Done():
+			glog.V(1).Infof("Client disconnected from acked subscription %s",
+				as.id)
+			return stream.Context().Err()
+		}
+	}
+}
+
+func (t *telemetryServiceServer) ValidateSubscription(
+	ctx context.Context,
+	req *api.ValidateSubscriptionRequest,
+) (*api.ValidateSubscriptionResponse, error) {
+	glog.V(1).Infof("ValidateSubscription(%+v)", req.Subscription)
+
+	return t.sensor.ValidateSubscription(req.Subscription), nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+
+	"github.com/golang/glog"
+)
+
+// Tracepoint names are used to construct paths in tracefs, so only simple
+// subsystem and event names are accepted.
+var validTracepointRegex = regexp.MustCompile(`^(\w+)[:/](\w+)$`)
+
+// parseTracepointName splits a tracepoint name given as either
+// "subsystem:name" or "subsystem/name" into its parts.
+func parseTracepointName(s string) (string, string, bool) {
+	m := validTracepointRegex.FindStringSubmatch(s)
+	if m == nil {
+		return "", "", false
+	}
+	return m[1], m[2], true
+}
+
+type tracepointFilter struct {
+	subsystem string
+	name      string
+	sensor    *Sensor
+
+	// Fixed-size char array fields, which are decoded as strings
+	strings map[string]bool
+}
+
+func newTracepointFilter(sensor *Sensor, subsystem, name string) *tracepointFilter {
+	f := &tracepointFilter{
+		subsystem: subsystem,
+		name:      name,
+		sensor:    sensor,
+		strings:   make(map[string]bool),
+	}
+
+	fields, err := perf.TraceEventFormat(sensor.tracingDir(),
+		f.tracepoint())
+	if err != nil {
+		glog.V(2).Infof("Couldn't read format for %s: %s",
+			f.tracepoint(), err)
+		return f
+	}
+	for _, field := range fields {
+		if field.TypeName == "char" {
+			f.strings[field.Name] = true
+		}
+	}
+
+	return f
+}
+
+func (f *tracepointFilter) tracepoint() string {
+	return f.subsystem + "/" + f.name
+}
+
+func (f *tracepointFilter) decodeTracepoint(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	fields := make(map[string]*api.KernelFunctionCallEvent_FieldValue)
+	for k, v := range data {
+		if a, ok := v.([]interface{}); ok && f.strings[k] {
+			if b, ok := byteArray(a); ok {
+				if i := bytes.IndexByte(b, 0); i >= 0 {
+					b = b[:i]
+				}
+				v = string(b)
+			}
+		}
+		fields[k] = newFieldValue(v)
+	}
+
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.TelemetryEvent_Tracepoint{
+		Tracepoint: &api.TracepointEvent{
+			Subsystem: f.subsystem,
+			Name:      f.name,
+			Fields:    fields,
+		},
+	}
+
+	return ev, nil
+}
+
+// parseTracepointEventFilter checks a tracepoint event filter.
+func parseTracepointEventFilter(sensor *Sensor, tef *api.TracepointEventFilter) (*parsedFilter, error) {
+	subsystem, name, ok := parseTracepointName(tef.Name)
+	if !ok {
+		return nil, fmt.Errorf("Invalid tracepoint name %q", tef.Name)
+	}
+
+	tracepoint := subsystem + "/" + name
+	types, err := sensor.tracepointFieldTypes(tracepoint)
+	if err != nil {
+		return nil, err
+	}
+	return newParsedFilter([]string{tracepoint}, nil, tef.FilterExpression,
+		true, types)
+}
+
+func registerTracepointEvents(sensor *Sensor, eventMap subscriptionMap, events []*api.TracepointEventFilter) {
+	filters := make(map[string]map[string]int)
+	tracepoints := make(map[string]*tracepointFilter)
+
+	for _, tef := range events {
+		pf, err := parseTracepointEventFilter(sensor, tef)
+		if err != nil {
+			glog.V(1).Infof("Invalid tracepoint event filter: %s", err)
+			continue
+		}
+
+		tracepoint := pf.tracepoints[0]
+		if filters[tracepoint] == nil {
+			subsystem, name, _ := parseTracepointName(tef.Name)
+			filters[tracepoint] = make(map[string]int)
+			tracepoints[tracepoint] = newTracepointFilter(sensor,
+				subsystem, name)
+		}
+		filters[tracepoint][pf.kernelFilterString()]++
+	}
+
+	for tracepoint, f := range tracepoints {
+		registerEvent(sensor.monitor, eventMap, tracepoint,
+			f.decodeTracepoint, filters[tracepoint])
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"bytes"
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+)
+
+func TestParseTracepointName(t *testing.T) {
+	valid := []string{"sched:sched_switch", "sched/sched_switch"}
+	for _, s := range valid {
+		subsystem, name, ok := parseTracepointName(s)
+		if !ok || subsystem != "sched" || name != "sched_switch" {
+			t.Errorf("Unexpected result for %q: %q %q %v",
+				s, subsystem, name, ok)
+		}
+	}
+
+	invalid := []string{"", "sched", "sched:", ":sched_switch",
+		"../sched:sched_switch", "sched:sched_switch/format",
+		"sched:sched switch"}
+	for _, s := range invalid {
+		if _, _, ok := parseTracepointName(s); ok {
+			t.Errorf("Expected %q to be invalid", s)
+		}
+	}
+}
+
+func TestDecodeTracepoint(t *testing.T) {
+	s := &Sensor{}
+	s.ProcessCache.cache = newArrayTaskCache(32)
+
+	f := &tracepointFilter{
+		subsystem: "sched",
+		name:      "sched_switch",
+		sensor:    s,
+		strings:   map[string]bool{"prev_comm": true},
+	}
+
+	comm := make([]interface{}, 16)
+	for i := range comm {
+		comm[i] = int8(0)
+	}
+	for i, c := range []byte("bash") {
+		comm[i] = int8(c)
+	}
+
+	i, err := f.decodeTracepoint(&perf.SampleRecord{}, perf.TraceEventSampleData{
+		"common_pid": int32(1),
+		"prev_comm":  comm,
+		"prev_pid":   int32(1),
+		"saddr":      []interface{}{uint8(127), uint8(0), uint8(0), uint8(1)},
+		"args":       []interface{}{uint64(1), uint64(2)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tp := i.(*api.TelemetryEvent).GetTracepoint()
+	if tp.Subsystem != "sched" || tp.Name != "sched_switch" {
+		t.Errorf("Unexpected tracepoint %s/%s", tp.Subsystem, tp.Name)
+	}
+	if v := tp.Fields["prev_comm"]; v.GetStringValue() != "bash" {
+		t.Errorf("Unexpected prev_comm %+v", v)
+	}
+	if v := tp.Fields["prev_pid"]; v.FieldType != api.KernelFunctionCallEvent_SINT32 ||
+		v.GetSignedValue() != 1 {
+		t.Errorf("Unexpected prev_pid %+v", v)
+	}
+	if v := tp.Fields["saddr"]; !bytes.Equal(v.GetBytesValue(), []byte{127, 0, 0, 1}) {
+		t.Errorf("Unexpected saddr %+v", v)
+	}
+	v := tp.Fields["args"]
+	if v.FieldType != api.KernelFunctionCallEvent_ARRAY {
+		t.Fatalf("Unexpected args %+v", v)
+	}
+	values := v.GetArrayValue().Values
+	if len(values) != 2 || values[0].GetUnsignedValue() != 1 ||
+		values[1].GetUnsignedValue() != 2 {
+		t.Errorf("Unexpected args %+v", values)
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/sys"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+
+	"github.com/golang/glog"
+)
+
+// Maximum number of symbolic links followed when resolving an executable
+// path within a container, matching the kernel's limit.
+const maxUprobeSymlinks = 40
+
+// User-space symbols are resolved by the sensor from the executable's ELF
+// symbol tables and are never passed to the kernel, so any name that does not
+// look like an address is accepted. This allows for C++ and Go symbol names.
+var validUserSymbolRegex = regexp.MustCompile(`^[A-Za-z_]\S*$`)
+
+// The executable path is passed to the kernel as part of the uprobe
+// definition, so it must be absolute and must not contain whitespace.
+var validExecutableRegex = regexp.MustCompile(`^/\S*$`)
+
+type uprobeFilter struct {
+	executable  string
+	symbol      string
+	offset      uint64
+	containerID string
+	onReturn    bool
+	arguments   map[string]string
+	filter      string
+	sensor      *Sensor
+}
+
+// parseUserEventFilter checks a user function call event filter.
+func parseUserEventFilter(uef *api.UserFunctionCallFilter) (*parsedFilter, error) {
+	if !validExecutableRegex.MatchString(uef.Executable) {
+		return nil, fmt.Errorf("Invalid uprobe executable %q", uef.Executable)
+	}
+	if len(uef.Symbol) > 0 {
+		if !validUserSymbolRegex.MatchString(uef.Symbol) {
+			return nil, fmt.Errorf("Invalid uprobe symbol %q", uef.Symbol)
+		}
+	} else if uef.Offset == 0 {
+		return nil, errors.New("Either a uprobe symbol or offset is required")
+	}
+
+	switch uef.Type {
+	case api.UserFunctionCallEventType_USER_FUNCTION_CALL_EVENT_TYPE_ENTER,
+		api.UserFunctionCallEventType_USER_FUNCTION_CALL_EVENT_TYPE_EXIT:
+	default:
+		return nil, fmt.Errorf("Invalid user function call event type %s",
+			uef.Type)
+	}
+
+	f := uprobeFilter{arguments: uef.Arguments}
+	return newParsedFilter(nil, nil, uef.FilterExpression, true,
+		kprobeFieldTypes(f.fetchargs()))
+}
+
+func newUprobeFilter(uef *api.UserFunctionCallFilter) *uprobeFilter {
+	pf, err := parseUserEventFilter(uef)
+	if err != nil {
+		glog.V(1).Infof("Invalid user function call filter: %s", err)
+		return nil
+	}
+
+	return &uprobeFilter{
+		executable:  uef.Executable,
+		symbol:      uef.Symbol,
+		offset:      uef.Offset,
+		containerID: uef.ContainerId,
+		onReturn:    uef.Type == api.UserFunctionCallEventType_USER_FUNCTION_CALL_EVENT_TYPE_EXIT,
+		arguments:   uef.Arguments,
+		filter:      pf.kernelFilterString(),
+	}
+}
+
+// address returns the location of the probed function in the form expected
+// by EventMonitor.RegisterUprobe.
+func (f *uprobeFilter) address() string {
+	if len(f.symbol) > 0 {
+		return f.symbol
+	}
+	return fmt.Sprintf("%#x", f.offset)
+}
+
+func (f *uprobeFilter) fetchargs() string {
+	args := make([]string, 0, len(f.arguments))
+	for k, v := range f.arguments {
+		args = append(args, fmt.Sprintf("%s=%s", k, v))
+	}
+
+	return strings.Join(args, " ")
+}
+
+func (f *uprobeFilter) decodeUprobe(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	args := make(map[string]*api.KernelFunctionCallEvent_FieldValue)
+	for k, v := range data {
+		args[k] = newFieldValue(v)
+	}
+
+	var eventType api.UserFunctionCallEventType
+	if f.onReturn {
+		eventType = api.UserFunctionCallEventType_USER_FUNCTION_CALL_EVENT_TYPE_EXIT
+	} else {
+		eventType = api.UserFunctionCallEventType_USER_FUNCTION_CALL_EVENT_TYPE_ENTER
+	}
+
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.TelemetryEvent_UserCall{
+		UserCall: &api.UserFunctionCallEvent{
+			Type:        eventType,
+			Executable:  f.executable,
+			Symbol:      f.symbol,
+			Offset:      f.offset,
+			ContainerId: f.containerID,
+			Arguments:   args,
+		},
+	}
+
+	return ev, nil
+}
+
+// containerPid returns the PID of a task running in a container, or 0 if
+// there is none known to the sensor.
+func (s *Sensor) containerPid(containerID string) int {
+	if info := s.ContainerCache.LookupContainer(containerID, false); info != nil && info.Pid != 0 {
+		return info.Pid
+	}
+
+	var pid int
+	s.ProcessCache.ForEachTask(func(t *Task) {
+		if pid == 0 && t.ContainerID == containerID {
+			pid = t.PID
+		}
+	})
+	return pid
+}
+
+// resolveInRoot resolves an absolute path within the directory root as if
+// root were the root directory, following symbolic links without escaping
+// root. The returned path is the host path of the resolved file.
+func resolveInRoot(root, path string) (string, error) {
+	resolved := "/"
+	remaining := strings.Split(path, "/")
+	links := 0
+
+	for len(remaining) > 0 {
+		name := remaining[0]
+		remaining = remaining[1:]
+
+		switch name {
+		case "", ".":
+			continue
+		case "..":
+			resolved = filepath.Dir(resolved)
+			continue
+		}
+
+		next := filepath.Join(resolved, name)
+		fi, err := os.Lstat(filepath.Join(root, next))
+		if err != nil {
+			return "", err
+		}
+		if fi.Mode()&os.ModeSymlink == 0 {
+			resolved = next
+			continue
+		}
+
+		links++
+		if links > maxUprobeSymlinks {
+			return "", fmt.Errorf("Too many symbolic links in %s", path)
+		}
+		target, err := os.Readlink(filepath.Join(root, next))
+		if err != nil {
+			return "", err
+		}
+		if filepath.IsAbs(target) {
+			resolved = "/"
+		}
+		remaining = append(strings.Split(target, "/"), remaining...)
+	}
+
+	return filepath.Join(root, resolved), nil
+}
+
+// executablePath returns the host path of the probed executable. If the
+// filter names a container, the path is resolved within the root filesystem
+// of a task running in that container.
+func (f *uprobeFilter) executablePath() (string, error) {
+	if len(f.containerID) == 0 {
+		return f.executable, nil
+	}
+
+	pid := f.sensor.containerPid(f.containerID)
+	if pid == 0 {
+		return "", fmt.Errorf("No running tasks found for container %s",
+			f.containerID)
+	}
+
+	root := filepath.Join(sys.HostProcFS().MountPoint,
+		fmt.Sprintf("%d/root", pid))
+	return resolveInRoot(root, f.executable)
+}
+
+func registerUserEvents(sensor *Sensor, eventMap subscriptionMap, events []*api.UserFunctionCallFilter) {
+	for _, uef := range events {
+		f := newUprobeFilter(uef)
+		if f == nil {
+			continue
+		}
+
+		var loc string
+		if f.onReturn {
+			loc = "return"
+		} else {
+			loc = "entry"
+		}
+
+		f.sensor = sensor
+		path, err := f.executablePath()
+		if err != nil {
+			glog.V(1).Infof("Couldn't resolve uprobe executable %s: %v",
+				f.executable, err)
+			continue
+		}
+
+		eventID, err := sensor.monitor.RegisterUprobe(
+			path, f.address(), f.onReturn, f.fetchargs(),
+			f.decodeUprobe,
+			perf.WithFilter(f.filter))
+		if err != nil {
+			glog.V(1).Infof("Couldn't register uprobe on %s %s %s [%s]: %v",
+				path, f.address(), loc, f.fetchargs(), err)
+			continue
+		}
+		eventMap.subscribe(eventID)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+)
+
+func TestNewUprobeFilter(t *testing.T) {
+	enter := api.UserFunctionCallEventType_USER_FUNCTION_CALL_EVENT_TYPE_ENTER
+
+	f := newUprobeFilter(&api.UserFunctionCallFilter{
+		Type:       enter,
+		Executable: "/usr/bin/server",
+		Symbol:     "main.(*Server).handle",
+	})
+	if f == nil {
+		t.Fatal("Expected filter for Go symbol")
+	}
+	if a := f.address(); a != "main.(*Server).handle" {
+		t.Errorf("Unexpected address %q", a)
+	}
+
+	f = newUprobeFilter(&api.UserFunctionCallFilter{
+		Type:       api.UserFunctionCallEventType_USER_FUNCTION_CALL_EVENT_TYPE_EXIT,
+		Executable: "/lib/libc.so.6",
+		Offset:     0x4f440,
+	})
+	if f == nil {
+		t.Fatal("Expected filter for offset")
+	}
+	if a := f.address(); a != "0x4f440" {
+		t.Errorf("Unexpected address %q", a)
+	}
+	if !f.onReturn {
+		t.Error("Expected return probe")
+	}
+
+	invalid := []*api.UserFunctionCallFilter{
+		&api.UserFunctionCallFilter{
+			Type:       enter,
+			Executable: "usr/bin/server",
+			Symbol:     "main",
+		},
+		&api.UserFunctionCallFilter{
+			Type:       enter,
+			Executable: "/usr/bin/server extra",
+			Symbol:     "main",
+		},
+		&api.UserFunctionCallFilter{
+			Type:       enter,
+			Executable: "/usr/bin/server",
+		},
+		&api.UserFunctionCallFilter{
+			Type:       enter,
+			Executable: "/usr/bin/server",
+			Symbol:     "0x1234",
+		},
+		&api.UserFunctionCallFilter{
+			Executable: "/usr/bin/server",
+			Symbol:     "main",
+		},
+	}
+	for _, uef := range invalid {
+		if newUprobeFilter(uef) != nil {
+			t.Errorf("Expected invalid filter for %+v", uef)
+		}
+	}
+}
+
+func TestResolveInRoot(t *testing.T) {
+	root, err := ioutil.TempDir("", "uprobe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	if err = os.MkdirAll(filepath.Join(root, "lib/x86_64"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	libc := filepath.Join(root, "lib/x86_64/libc-2.27.so")
+	if err = ioutil.WriteFile(libc, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// An absolute link must be resolved within root rather than on the
+	// host, and relative links must not be able to escape root.
+	links := map[string]string{
+		"lib/libc.so.6":         "/lib/x86_64/libc-2.27.so",
+		"lib/x86_64/libc.so":    "../../../../lib/x86_64/libc-2.27.so",
+		"lib/x86_64/loop":       "loop",
+		"lib/x86_64/missing.so": "/nonexistent",
+	}
+	for name, target := range links {
+		if err = os.Symlink(target, filepath.Join(root, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, path := range []string{"/lib/libc.so.6", "/lib/x86_64/libc.so", "/lib/../lib/libc.so.6"} {
+		resolved, err := resolveInRoot(root, path)
+		if err != nil {
+			t.Errorf("Couldn't resolve %s: %s", path, err)
+		} else if resolved != libc {
+			t.Errorf("Expected %s to resolve to %s, got %s",
+				path, libc, resolved)
+		}
+	}
+
+	for _, path := range []string{"/lib/x86_64/loop", "/lib/x86_64/missing.so"} {
+		if resolved, err := resolveInRoot(root, path); err == nil {
+			t.Errorf("Expected error resolving %s, got %s",
+				path, resolved)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	api "github.com/capsule8/capsule8/api/v0"
+)
+
+// subscriptionValidator checks the filters in a subscription without
+// registering any events. Each filter is checked by the same parse function
+// that is used when the subscription is created.
+type subscriptionValidator struct {
+	response *api.ValidateSubscriptionResponse
+}
+
+func (v *subscriptionValidator) add(filterType string, index int, pf *parsedFilter, err error) {
+	fv := &api.FilterValidation{
+		FilterType: filterType,
+		Index:      uint32(index),
+	}
+	v.response.Filters = append(v.response.Filters, fv)
+
+	if err != nil {
+		fv.Error = err.Error()
+		v.response.Valid = false
+		return
+	}
+
+	fv.Tracepoints = pf.tracepoints
+	fv.Kprobes = pf.kprobes
+	fv.KernelFilter = pf.kernelFilterString()
+}
+
+// ValidateSubscription checks each of the filters in a subscription without
+// creating the subscription. The filters may be rewritten in the same way
+// that they are when the subscription is created.
+func (s *Sensor) ValidateSubscription(sub *api.Subscription) *api.ValidateSubscriptionResponse {
+	v := subscriptionValidator{
+		response: &api.ValidateSubscriptionResponse{
+			Valid: true,
+		},
+	}
+
+	if sub == nil || sub.EventFilter == nil {
+		v.response.Valid = false
+		return v.response
+	}
+
+	ef := sub.EventFilter
+	for i, sef := range ef.SyscallEvents {
+		// Translate deprecated fields into an expression
+		rewriteSyscallEventFilter(sef)
+		pf, err := parseSyscallEventFilter(s, sef)
+		v.add("syscall_events", i, pf, err)
+	}
+	for i, pef := range ef.ProcessEvents {
+		// Translate deprecated fields into an expression
+		rewriteProcessEventFilter(pef)
+		pf, err := parseProcessEventFilter(s, pef)
+		v.add("process_events", i, pf, err)
+	}
+	for i, fef := range ef.FileEvents {
+		// Translate deprecated fields into an expression
+		rewriteFileEventFilter(fef)
+		pf, err := parseFileEventFilter(fef)
+		v.add("file_events", i, pf, err)
+	}
+	for i, kef := range ef.KernelEvents {
+		pf, err := parseKernelEventFilter(kef)
+		v.add("kernel_events", i, pf, err)
+	}
+	for i, uef := range ef.UserEvents {
+		pf, err := parseUserEventFilter(uef)
+		v.add("user_events", i, pf, err)
+	}
+	for i, nef := range ef.NetworkEvents {
+		pf, err := parseNetworkEventFilter(s, nef)
+		v.add("network_events", i, pf, err)
+	}
+	for i, sef := range ef.SignalEvents {
+		pf, err := parseSignalEventFilter(s, sef)
+		v.add("signal_events", i, pf, err)
+	}
+	for i, nef := range ef.NamespaceEvents {
+		pf, err := parseNamespaceEventFilter(nef)
+		v.add("namespace_events", i, pf, err)
+	}
+	for i, mef := range ef.MountEvents {
+		pf, err := parseMountEventFilter(mef)
+		v.add("mount_events", i, pf, err)
+	}
+	for i, kef := range ef.KernelModuleEvents {
+		pf, err := parseKernelModuleEventFilter(s, kef)
+		v.add("kernel_module_events", i, pf, err)
+	}
+	for i, pef := range ef.ProcessAccessEvents {
+		pf, err := parseProcessAccessEventFilter(pef)
+		v.add("process_access_events", i, pf, err)
+	}
+	for i, tef := range ef.TracepointEvents {
+		pf, err := parseTracepointEventFilter(s, tef)
+		v.add("tracepoint_events", i, pf, err)
+	}
+	for i, cef := range ef.ContainerEvents {
+		pf, err := parseContainerEventFilter(cef)
+		v.add("container_events", i, pf, err)
+	}
+	for i, mef := range ef.ContainerMetricsEvents {
+		pf, err := parseContainerMetricsEventFilter(mef)
+		v.add("container_metrics_events", i, pf, err)
+	}
+
+	return v.response
+}
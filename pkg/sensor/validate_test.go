@@ -0,0 +1,179 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"os"
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+)
+
+func TestKprobeFieldTypes(t *testing.T) {
+	types := kprobeFieldTypes("fd=%di  name=+0(%si):string code=%dx:s32 x=%cx:bad")
+
+	expected := map[string]int32{
+		"fd":   perf.TraceEventFieldTypeUnsignedInt64,
+		"name": perf.TraceEventFieldTypeString,
+		"code": perf.TraceEventFieldTypeSignedInt32,
+	}
+	for k, v := range expected {
+		if types[k] != v {
+			t.Errorf("Expected type %d for %s, got %d", v, k, types[k])
+		}
+	}
+	if _, ok := types["x"]; ok {
+		t.Error("Unexpected type for x")
+	}
+	if _, ok := types["common_pid"]; !ok {
+		t.Error("Missing type for common_pid")
+	}
+}
+
+func TestValidateSubscription(t *testing.T) {
+	s := &Sensor{}
+
+	r := s.ValidateSubscription(&api.Subscription{
+		EventFilter: &api.EventFilter{
+			FileEvents: []*api.FileEventFilter{
+				&api.FileEventFilter{
+					Type: api.FileEventType_FILE_EVENT_TYPE_OPEN,
+					FilterExpression: expression.Equal(
+						expression.Identifier("filename"),
+						expression.Value("/etc/passwd")),
+				},
+			},
+			KernelEvents: []*api.KernelFunctionCallFilter{
+				&api.KernelFunctionCallFilter{
+					Type:   api.KernelFunctionCallEventType_KERNEL_FUNCTION_CALL_EVENT_TYPE_ENTER,
+					Symbol: "sys_open+4",
+				},
+			},
+			ContainerEvents: []*api.ContainerEventFilter{
+				&api.ContainerEventFilter{
+					Type: api.ContainerEventType_CONTAINER_EVENT_TYPE_CREATED,
+					FilterExpression: expression.Equal(
+						expression.Identifier("no_such_field"),
+						expression.Value("foo")),
+				},
+			},
+		},
+	})
+
+	if r.Valid {
+		t.Error("Expected invalid subscription")
+	}
+	if len(r.Filters) != 3 {
+		t.Fatalf("Expected 3 filter results, got %d", len(r.Filters))
+	}
+
+	f := r.Filters[0]
+	if f.FilterType != "file_events" || len(f.Error) > 0 {
+		t.Errorf("Unexpected result for file filter: %+v", f)
+	}
+	if f.KernelFilter != `filename == "/etc/passwd"` {
+		t.Errorf("Unexpected kernel filter %q", f.KernelFilter)
+	}
+	if len(f.Kprobes) != 1 || f.Kprobes[0] != fsDoSysOpenKprobeAddress {
+		t.Errorf("Unexpected kprobes %v", f.Kprobes)
+	}
+
+	for _, f = range r.Filters[1:] {
+		if len(f.Error) == 0 {
+			t.Errorf("Expected error for %s filter", f.FilterType)
+		}
+		if len(f.Kprobes) > 0 || len(f.Tracepoints) > 0 {
+			t.Errorf("Unexpected events for %s filter", f.FilterType)
+		}
+	}
+}
+
+func TestValidateMissingFormat(t *testing.T) {
+	dir := newTestTracingDir(t, nil)
+	defer os.RemoveAll(dir)
+
+	s := &Sensor{traceFSMountPoint: dir}
+	r := s.ValidateSubscription(&api.Subscription{
+		EventFilter: &api.EventFilter{
+			TracepointEvents: []*api.TracepointEventFilter{
+				&api.TracepointEventFilter{
+					Name: "sched/sched_switch",
+				},
+			},
+		},
+	})
+
+	if r.Valid {
+		t.Error("Expected invalid subscription")
+	}
+	if len(r.Filters) != 1 || len(r.Filters[0].Error) == 0 {
+		t.Errorf("Expected error for tracepoint filter: %+v", r.Filters)
+	}
+}
+
+func TestValidateKernelModuleEvents(t *testing.T) {
+	s := &Sensor{}
+
+	r := s.ValidateSubscription(&api.Subscription{
+		EventFilter: &api.EventFilter{
+			KernelModuleEvents: []*api.KernelModuleEventFilter{
+				&api.KernelModuleEventFilter{
+					Type: api.KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_LOAD_ATTEMPT,
+					FilterExpression: expression.Equal(
+						expression.Identifier("params"),
+						expression.Value("debug=1")),
+				},
+				// fd is only available from finit_module
+				&api.KernelModuleEventFilter{
+					Type: api.KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_LOAD_ATTEMPT,
+					FilterExpression: expression.Equal(
+						expression.Identifier("fd"),
+						expression.Value(int32(3))),
+				},
+				&api.KernelModuleEventFilter{
+					Type: api.KernelModuleEventType_KERNEL_MODULE_EVENT_TYPE_UNLOAD_ATTEMPT,
+					FilterExpression: expression.Equal(
+						expression.Identifier("name"),
+						expression.Value("nf_tables")),
+				},
+			},
+		},
+	})
+
+	if r.Valid {
+		t.Error("Expected invalid subscription")
+	}
+	if len(r.Filters) != 3 {
+		t.Fatalf("Expected 3 filter results, got %d", len(r.Filters))
+	}
+
+	f := r.Filters[0]
+	if len(f.Error) > 0 || len(f.Kprobes) != 2 {
+		t.Errorf("Unexpected result for load attempt filter: %+v", f)
+	}
+	if f = r.Filters[1]; len(f.Error) == 0 {
+		t.Error("Expected error for load attempt filter on fd")
+	}
+	f = r.Filters[2]
+	if len(f.Error) > 0 || f.KernelFilter != `name == "nf_tables"` {
+		t.Errorf("Unexpected result for unload attempt filter: %+v", f)
+	}
+	if len(f.Kprobes) != 1 || f.Kprobes[0] != deleteModuleKprobeSymbol {
+		t.Errorf("Unexpected kprobes %v", f.Kprobes)
+	}
+}
@@ -684,6 +684,37 @@ func Limit(in *Stream, mod api.LimitModifier) *Stream {
 	}
 }
 
+// Duration closes the stream once the given duration has elapsed. Elements
+// already in flight continue to be delivered until the data channel closes.
+func Duration(in *Stream, d time.Duration) *Stream {
+	ctrl := make(chan interface{})
+
+	go func() {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-timer.C:
+				in.Close()
+				return
+
+			case m, ok := <-ctrl:
+				if !ok {
+					in.Close()
+					return
+				}
+				in.Ctrl <- m
+			}
+		}
+	}()
+
+	return &Stream{
+		Ctrl: ctrl,
+		Data: in.Data,
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Terminators accept an input stream and return a terminal value. They are
 // typically used to aggregate a value over the entire stream.
@@ -14,7 +14,10 @@
 
 package stream
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestNext(t *testing.T) {
 	s := Iota(10)
@@ -123,3 +126,31 @@ func TestIota3(t *testing.T) {
 		t.Errorf("Expected total = %d, got %d\n", expected, total)
 	}
 }
+
+func TestDuration(t *testing.T) {
+	s := Duration(Null(), 10*time.Millisecond)
+	defer s.Close()
+
+	select {
+	case e, ok := <-s.Data:
+		if ok {
+			t.Errorf("Expected stream closed, got element: %v", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stream did not close after duration elapsed")
+	}
+}
+
+func TestDurationClose(t *testing.T) {
+	s := Duration(Null(), time.Hour)
+	s.Close()
+
+	select {
+	case e, ok := <-s.Data:
+		if ok {
+			t.Errorf("Expected stream closed, got element: %v", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stream did not close")
+	}
+}
@@ -0,0 +1,364 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sys
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/capsule8/capsule8/pkg/sys/proc"
+)
+
+// cgroup v1 reports unlimited values as the largest page-aligned int64
+const cgroupV1Unlimited = 1 << 62
+
+// The cgroup v1 controllers that CgroupStats are read from
+var cgroupV1Controllers = []string{"cpu", "cpuacct", "memory", "pids", "blkio"}
+
+// PressureStallAverages are the pressure stall information averages for
+// some or all tasks in a cgroup, as reported by cgroup v2.
+type PressureStallAverages struct {
+	// The percentages of time that tasks were stalled over the last
+	// 10, 60, and 300 seconds
+	Avg10  float64
+	Avg60  float64
+	Avg300 float64
+
+	// The total time that tasks were stalled in microseconds
+	Total uint64
+}
+
+// PressureStall is the pressure stall information for a resource in a
+// cgroup. Some is for time that at least one task was stalled and Full is
+// for time that all tasks were stalled.
+type PressureStall struct {
+	Some PressureStallAverages
+	Full PressureStallAverages
+}
+
+// CgroupStats is a snapshot of the resource usage and limits of a cgroup.
+// Limits that are not set are zero.
+type CgroupStats struct {
+	// Version is the cgroup version (1 or 2) that the stats were read
+	// from.
+	Version int
+
+	// CPU times in nanoseconds
+	CPUUsage  uint64
+	CPUUser   uint64
+	CPUSystem uint64
+
+	// CPU bandwidth throttling
+	CPUPeriods          uint64
+	CPUThrottledPeriods uint64
+	CPUThrottledTime    uint64
+
+	// Memory usage and limit in bytes and the number of OOM kills
+	MemoryUsage    uint64
+	MemoryLimit    uint64
+	MemoryOOMKills uint64
+
+	// Number of tasks and the limit on the number of tasks
+	PidsCurrent uint64
+	PidsMax     uint64
+
+	// Block I/O summed over all devices
+	IOReadBytes  uint64
+	IOWriteBytes uint64
+	IOReadOps    uint64
+	IOWriteOps   uint64
+
+	// Pressure stall information, only available with cgroup v2
+	CPUPressure    *PressureStall
+	MemoryPressure *PressureStall
+	IOPressure     *PressureStall
+}
+
+// ReadCgroupStats reads the resource usage of the cgroups that a process
+// belongs to, as returned by proc.FileSystem.Cgroups. The stats are read
+// from cgroup v1 controllers if any are mounted and from the unified
+// cgroup v2 hierarchy otherwise.
+func ReadCgroupStats(cgroups []proc.Cgroup) (*CgroupStats, error) {
+	v1Dirs := make(map[string]string)
+	var v2Dir string
+
+	for _, m := range Mounts() {
+		switch m.FilesystemType {
+		case "cgroup":
+			for _, cg := range cgroups {
+				for _, c := range cg.Controllers {
+					if _, ok := m.SuperOptions[c]; !ok {
+						continue
+					}
+					if dir, ok := cgroupDir(m, cg.Path); ok {
+						v1Dirs[c] = dir
+					}
+				}
+			}
+		case "cgroup2":
+			for _, cg := range cgroups {
+				if cg.ID != 0 {
+					continue
+				}
+				if dir, ok := cgroupDir(m, cg.Path); ok {
+					v2Dir = dir
+				}
+			}
+		}
+	}
+
+	for _, c := range cgroupV1Controllers {
+		if _, ok := v1Dirs[c]; ok {
+			return readCgroupV1Stats(v1Dirs), nil
+		}
+	}
+	if len(v2Dir) > 0 {
+		return readCgroupV2Stats(v2Dir), nil
+	}
+	return nil, errors.New("No cgroup controllers found")
+}
+
+// cgroupDir returns the directory of the cgroup with the given path in a
+// mounted cgroup filesystem. The mount may be of a sub-tree of the
+// hierarchy, in which case the cgroup must be within it.
+func cgroupDir(m Mount, path string) (string, bool) {
+	if m.Root != "/" {
+		if path != m.Root && !strings.HasPrefix(path, m.Root+"/") {
+			return "", false
+		}
+		path = strings.TrimPrefix(path, m.Root)
+	}
+	return filepath.Join(m.MountPoint, path), true
+}
+
+// readCgroupValue reads a file containing a single integer. The value
+// "max" is read as zero.
+func readCgroupValue(path string) uint64 {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0
+	}
+	x, _ := strconv.ParseUint(strings.TrimSpace(string(b)), 10, 64)
+	return x
+}
+
+// readCgroupKeyValues reads a file of "key value" lines, such as
+// memory.stat or cpu.stat.
+func readCgroupKeyValues(path string) map[string]uint64 {
+	values := make(map[string]uint64)
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return values
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		f := strings.Fields(scanner.Text())
+		if len(f) != 2 {
+			continue
+		}
+		if x, err := strconv.ParseUint(f[1], 10, 64); err == nil {
+			values[f[0]] = x
+		}
+	}
+	return values
+}
+
+// readCgroupLines reads a file and splits it into fields by line.
+func readCgroupLines(path string) [][]string {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	var lines [][]string
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		lines = append(lines, strings.Fields(scanner.Text()))
+	}
+	return lines
+}
+
+func cgroupV1Limit(x uint64) uint64 {
+	if x >= cgroupV1Unlimited {
+		return 0
+	}
+	return x
+}
+
+// readBlkioTotals sums the Read and Write lines of a blkio.throttle file
+// over all devices.
+func readBlkioTotals(path string) (read, write uint64) {
+	for _, f := range readCgroupLines(path) {
+		if len(f) != 3 {
+			continue
+		}
+		x, err := strconv.ParseUint(f[2], 10, 64)
+		if err != nil {
+			continue
+		}
+		switch f[1] {
+		case "Read":
+			read += x
+		case "Write":
+			write += x
+		}
+	}
+	return
+}
+
+func readCgroupV1Stats(dirs map[string]string) *CgroupStats {
+	stats := &CgroupStats{Version: 1}
+
+	if dir, ok := dirs["cpuacct"]; ok {
+		stats.CPUUsage = readCgroupValue(filepath.Join(dir, "cpuacct.usage"))
+
+		// cpuacct.stat is reported in USER_HZ (100Hz) clock ticks
+		cpu := readCgroupKeyValues(filepath.Join(dir, "cpuacct.stat"))
+		stats.CPUUser = cpu["user"] * 10000000
+		stats.CPUSystem = cpu["system"] * 10000000
+	}
+	if dir, ok := dirs["cpu"]; ok {
+		cpu := readCgroupKeyValues(filepath.Join(dir, "cpu.stat"))
+		stats.CPUPeriods = cpu["nr_periods"]
+		stats.CPUThrottledPeriods = cpu["nr_throttled"]
+		stats.CPUThrottledTime = cpu["throttled_time"]
+	}
+	if dir, ok := dirs["memory"]; ok {
+		stats.MemoryUsage = readCgroupValue(
+			filepath.Join(dir, "memory.usage_in_bytes"))
+		stats.MemoryLimit = cgroupV1Limit(readCgroupValue(
+			filepath.Join(dir, "memory.limit_in_bytes")))
+		oom := readCgroupKeyValues(filepath.Join(dir, "memory.oom_control"))
+		stats.MemoryOOMKills = oom["oom_kill"]
+	}
+	if dir, ok := dirs["pids"]; ok {
+		stats.PidsCurrent = readCgroupValue(filepath.Join(dir, "pids.current"))
+		stats.PidsMax = readCgroupValue(filepath.Join(dir, "pids.max"))
+	}
+	if dir, ok := dirs["blkio"]; ok {
+		stats.IOReadBytes, stats.IOWriteBytes = readBlkioTotals(
+			filepath.Join(dir, "blkio.throttle.io_service_bytes"))
+		stats.IOReadOps, stats.IOWriteOps = readBlkioTotals(
+			filepath.Join(dir, "blkio.throttle.io_serviced"))
+	}
+
+	return stats
+}
+
+// readPressureStall reads a cgroup v2 pressure file, which looks like:
+//
+//	some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+func readPressureStall(path string) *PressureStall {
+	lines := readCgroupLines(path)
+	if lines == nil {
+		return nil
+	}
+
+	ps := &PressureStall{}
+	for _, f := range lines {
+		if len(f) == 0 {
+			continue
+		}
+
+		var avgs *PressureStallAverages
+		switch f[0] {
+		case "some":
+			avgs = &ps.Some
+		case "full":
+			avgs = &ps.Full
+		default:
+			continue
+		}
+
+		for _, kv := range f[1:] {
+			parts := strings.SplitN(kv, "=", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			switch parts[0] {
+			case "avg10":
+				avgs.Avg10, _ = strconv.ParseFloat(parts[1], 64)
+			case "avg60":
+				avgs.Avg60, _ = strconv.ParseFloat(parts[1], 64)
+			case "avg300":
+				avgs.Avg300, _ = strconv.ParseFloat(parts[1], 64)
+			case "total":
+				avgs.Total, _ = strconv.ParseUint(parts[1], 10, 64)
+			}
+		}
+	}
+	return ps
+}
+
+func readCgroupV2Stats(dir string) *CgroupStats {
+	stats := &CgroupStats{Version: 2}
+
+	// cpu.stat is reported in microseconds
+	cpu := readCgroupKeyValues(filepath.Join(dir, "cpu.stat"))
+	stats.CPUUsage = cpu["usage_usec"] * 1000
+	stats.CPUUser = cpu["user_usec"] * 1000
+	stats.CPUSystem = cpu["system_usec"] * 1000
+	stats.CPUPeriods = cpu["nr_periods"]
+	stats.CPUThrottledPeriods = cpu["nr_throttled"]
+	stats.CPUThrottledTime = cpu["throttled_usec"] * 1000
+
+	stats.MemoryUsage = readCgroupValue(filepath.Join(dir, "memory.current"))
+	stats.MemoryLimit = readCgroupValue(filepath.Join(dir, "memory.max"))
+	events := readCgroupKeyValues(filepath.Join(dir, "memory.events"))
+	stats.MemoryOOMKills = events["oom_kill"]
+
+	stats.PidsCurrent = readCgroupValue(filepath.Join(dir, "pids.current"))
+	stats.PidsMax = readCgroupValue(filepath.Join(dir, "pids.max"))
+
+	// io.stat has a line per device like:
+	// 8:0 rbytes=90430464 wbytes=299008000 rios=8950 wios=12252 dbytes=0 dios=0
+	for _, f := range readCgroupLines(filepath.Join(dir, "io.stat")) {
+		for _, kv := range f {
+			parts := strings.SplitN(kv, "=", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			x, err := strconv.ParseUint(parts[1], 10, 64)
+			if err != nil {
+				continue
+			}
+			switch parts[0] {
+			case "rbytes":
+				stats.IOReadBytes += x
+			case "wbytes":
+				stats.IOWriteBytes += x
+			case "rios":
+				stats.IOReadOps += x
+			case "wios":
+				stats.IOWriteOps += x
+			}
+		}
+	}
+
+	stats.CPUPressure = readPressureStall(filepath.Join(dir, "cpu.pressure"))
+	stats.MemoryPressure = readPressureStall(
+		filepath.Join(dir, "memory.pressure"))
+	stats.IOPressure = readPressureStall(filepath.Join(dir, "io.pressure"))
+
+	return stats
+}
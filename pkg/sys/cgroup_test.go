@@ -0,0 +1,148 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sys
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeCgroupFiles(t *testing.T, dir string, files map[string]string) {
+	for name, contents := range files {
+		err := ioutil.WriteFile(filepath.Join(dir, name), []byte(contents), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestCgroupDir(t *testing.T) {
+	m := Mount{MountPoint: "/sys/fs/cgroup/memory", Root: "/"}
+	if d, ok := cgroupDir(m, "/docker/abc"); !ok || d != "/sys/fs/cgroup/memory/docker/abc" {
+		t.Errorf("Unexpected cgroup dir %s", d)
+	}
+
+	m.Root = "/docker"
+	if d, ok := cgroupDir(m, "/docker/abc"); !ok || d != "/sys/fs/cgroup/memory/abc" {
+		t.Errorf("Unexpected cgroup dir %s", d)
+	}
+	if _, ok := cgroupDir(m, "/dockerd"); ok {
+		t.Error("Unexpected cgroup dir outside of mount root")
+	}
+}
+
+func TestReadCgroupV1Stats(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cgroup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeCgroupFiles(t, dir, map[string]string{
+		"cpuacct.usage":         "123456789\n",
+		"cpuacct.stat":          "user 10\nsystem 2\n",
+		"cpu.stat":              "nr_periods 100\nnr_throttled 5\nthrottled_time 2000\n",
+		"memory.usage_in_bytes": "4096\n",
+		"memory.limit_in_bytes": "9223372036854771712\n",
+		"memory.oom_control":    "oom_kill_disable 0\nunder_oom 0\noom_kill 3\n",
+		"pids.current":          "7\n",
+		"pids.max":              "max\n",
+		"blkio.throttle.io_service_bytes": "8:0 Read 100\n8:0 Write 200\n" +
+			"8:0 Total 300\n8:16 Read 1\n8:16 Write 2\nTotal 303\n",
+		"blkio.throttle.io_serviced": "8:0 Read 3\n8:0 Write 4\n8:0 Total 7\n",
+	})
+
+	stats := readCgroupV1Stats(map[string]string{
+		"cpu":     dir,
+		"cpuacct": dir,
+		"memory":  dir,
+		"pids":    dir,
+		"blkio":   dir,
+	})
+	expected := CgroupStats{
+		Version:             1,
+		CPUUsage:            123456789,
+		CPUUser:             100000000,
+		CPUSystem:           20000000,
+		CPUPeriods:          100,
+		CPUThrottledPeriods: 5,
+		CPUThrottledTime:    2000,
+		MemoryUsage:         4096,
+		MemoryOOMKills:      3,
+		PidsCurrent:         7,
+		IOReadBytes:         101,
+		IOWriteBytes:        202,
+		IOReadOps:           3,
+		IOWriteOps:          4,
+	}
+	if *stats != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *stats)
+	}
+}
+
+func TestReadCgroupV2Stats(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cgroup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeCgroupFiles(t, dir, map[string]string{
+		"cpu.stat": "usage_usec 2000\nuser_usec 1500\nsystem_usec 500\n" +
+			"nr_periods 10\nnr_throttled 1\nthrottled_usec 30\n",
+		"memory.current": "8192\n",
+		"memory.max":     "1048576\n",
+		"memory.events":  "low 0\nhigh 0\nmax 2\noom 1\noom_kill 1\n",
+		"pids.current":   "3\n",
+		"pids.max":       "100\n",
+		"io.stat": "8:0 rbytes=10 wbytes=20 rios=1 wios=2 dbytes=0 dios=0\n" +
+			"8:16 rbytes=5 wbytes=5 rios=1 wios=1 dbytes=0 dios=0\n",
+		"memory.pressure": "some avg10=1.50 avg60=0.25 avg300=0.00 total=1234\n" +
+			"full avg10=0.50 avg60=0.00 avg300=0.00 total=567\n",
+	})
+
+	stats := readCgroupV2Stats(dir)
+	if stats.Version != 2 || stats.CPUUsage != 2000000 ||
+		stats.CPUUser != 1500000 || stats.CPUSystem != 500000 ||
+		stats.CPUPeriods != 10 || stats.CPUThrottledPeriods != 1 ||
+		stats.CPUThrottledTime != 30000 {
+		t.Errorf("Unexpected CPU stats %+v", stats)
+	}
+	if stats.MemoryUsage != 8192 || stats.MemoryLimit != 1048576 ||
+		stats.MemoryOOMKills != 1 {
+		t.Errorf("Unexpected memory stats %+v", stats)
+	}
+	if stats.PidsCurrent != 3 || stats.PidsMax != 100 {
+		t.Errorf("Unexpected pids stats %+v", stats)
+	}
+	if stats.IOReadBytes != 15 || stats.IOWriteBytes != 25 ||
+		stats.IOReadOps != 2 || stats.IOWriteOps != 3 {
+		t.Errorf("Unexpected I/O stats %+v", stats)
+	}
+
+	if stats.CPUPressure != nil || stats.IOPressure != nil {
+		t.Error("Unexpected pressure stall information")
+	}
+	expected := PressureStall{
+		Some: PressureStallAverages{Avg10: 1.5, Avg60: 0.25, Total: 1234},
+		Full: PressureStallAverages{Avg10: 0.5, Total: 567},
+	}
+	if stats.MemoryPressure == nil || *stats.MemoryPressure != expected {
+		t.Errorf("Expected memory pressure %+v, got %+v",
+			expected, stats.MemoryPressure)
+	}
+}
@@ -242,6 +242,7 @@ func (group *perfEventGroup) cleanup() {
 
 // SampleDispatchFn is the signature of a function called to dispatch a
 // sample. The first argument is the event ID, the second is the sample.
+// Samples lost by the kernel are dispatched with LostSamplesEventID.
 type SampleDispatchFn func(uint64, EventMonitorSample)
 
 // EventMonitor is a high-level interface to the Linux kernel's perf_event
@@ -282,6 +283,7 @@ type EventMonitor struct {
 	// while pending externalSamples remain undispatched.
 	pendingExternalSamples   externalSampleList
 	lastSampleTimeDispatched uint64
+	lastCPUSampleTime        []uint64 // cpu : time of last sample
 
 	// This lock protects everything mutable below this point.
 	lock *sync.Mutex
@@ -972,7 +974,9 @@ type EventMonitorSample struct {
 	Fields map[string]int32
 
 	// DecodedData is the sample data decoded from RawSample.Record.RawData
-	// if RawSample is of type *SampleRecord; otherwise, it will be nil.
+	// if RawSample is of type *SampleRecord. If RawSample is of type
+	// *LostRecord, it is the lost sample information described by
+	// LostSampleFields. Otherwise, it will be nil.
 	DecodedData TraceEventSampleData
 
 	// DecodedSample is the value returned from calling the registered
@@ -983,6 +987,23 @@ type EventMonitorSample struct {
 	Err error
 }
 
+// LostSamplesEventID is the event ID with which samples for *LostRecord
+// records are dispatched. A ringbuffer is shared by all of the events in its
+// group, so lost samples cannot be attributed to any one event. No registered
+// event has this ID.
+const LostSamplesEventID uint64 = 0
+
+// LostSampleFields is the name and type information for the DecodedData of
+// an EventMonitorSample for a *LostRecord. The samples were lost on the CPU
+// after the time of the previous sample dispatched for the CPU (start_time),
+// which may be zero if there was none, and before end_time.
+var LostSampleFields = map[string]int32{
+	"lost":       TraceEventFieldTypeUnsignedInt64,
+	"cpu":        TraceEventFieldTypeUnsignedInt32,
+	"start_time": TraceEventFieldTypeUnsignedInt64,
+	"end_time":   TraceEventFieldTypeUnsignedInt64,
+}
+
 type externalSample struct {
 	eventID uint64
 	sample  EventMonitorSample
@@ -1194,6 +1215,21 @@ func (monitor *EventMonitor) dispatchSamples(samples [][]EventMonitorSample) {
 
 		monitor.processExternalSamples(esm.RawSample.Time)
 
+		cpu := esm.RawSample.CPU
+		if record, ok := esm.RawSample.Record.(*LostRecord); ok {
+			// The record's stream ID is that of the group
+			// leader, which is not a registered event.
+			esm.Fields = LostSampleFields
+			esm.DecodedData = TraceEventSampleData{
+				"lost":       record.Lost,
+				"cpu":        cpu,
+				"start_time": monitor.cpuSampleTime(cpu),
+				"end_time":   esm.RawSample.Time,
+			}
+			monitor.dispatchSample(dispatchFn, LostSamplesEventID, esm)
+			continue
+		}
+
 		streamID := esm.RawSample.SampleID.StreamID
 		eventID, ok := eventIDMap[streamID]
 		if !ok {
@@ -1217,8 +1253,7 @@ func (monitor *EventMonitor) dispatchSamples(samples [][]EventMonitorSample) {
 		}
 		esm.Fields = event.fields
 
-		switch record := esm.RawSample.Record.(type) {
-		case *SampleRecord:
+		if record, ok := esm.RawSample.Record.(*SampleRecord); ok {
 			// Adjust the sample time so that it
 			// matches the normalized timestamp.
 			record.Time = esm.RawSample.Time
@@ -1230,15 +1265,42 @@ func (monitor *EventMonitor) dispatchSamples(samples [][]EventMonitorSample) {
 					monitor.decoders.DecodeSample(record)
 			}
 		}
-		dispatchFn(eventID, esm)
-		if esm.RawSample.Time > monitor.lastSampleTimeDispatched {
-			monitor.lastSampleTimeDispatched = esm.RawSample.Time
-		}
+		monitor.dispatchSample(dispatchFn, eventID, esm)
 	}
 
 	monitor.processExternalSamples(monitor.lastSampleTimeDispatched)
 }
 
+func (monitor *EventMonitor) dispatchSample(
+	dispatchFn SampleDispatchFn,
+	eventID uint64,
+	esm EventMonitorSample,
+) {
+	dispatchFn(eventID, esm)
+	monitor.setCPUSampleTime(esm.RawSample.CPU, esm.RawSample.Time)
+	if esm.RawSample.Time > monitor.lastSampleTimeDispatched {
+		monitor.lastSampleTimeDispatched = esm.RawSample.Time
+	}
+}
+
+func (monitor *EventMonitor) cpuSampleTime(cpu uint32) uint64 {
+	if int(cpu) < len(monitor.lastCPUSampleTime) {
+		return monitor.lastCPUSampleTime[cpu]
+	}
+	return 0
+}
+
+func (monitor *EventMonitor) setCPUSampleTime(cpu uint32, t uint64) {
+	if int(cpu) >= len(monitor.lastCPUSampleTime) {
+		times := make([]uint64, cpu+1)
+		copy(times, monitor.lastCPUSampleTime)
+		monitor.lastCPUSampleTime = times
+	}
+	if t > monitor.lastCPUSampleTime[cpu] {
+		monitor.lastCPUSampleTime[cpu] = t
+	}
+}
+
 func (monitor *EventMonitor) dispatchSampleLoop() {
 	defer monitor.wg.Done()
 
@@ -1287,6 +1349,12 @@ func (monitor *EventMonitor) readRingBuffers(readyfds []int) {
 				ems.RawSample.Time =
 					uint64(int64(ems.RawSample.Time) -
 						group.timeOffset)
+				if group.cpu >= 0 {
+					// Records that do not include
+					// PERF_SAMPLE_CPU (e.g., PERF_RECORD_LOST)
+					// still came from this CPU's ringbuffer.
+					ems.RawSample.CPU = uint32(group.cpu)
+				}
 				groupSamples = append(groupSamples, ems)
 			}
 		})
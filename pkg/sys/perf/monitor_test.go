@@ -0,0 +1,38 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package perf
+
+import (
+	"testing"
+)
+
+func TestCPUSampleTime(t *testing.T) {
+	monitor := &EventMonitor{}
+
+	if st := monitor.cpuSampleTime(3); st != 0 {
+		t.Errorf("Expected no sample time for CPU 3, got %d", st)
+	}
+
+	monitor.setCPUSampleTime(3, 100)
+	monitor.setCPUSampleTime(1, 50)
+	monitor.setCPUSampleTime(3, 90)
+
+	if st := monitor.cpuSampleTime(3); st != 100 {
+		t.Errorf("Expected sample time 100 for CPU 3, got %d", st)
+	}
+	if st := monitor.cpuSampleTime(1); st != 50 {
+		t.Errorf("Expected sample time 50 for CPU 1, got %d", st)
+	}
+}
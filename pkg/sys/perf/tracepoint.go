@@ -20,6 +20,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode"
@@ -434,6 +435,66 @@ func getTraceEventFormat(tracingDir, name string) (uint16, map[string]traceEvent
 	return readTraceEventFormat(name, file)
 }
 
+// TraceEventFields returns the types of the fields defined for a trace event,
+// indexed by field name. The types are the TraceEventFieldType constants.
+// The trace event is not registered.
+func TraceEventFields(tracingDir, name string) (map[string]int32, error) {
+	_, fields, err := getTraceEventFormat(tracingDir, name)
+	if err != nil {
+		return nil, err
+	}
+
+	types := make(map[string]int32, len(fields))
+	for k, v := range fields {
+		types[k] = v.dataType
+	}
+	return types, nil
+}
+
+// TraceEventFieldInfo describes a field defined for a trace event.
+type TraceEventFieldInfo struct {
+	Name     string
+	TypeName string
+	Offset   int
+	Size     int
+	IsSigned bool
+	Type     int32 // TraceEventFieldType constant
+}
+
+type traceEventFieldInfoSlice []TraceEventFieldInfo
+
+func (s traceEventFieldInfoSlice) Len() int           { return len(s) }
+func (s traceEventFieldInfoSlice) Less(i, j int) bool { return s[i].Offset < s[j].Offset }
+func (s traceEventFieldInfoSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+func traceEventFieldInfo(fields map[string]traceEventField) []TraceEventFieldInfo {
+	info := make(traceEventFieldInfoSlice, 0, len(fields))
+	for _, f := range fields {
+		info = append(info, TraceEventFieldInfo{
+			Name:     f.FieldName,
+			TypeName: f.TypeName,
+			Offset:   f.Offset,
+			Size:     f.Size,
+			IsSigned: f.IsSigned,
+			Type:     f.dataType,
+		})
+	}
+	sort.Sort(info)
+	return info
+}
+
+// TraceEventFormat returns the fields defined for a trace event in the order
+// that they appear in the trace event's samples. The trace event is not
+// registered.
+func TraceEventFormat(tracingDir, name string) ([]TraceEventFieldInfo, error) {
+	_, fields, err := getTraceEventFormat(tracingDir, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return traceEventFieldInfo(fields), nil
+}
+
 func readTraceEventFormat(name string, reader io.Reader) (uint16, map[string]traceEventField, error) {
 	var eventID uint16
 
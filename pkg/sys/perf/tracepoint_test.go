@@ -72,3 +72,35 @@ func TestReadTraceEventFormat(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestTraceEventFieldInfo(t *testing.T) {
+	var fields []TraceEventFieldInfo
+	err := extractFiles("testdata/events.tar.gz", func(name string, reader io.Reader) error {
+		if name != "syscalls/sys_enter_openat/format" {
+			return nil
+		}
+		_, f, err := readTraceEventFormat(filepath.Dir(name), reader)
+		fields = traceEventFieldInfo(f)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"common_type", "common_flags", "common_preempt_count",
+		"common_pid", "__syscall_nr", "dfd", "filename", "flags", "mode",
+	}
+	if len(fields) != len(expected) {
+		t.Fatalf("Expected %d fields, got %+v", len(expected), fields)
+	}
+	for i, name := range expected {
+		if fields[i].Name != name {
+			t.Errorf("Expected field %d to be %s, got %s", i, name,
+				fields[i].Name)
+		}
+	}
+	if f := fields[6]; f.TypeName != "const char *" || f.Size != 8 {
+		t.Errorf("Unexpected filename field %+v", f)
+	}
+}
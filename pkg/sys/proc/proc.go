@@ -30,6 +30,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/golang/glog"
 )
@@ -139,9 +140,33 @@ func (fs *FileSystem) CommandLine(pid int) []string {
 		return nil
 	}
 
-	var commandLine []string
+	return splitNulStrings(cmdline)
+}
+
+// Environment gets the environment of the process indicated by the given
+// PID as a list of NAME=value strings.
+func Environment(pid int) []string {
+	return FS().Environment(pid)
+}
+
+// Environment gets the environment of the process indicated by the given
+// PID as a list of NAME=value strings.
+func (fs *FileSystem) Environment(pid int) []string {
+	filename := fmt.Sprintf("%d/environ", pid)
+	environ, err := fs.ReadFile(filename)
+	if err != nil {
+		return nil
+	}
+
+	return splitNulStrings(environ)
+}
+
+// splitNulStrings splits the contents of a /proc file such as cmdline or
+// environ into its NUL-terminated strings, stopping at the first empty one.
+func splitNulStrings(b []byte) []string {
+	var strs []string
 
-	reader := bufio.NewReader(bytes.NewReader(cmdline[:]))
+	reader := bufio.NewReader(bytes.NewReader(b))
 	for {
 		s, err := reader.ReadString(0)
 		if err != nil {
@@ -149,13 +174,13 @@ func (fs *FileSystem) CommandLine(pid int) []string {
 		}
 
 		if len(s) > 1 {
-			commandLine = append(commandLine, s[:len(s)-1])
+			strs = append(strs, s[:len(s)-1])
 		} else {
 			break
 		}
 	}
 
-	return commandLine
+	return strs
 }
 
 // Cgroups returns the cgroup membership of the process
@@ -511,6 +536,159 @@ func (ps *ProcessStatus) UniqueID() string {
 	return ps.uniqueID
 }
 
+// The number of clock ticks per second used by the kernel when reporting
+// times in /proc. This is USER_HZ, which is 100 on all architectures that
+// the kernel supports.
+const userHZ = 100
+
+// ResourceUsage is the resource usage of a task as reported by the proc
+// filesystem.
+type ResourceUsage struct {
+	// UserTime and SystemTime are the times spent executing in user
+	// mode and kernel mode.
+	UserTime   time.Duration
+	SystemTime time.Duration
+
+	// StartTime is the time after system boot when the task started.
+	StartTime time.Duration
+
+	// MaxRSS is the maximum resident set size in bytes. It is zero once
+	// the task has released its memory while exiting.
+	MaxRSS uint64
+
+	// VoluntaryContextSwitches and InvoluntaryContextSwitches are the
+	// numbers of context switches of the task.
+	VoluntaryContextSwitches   uint64
+	InvoluntaryContextSwitches uint64
+
+	// ReadBytes and WriteBytes are the numbers of bytes read and written
+	// by the task via system calls, including pipes and page cache.
+	ReadBytes  uint64
+	WriteBytes uint64
+}
+
+// ReadTaskResourceUsage reads the resource usage of the task indicated by the
+// given TGID and TID.
+func ReadTaskResourceUsage(tgid, tid int) (*ResourceUsage, error) {
+	return FS().ReadTaskResourceUsage(tgid, tid)
+}
+
+// ReadTaskResourceUsage reads the resource usage of the task indicated by the
+// given TGID and TID from the ProcFS receiver. The usage is that of the task
+// itself rather than its whole thread group, except for MaxRSS, which is
+// shared by all of the threads. Only the task's stat file is required; fields
+// from its status and io files are read if possible.
+func (fs *FileSystem) ReadTaskResourceUsage(tgid, tid int) (*ResourceUsage, error) {
+	dir := fmt.Sprintf("%d/task/%d", tgid, tid)
+	stat, err := fs.ReadFile(dir + "/stat")
+	if err != nil {
+		return nil, err
+	}
+
+	ru := &ResourceUsage{}
+	if err = ru.parseStat(string(stat)); err != nil {
+		return nil, err
+	}
+
+	if f, err := fs.Open(dir + "/status"); err == nil {
+		err = ru.parseStatus(f)
+		f.Close()
+		if err != nil {
+			glog.V(2).Infof("Couldn't parse task %d status: %s", tid, err)
+		}
+	}
+	if f, err := fs.Open(dir + "/io"); err == nil {
+		err = ru.parseIO(f)
+		f.Close()
+		if err != nil {
+			glog.V(2).Infof("Couldn't parse task %d io: %s", tid, err)
+		}
+	}
+
+	return ru, nil
+}
+
+func ticksToDuration(ticks uint64) time.Duration {
+	return time.Duration(ticks) * (time.Second / userHZ)
+}
+
+func (ru *ResourceUsage) parseStat(stat string) error {
+	fields := statFields(stat)
+	if len(fields) < 22 {
+		return errors.New("Truncated stat")
+	}
+
+	var ticks [3]uint64
+	for i, n := range []int{14, 15, 22} {
+		x, err := strconv.ParseUint(fields[n-1], 0, 64)
+		if err != nil {
+			return err
+		}
+		ticks[i] = x
+	}
+
+	ru.UserTime = ticksToDuration(ticks[0])
+	ru.SystemTime = ticksToDuration(ticks[1])
+	ru.StartTime = ticksToDuration(ticks[2])
+	return nil
+}
+
+// parseUintFields parses the named fields from a /proc file made up of
+// "name: value" lines into a map. Fields that are missing are left out.
+func parseUintFields(r io.Reader, names ...string) (map[string]uint64, error) {
+	values := make(map[string]uint64)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		for _, name := range names {
+			if parts[0] != name {
+				continue
+			}
+			// Some values have units, such as "5116 kB"
+			f := strings.Fields(parts[1])
+			if len(f) == 0 {
+				break
+			}
+			x, err := strconv.ParseUint(f[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			values[name] = x
+			break
+		}
+	}
+	return values, scanner.Err()
+}
+
+func (ru *ResourceUsage) parseStatus(r io.Reader) error {
+	values, err := parseUintFields(r, "VmHWM",
+		"voluntary_ctxt_switches", "nonvoluntary_ctxt_switches")
+	if err != nil {
+		return err
+	}
+
+	// VmHWM is reported in kB
+	ru.MaxRSS = values["VmHWM"] * 1024
+	ru.VoluntaryContextSwitches = values["voluntary_ctxt_switches"]
+	ru.InvoluntaryContextSwitches = values["nonvoluntary_ctxt_switches"]
+	return nil
+}
+
+func (ru *ResourceUsage) parseIO(r io.Reader) error {
+	values, err := parseUintFields(r, "rchar", "wchar")
+	if err != nil {
+		return err
+	}
+
+	ru.ReadBytes = values["rchar"]
+	ru.WriteBytes = values["wchar"]
+	return nil
+}
+
 // DeriveUniqueID returns a unique ID for thye process with the given
 // PID and parent PID
 func DeriveUniqueID(pid, ppid int) string {
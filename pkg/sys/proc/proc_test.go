@@ -17,7 +17,11 @@ package proc
 import (
 	"bytes"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
 // This data was taken from actual /proc/PID/stat files.
@@ -343,3 +347,88 @@ func TestCgroupParse(t *testing.T) {
 		}
 	}
 }
+
+func TestSplitNulStrings(t *testing.T) {
+	strs := splitNulStrings([]byte("/bin/ls\x00-l\x00\x00ignored\x00"))
+	if len(strs) != 2 || strs[0] != "/bin/ls" || strs[1] != "-l" {
+		t.Errorf("Unexpected strings %q", strs)
+	}
+
+	if strs = splitNulStrings([]byte("unterminated")); strs != nil {
+		t.Errorf("Unexpected strings %q", strs)
+	}
+}
+
+func TestResourceUsageParse(t *testing.T) {
+	ru := &ResourceUsage{}
+	if err := ru.parseStat(statTests[0].statFile); err != nil {
+		t.Fatal(err)
+	}
+	if ru.UserTime != 330*time.Millisecond ||
+		ru.SystemTime != 70*time.Millisecond ||
+		ru.StartTime != 88100*time.Millisecond {
+		t.Errorf("Unexpected times %+v", ru)
+	}
+
+	status := "Name:\tbash\nVmHWM:\t    5116 kB\n" +
+		"voluntary_ctxt_switches:\t150\n" +
+		"nonvoluntary_ctxt_switches:\t3\n"
+	if err := ru.parseStatus(bytes.NewReader([]byte(status))); err != nil {
+		t.Fatal(err)
+	}
+	if ru.MaxRSS != 5116*1024 || ru.VoluntaryContextSwitches != 150 ||
+		ru.InvoluntaryContextSwitches != 3 {
+		t.Errorf("Unexpected status usage %+v", ru)
+	}
+
+	io := "rchar: 1948\nwchar: 1084\nsyscr: 7\nsyscw: 4\n" +
+		"read_bytes: 0\nwrite_bytes: 0\ncancelled_write_bytes: 0\n"
+	if err := ru.parseIO(bytes.NewReader([]byte(io))); err != nil {
+		t.Fatal(err)
+	}
+	if ru.ReadBytes != 1948 || ru.WriteBytes != 1084 {
+		t.Errorf("Unexpected I/O usage %+v", ru)
+	}
+
+	if err := ru.parseStat("1 (init) S 0"); err == nil {
+		t.Error("Expected error for truncated stat")
+	}
+}
+
+func TestReadTaskResourceUsage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "proc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	taskDir := filepath.Join(dir, "1/task/2")
+	if err = os.MkdirAll(taskDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(taskDir, "stat"),
+		[]byte(statTests[0].statFile), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(taskDir, "io"),
+		[]byte("rchar: 1948\nwchar: 1084\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fs := &FileSystem{MountPoint: dir}
+	ru, err := fs.ReadTaskResourceUsage(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ru.UserTime != 330*time.Millisecond || ru.ReadBytes != 1948 ||
+		ru.WriteBytes != 1084 {
+		t.Errorf("Unexpected usage %+v", ru)
+	}
+
+	// Only the task's stat file is required
+	if _, err = fs.ReadTaskResourceUsage(1, 3); err == nil {
+		t.Error("Expected error for missing task")
+	}
+}
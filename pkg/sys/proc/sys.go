@@ -35,3 +35,13 @@ func MaxPid() uint {
 
 	return uint(pidMax)
 }
+
+// KernelRelease returns the release of the running kernel
+func KernelRelease() (string, error) {
+	contents, err := ReadFile("sys/kernel/osrelease")
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(contents)), nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sys
+
+var syscallNumbers = make(map[string]int64, len(syscallNames))
+
+func init() {
+	for nr, name := range syscallNames {
+		syscallNumbers[name] = nr
+	}
+}
+
+// System calls whose kernel entry points are named differently than the
+// system calls themselves. The sys_enter tracepoints are named for the
+// kernel entry points.
+var syscallEntryNames = map[string]string{
+	"_sysctl":  "sysctl",
+	"fstat":    "newfstat",
+	"lstat":    "newlstat",
+	"sendfile": "sendfile64",
+	"stat":     "newstat",
+	"umount2":  "umount",
+	"uname":    "newuname",
+}
+
+// SyscallName returns the name of the system call with the given number for
+// the running architecture.
+func SyscallName(nr int64) (string, bool) {
+	name, ok := syscallNames[nr]
+	return name, ok
+}
+
+// SyscallNumber returns the number of the system call with the given name
+// for the running architecture.
+func SyscallNumber(name string) (int64, bool) {
+	nr, ok := syscallNumbers[name]
+	return nr, ok
+}
+
+// SyscallEnterTracepoint returns the name of the syscalls tracepoint that
+// fires on entry to the system call with the given name, e.g.
+// "syscalls/sys_enter_openat".
+func SyscallEnterTracepoint(name string) string {
+	if entryName, ok := syscallEntryNames[name]; ok {
+		name = entryName
+	}
+	return "syscalls/sys_enter_" + name
+}
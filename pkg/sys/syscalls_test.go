@@ -0,0 +1,43 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sys
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestSyscallNames(t *testing.T) {
+	if runtime.GOARCH != "amd64" {
+		t.Skip("System call numbers are only known for amd64")
+	}
+
+	if name, ok := SyscallName(257); !ok || name != "openat" {
+		t.Errorf("Expected openat for syscall 257, got %q", name)
+	}
+	if nr, ok := SyscallNumber("execve"); !ok || nr != 59 {
+		t.Errorf("Expected 59 for execve, got %d", nr)
+	}
+	if _, ok := SyscallNumber("no_such_syscall"); ok {
+		t.Error("Unexpected number for no_such_syscall")
+	}
+
+	if tp := SyscallEnterTracepoint("openat"); tp != "syscalls/sys_enter_openat" {
+		t.Errorf("Unexpected tracepoint %s for openat", tp)
+	}
+	if tp := SyscallEnterTracepoint("stat"); tp != "syscalls/sys_enter_newstat" {
+		t.Errorf("Unexpected tracepoint %s for stat", tp)
+	}
+}